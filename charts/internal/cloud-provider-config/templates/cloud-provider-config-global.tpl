@@ -1,15 +1,19 @@
 {{- define "cloud-provider-config-credentials" -}}
+{{- $credentials := .Values -}}
+{{- if and .component .Values.applicationCredentials -}}
+{{- $credentials = index .Values.applicationCredentials .component | default .Values -}}
+{{- end -}}
 auth-url="{{ .Values.authUrl }}"
 domain-name="{{ .Values.domainName }}"
 tenant-name="{{ .Values.tenantName }}"
-username="{{ .Values.username }}"
-{{- if .Values.password }}
-password="{{ .Values.password }}"
+username="{{ $credentials.username }}"
+{{- if $credentials.password }}
+password="{{ $credentials.password }}"
 {{- end }}
-{{- if .Values.applicationCredentialSecret }}
-application-credential-id="{{ .Values.applicationCredentialID }}"
-application-credential-name="{{ .Values.applicationCredentialName }}"
-application-credential-secret="{{ .Values.applicationCredentialSecret }}"
+{{- if $credentials.applicationCredentialSecret }}
+application-credential-id="{{ $credentials.applicationCredentialID }}"
+application-credential-name="{{ $credentials.applicationCredentialName }}"
+application-credential-secret="{{ $credentials.applicationCredentialSecret }}"
 {{- end }}
 region="{{ .Values.region }}"
 {{- if .Values.insecure }}
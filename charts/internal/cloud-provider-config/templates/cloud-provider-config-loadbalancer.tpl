@@ -1,15 +1,32 @@
 {{- define "cloud-provider-config-loadbalancer" -}}
 [LoadBalancer]
-create-monitor=true
+create-monitor={{ .Values.createMonitor }}
 monitor-delay="{{ .Values.monitorDelay }}"
 monitor-timeout="{{ .Values.monitorTimeout }}"
 monitor-max-retries={{ .Values.monitorMaxRetries }}
+{{- if .Values.monitorMaxRetriesDown }}
+monitor-max-retries-down={{ .Values.monitorMaxRetriesDown }}
+{{- end }}
 lb-version="v2"
 lb-provider="{{ .Values.lbProvider }}"
+{{- if .Values.lbMethod }}
+lb-method="{{ .Values.lbMethod }}"
+{{- end }}
 {{- if eq .Values.lbProvider "ovn" }}
-lb-method="SOURCE_IP_PORT"
 manage-security-groups=true
 {{- end }}
+{{- if .Values.flavorID }}
+flavor-id="{{ .Values.flavorID }}"
+{{- end }}
+{{- if .Values.availabilityZone }}
+availability-zone="{{ .Values.availabilityZone }}"
+{{- end }}
+{{- if .Values.enableIngressHostname }}
+enable-ingress-hostname=true
+{{- end }}
+{{- if .Values.maxSharedLB }}
+max-shared-lb={{ .Values.maxSharedLB }}
+{{- end }}
 floating-network-id="{{ .Values.floatingNetworkID }}"
 {{- if .Values.floatingSubnetID }}
 floating-subnet-id="{{ .Values.floatingSubnetID }}"
@@ -40,5 +57,8 @@ floating-subnet-tags="{{ $class.floatingSubnetTags }}"
 {{- if $class.subnetID }}
 subnet-id="{{ $class.subnetID }}"
 {{- end }}
+{{- if $class.memberSubnetID }}
+member-subnet-id="{{ $class.memberSubnetID }}"
+{{- end }}
 {{- end }}
 {{- end -}}
@@ -0,0 +1,3 @@
+{{- define "k8s-keystone-auth.port" -}}
+8443
+{{- end -}}
@@ -225,9 +225,11 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			openstackworker.DefaultAddOptions.GardenCluster = gardenCluster
 			openstackworker.DefaultAddOptions.SelfHostedShootCluster = generalOpts.Completed().SelfHostedShootCluster
 
-			if _, err := webhookOptions.Completed().AddToManager(ctx, mgr, nil); err != nil {
+			shootWebhookConfig, err := webhookOptions.Completed().AddToManager(ctx, mgr, nil)
+			if err != nil {
 				return fmt.Errorf("could not add webhooks to manager: %w", err)
 			}
+			openstackcontrolplane.DefaultAddOptions.ShootWebhookConfig = shootWebhookConfig
 			openstackcontrolplane.DefaultAddOptions.WebhookServerNamespace = webhookOptions.Server.Namespace
 
 			if err := controllerSwitches.Completed().AddToManager(ctx, mgr); err != nil {
@@ -121,6 +121,7 @@ func (s *shoot) validateShootCreation(ctx context.Context, shoot *core.Shoot, cr
 		allErrs = append(allErrs, openstackvalidation.ValidateInfrastructureConfigAgainstCloudProfile(nil, valContext.infraConfig, credentials.DomainName, valContext.shoot.Spec.Region, valContext.cloudProfileConfig, infraConfigPath)...)
 		allErrs = append(allErrs, openstackvalidation.ValidateControlPlaneConfigAgainstCloudProfile(nil, valContext.cpConfig, credentials.DomainName, valContext.shoot.Spec.Region, valContext.infraConfig.FloatingPoolName, valContext.cloudProfileConfig, cpConfigPath)...)
 	}
+	allErrs = append(allErrs, openstackvalidation.ValidateStorageClassesAgainstCloudProfile(nil, valContext.cpConfig, valContext.shoot.Spec.Region, cloudProfileSpec, valContext.cloudProfileConfig, cpConfigPath)...)
 	allErrs = append(allErrs, s.validateShoot(ctx, valContext)...)
 	return allErrs.ToAggregate()
 }
@@ -160,6 +161,7 @@ func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.S
 		oldValContext.infraConfig.FloatingPoolName != valContext.infraConfig.FloatingPoolName {
 		allErrs = append(allErrs, openstackvalidation.ValidateControlPlaneConfigAgainstCloudProfile(oldCpConfig, cpConfig, credentials.DomainName, valContext.shoot.Spec.Region, valContext.infraConfig.FloatingPoolName, valContext.cloudProfileConfig, cpConfigPath)...)
 	}
+	allErrs = append(allErrs, openstackvalidation.ValidateStorageClassesAgainstCloudProfile(oldCpConfig, cpConfig, valContext.shoot.Spec.Region, cloudProfileSpec, valContext.cloudProfileConfig, cpConfigPath)...)
 
 	if errList := openstackvalidation.ValidateWorkersUpdate(oldValContext.shoot.Spec.Provider.Workers, valContext.shoot.Spec.Provider.Workers, workersPath); len(errList) > 0 {
 		return errList.ToAggregate()
@@ -186,6 +188,7 @@ func (s *shoot) validateShoot(ctx context.Context, context *validationContext) f
 	}
 	allErrs = append(allErrs, openstackvalidation.ValidateControlPlaneConfig(context.cpConfig, context.infraConfig, context.shoot.Spec.Kubernetes.Version, cpConfigPath)...)
 	allErrs = append(allErrs, openstackvalidation.ValidateWorkers(context.shoot.Spec.Provider.Workers, context.cloudProfileConfig, workersPath)...)
+	allErrs = append(allErrs, openstackvalidation.ValidateWorkersBootCapabilities(context.shoot.Spec.Provider.Workers, context.shoot.Spec.Region, context.cloudProfileSpec, context.cloudProfileConfig, workersPath)...)
 	allErrs = append(allErrs, s.validateDNS(ctx, context.shoot)...)
 	return allErrs
 }
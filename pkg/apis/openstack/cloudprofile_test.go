@@ -72,5 +72,15 @@ var _ = Describe("LoadBalancerClass", func() {
 			loadBalancerClassB.SubnetID = ptr.To("subnet-ids-2")
 			Expect(loadBalancerClassA.IsSemanticallyEqual(loadBalancerClassB)).To(BeFalse())
 		})
+
+		It("should return false as LoadBalancerClass are not semantically due to different IPv6 subnet ids", func() {
+			loadBalancerClassB.IPv6SubnetID = ptr.To("ipv6-subnet-id")
+			Expect(loadBalancerClassA.IsSemanticallyEqual(loadBalancerClassB)).To(BeFalse())
+		})
+
+		It("should return false as LoadBalancerClass are not semantically due to different IPv6 floating subnet ids", func() {
+			loadBalancerClassB.IPv6FloatingSubnetID = ptr.To("ipv6-floating-subnet-id")
+			Expect(loadBalancerClassA.IsSemanticallyEqual(loadBalancerClassB)).To(BeFalse())
+		})
 	})
 })
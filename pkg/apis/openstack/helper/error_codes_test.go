@@ -31,6 +31,12 @@ var _ = Describe("#ErrorCodes", func() {
 			Expect(KnownCodes[gardencorev1beta1.ErrorInfraResourcesDepleted](errorMsg)).To(BeTrue())
 		})
 
+		It("should match Nova capacity faults", func() {
+			errorMsg := "Cloud provider message - machine codes error: code = [Internal] message = [server reached unexpected status \"ERROR\": No valid host was found. There are not enough hosts available.]"
+			Expect(KnownCodes[gardencorev1beta1.ErrorInfraResourcesDepleted](errorMsg)).To(BeTrue())
+			Expect(KnownCodes[gardencorev1beta1.ErrorInfraDependencies](errorMsg)).To(BeFalse())
+		})
+
 		It("should not match unrelated error", func() {
 			msg := "Some other error message"
 			Expect(KnownCodes[gardencorev1beta1.ErrorInfraResourcesDepleted](msg)).To(BeFalse())
@@ -6,11 +6,13 @@ package helper
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	gardencorev1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
@@ -271,6 +273,46 @@ func filterCapabilityFlavorsByRegion(capabilityFlavors []api.MachineImageFlavor,
 	return compatibleFlavors
 }
 
+// IsBareMetalMachineType returns whether the machine type with the given name is marked as bare metal machine type.
+func IsBareMetalMachineType(machineTypes []api.MachineType, name string) bool {
+	for _, machineType := range machineTypes {
+		if machineType.Name == name {
+			return ptr.Deref(machineType.BareMetal, false)
+		}
+	}
+	return false
+}
+
+// bootCapabilityValues are the values supported by the machine capabilities for the boot features of machines.
+var bootCapabilityValues = map[string][]string{
+	api.CapabilitySecureBoot:            {api.CapabilityValueEnabled, api.CapabilityValueDisabled},
+	api.CapabilityTPM:                   {api.CapabilityValueEnabled, api.CapabilityValueDisabled},
+	api.CapabilityConfidentialComputing: {api.ConfidentialComputingNone, api.ConfidentialComputingSEV, api.ConfidentialComputingTDX},
+}
+
+// BootCapabilityValues returns the supported values of the given machine capability if it is one of the capabilities
+// for the boot features of machines.
+func BootCapabilityValues(name string) ([]string, bool) {
+	values, ok := bootCapabilityValues[name]
+	return values, ok
+}
+
+// RequiredBootCapabilities returns the boot capabilities of a machine type which require a feature from the machine
+// image, i.e. the capabilities which do not allow to boot without the feature.
+func RequiredBootCapabilities(capabilities gardencorev1beta1.Capabilities) gardencorev1beta1.Capabilities {
+	required := gardencorev1beta1.Capabilities{}
+	for name, values := range capabilities {
+		if _, ok := bootCapabilityValues[name]; !ok || len(values) == 0 {
+			continue
+		}
+		if slices.Contains(values, api.CapabilityValueDisabled) || slices.Contains(values, api.ConfidentialComputingNone) {
+			continue
+		}
+		required[name] = values
+	}
+	return required
+}
+
 // FindKeyStoneURL takes a list of keystone URLs and tries to find the first entry
 // whose region matches with the given region. If no such entry is found then it tries to use the non-regional
 // keystone URL. If this is not specified then an error will be returned.
@@ -301,6 +343,41 @@ func FindKeyStoneCACert(keyStoneURLs []api.KeyStoneURL, keystoneCABundle *string
 	return keystoneCABundle
 }
 
+// FindVolumeZone returns the block storage availability zone which is mapped to the given compute availability zone of
+// the region. If there is no mapping for the zone then the compute availability zone is returned.
+func FindVolumeZone(volumeAZMappings []api.VolumeAZMapping, region, computeZone string) string {
+	for _, mapping := range volumeAZMappings {
+		if mapping.Region == region && mapping.ComputeZone == computeZone {
+			return mapping.VolumeZone
+		}
+	}
+	return computeZone
+}
+
+// MappedVolumeZones returns the sorted list of distinct block storage availability zones which are mapped to compute
+// availability zones of the given region.
+func MappedVolumeZones(volumeAZMappings []api.VolumeAZMapping, region string) []string {
+	zones := sets.New[string]()
+	for _, mapping := range volumeAZMappings {
+		if mapping.Region == region {
+			zones.Insert(mapping.VolumeZone)
+		}
+	}
+	return sets.List(zones)
+}
+
+// MappedComputeZones returns the sorted list of distinct compute availability zones of the given region which are mapped
+// to a block storage availability zone.
+func MappedComputeZones(volumeAZMappings []api.VolumeAZMapping, region string) []string {
+	zones := sets.New[string]()
+	for _, mapping := range volumeAZMappings {
+		if mapping.Region == region {
+			zones.Insert(mapping.ComputeZone)
+		}
+	}
+	return sets.List(zones)
+}
+
 // FindFloatingPool receives a list of floating pools and tries to find the best
 // match for a given `floatingPoolNamePattern` considering constraints like
 // `region` and `domain`. If no matching floating pool was found then an error will be returned.
@@ -348,6 +425,15 @@ func FindFloatingPool(floatingPools []api.FloatingPool, floatingPoolNamePattern,
 	return nil, fmt.Errorf("cannot find a matching floating pool for pattern %q", floatingPoolNamePattern)
 }
 
+// IPv6LoadBalancerClassName returns the name of the load balancer class for IPv6 load balancers which is derived from
+// the given load balancer class in dual-stack shoots. It returns false if the load balancer class has no IPv6 subnet.
+func IPv6LoadBalancerClassName(lbClass api.LoadBalancerClass) (string, bool) {
+	if lbClass.IPv6SubnetID == nil && lbClass.IPv6FloatingSubnetID == nil {
+		return "", false
+	}
+	return lbClass.Name + "-" + api.IPv6LoadBalancerClass, true
+}
+
 func checkFloatingPoolCandidate(floatingPool *api.FloatingPool, floatingPoolNamePattern, region string, domain *string) (*api.FloatingPool, int) {
 	// If the domain should be considered then only floating pools
 	// in the same domain will be considered.
@@ -44,6 +44,32 @@ var _ = Describe("Helper", func() {
 		Entry("entry exists", []api.SecurityGroup{{Name: "bar", Purpose: purpose}}, purpose, &api.SecurityGroup{Name: "bar", Purpose: purpose}, false),
 	)
 
+	DescribeTable("#IsBareMetalMachineType",
+		func(machineTypes []api.MachineType, name string, expected bool) {
+			Expect(IsBareMetalMachineType(machineTypes, name)).To(Equal(expected))
+		},
+
+		Entry("list is nil", nil, "bm.large", false),
+		Entry("entry not found", []api.MachineType{{Name: "bm.small", BareMetal: ptr.To(true)}}, "bm.large", false),
+		Entry("entry is not marked as bare metal", []api.MachineType{{Name: "bm.large"}}, "bm.large", false),
+		Entry("entry is marked as bare metal", []api.MachineType{{Name: "bm.large", BareMetal: ptr.To(true)}}, "bm.large", true),
+	)
+
+	DescribeTable("#RequiredBootCapabilities",
+		func(capabilities, expected gardencorev1beta1.Capabilities) {
+			Expect(RequiredBootCapabilities(capabilities)).To(Equal(expected))
+		},
+
+		Entry("capabilities are nil", nil, gardencorev1beta1.Capabilities{}),
+		Entry("no boot capabilities", gardencorev1beta1.Capabilities{"architecture": {"amd64"}}, gardencorev1beta1.Capabilities{}),
+		Entry("boot features are optional",
+			gardencorev1beta1.Capabilities{"secureBoot": {"enabled", "disabled"}, "confidentialComputing": {"sev", "none"}},
+			gardencorev1beta1.Capabilities{}),
+		Entry("boot features are required",
+			gardencorev1beta1.Capabilities{"architecture": {"amd64"}, "secureBoot": {"enabled"}, "tpm": {"enabled"}, "confidentialComputing": {"tdx"}},
+			gardencorev1beta1.Capabilities{"secureBoot": {"enabled"}, "tpm": {"enabled"}, "confidentialComputing": {"tdx"}}),
+	)
+
 	regionName := "eu-de-1"
 
 	Describe("#FindImageInCloudProfile (legacy format)", func() {
@@ -264,6 +290,47 @@ var _ = Describe("Helper", func() {
 		Entry("no default URL", []api.KeyStoneURL{{URL: "bar", Region: "europe"}}, "", "asia", "", true),
 	)
 
+	DescribeTable("#FindVolumeZone",
+		func(volumeAZMappings []api.VolumeAZMapping, region, computeZone, expectedVolumeZone string) {
+			Expect(FindVolumeZone(volumeAZMappings, region, computeZone)).To(Equal(expectedVolumeZone))
+		},
+
+		Entry("list is nil", nil, "europe", "nova-1", "nova-1"),
+		Entry("zone not mapped", []api.VolumeAZMapping{{Region: "europe", ComputeZone: "nova-2", VolumeZone: "cinder-2"}}, "europe", "nova-1", "nova-1"),
+		Entry("zone mapped in other region", []api.VolumeAZMapping{{Region: "asia", ComputeZone: "nova-1", VolumeZone: "cinder-1"}}, "europe", "nova-1", "nova-1"),
+		Entry("zone mapped", []api.VolumeAZMapping{{Region: "europe", ComputeZone: "nova-1", VolumeZone: "cinder-1"}}, "europe", "nova-1", "cinder-1"),
+	)
+
+	DescribeTable("#MappedVolumeZones",
+		func(volumeAZMappings []api.VolumeAZMapping, region string, expectedVolumeZones []string) {
+			Expect(MappedVolumeZones(volumeAZMappings, region)).To(Equal(expectedVolumeZones))
+		},
+
+		Entry("list is nil", nil, "europe", []string{}),
+		Entry("no mapping for region", []api.VolumeAZMapping{{Region: "asia", ComputeZone: "nova-1", VolumeZone: "cinder-1"}}, "europe", []string{}),
+		Entry("distinct and sorted zones of region", []api.VolumeAZMapping{
+			{Region: "europe", ComputeZone: "nova-3", VolumeZone: "cinder-b"},
+			{Region: "europe", ComputeZone: "nova-1", VolumeZone: "cinder-a"},
+			{Region: "europe", ComputeZone: "nova-2", VolumeZone: "cinder-a"},
+			{Region: "asia", ComputeZone: "nova-1", VolumeZone: "cinder-c"},
+		}, "europe", []string{"cinder-a", "cinder-b"}),
+	)
+
+	DescribeTable("#MappedComputeZones",
+		func(volumeAZMappings []api.VolumeAZMapping, region string, expectedComputeZones []string) {
+			Expect(MappedComputeZones(volumeAZMappings, region)).To(Equal(expectedComputeZones))
+		},
+
+		Entry("list is nil", nil, "europe", []string{}),
+		Entry("no mapping for region", []api.VolumeAZMapping{{Region: "asia", ComputeZone: "nova-1", VolumeZone: "cinder-1"}}, "europe", []string{}),
+		Entry("sorted zones of region", []api.VolumeAZMapping{
+			{Region: "europe", ComputeZone: "nova-3", VolumeZone: "cinder-b"},
+			{Region: "europe", ComputeZone: "nova-1", VolumeZone: "cinder-a"},
+			{Region: "europe", ComputeZone: "nova-2", VolumeZone: "cinder-a"},
+			{Region: "asia", ComputeZone: "nova-4", VolumeZone: "cinder-c"},
+		}, "europe", []string{"nova-1", "nova-2", "nova-3"}),
+	)
+
 	DescribeTable("#FindFloatingPool",
 		func(floatingPools []api.FloatingPool, floatingPoolNamePattern, region string, domain, expectedFloatingPoolName *string) {
 			result, err := FindFloatingPool(floatingPools, floatingPoolNamePattern, region, domain)
@@ -282,6 +349,18 @@ var _ = Describe("Helper", func() {
 		Entry("return fip even if there is a non-constraing fip with better score", []api.FloatingPool{{Name: "fip-*", Region: &regionName}, {Name: "fip-1", Region: &regionName, NonConstraining: ptr.To(true)}}, "fip-1", regionName, nil, ptr.To("fip-*")),
 		Entry("return non-constraing fip as there is no other matching fip", []api.FloatingPool{{Name: "nofip-1", Region: &regionName}, {Name: "fip-1", Region: &regionName, NonConstraining: ptr.To(true)}}, "fip-1", regionName, nil, ptr.To("fip-1")),
 	)
+
+	DescribeTable("#IPv6LoadBalancerClassName",
+		func(lbClass api.LoadBalancerClass, expectedName string, expectedOK bool) {
+			name, ok := IPv6LoadBalancerClassName(lbClass)
+			Expect(name).To(Equal(expectedName))
+			Expect(ok).To(Equal(expectedOK))
+		},
+
+		Entry("no name for a class without IPv6 subnets", api.LoadBalancerClass{Name: "foo", SubnetID: ptr.To("subnet")}, "", false),
+		Entry("name for a class with IPv6 subnet", api.LoadBalancerClass{Name: "foo", IPv6SubnetID: ptr.To("subnet")}, "foo-ipv6", true),
+		Entry("name for a class with IPv6 floating subnet", api.LoadBalancerClass{Name: "foo", IPv6FloatingSubnetID: ptr.To("subnet")}, "foo-ipv6", true),
+	)
 })
 
 //nolint:unparam
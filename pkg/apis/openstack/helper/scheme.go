@@ -105,6 +105,19 @@ func WorkerConfigFromRawExtension(raw *runtime.RawExtension) (*api.WorkerConfig,
 	return poolConfig, nil
 }
 
+// ControlPlaneConfigFromRawExtension extracts the provider specific configuration for the control plane.
+func ControlPlaneConfigFromRawExtension(raw *runtime.RawExtension) (*api.ControlPlaneConfig, error) {
+	cpConfig := &api.ControlPlaneConfig{}
+
+	if raw != nil && raw.Raw != nil {
+		if _, _, err := decoder.Decode(raw.Raw, nil, cpConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	return cpConfig, nil
+}
+
 // HasFlowState returns true if the group version of the State field in the provided
 // `extensionsv1alpha1.InfrastructureStatus` is openstack.provider.extensions.gardener.cloud/v1alpha1.
 func HasFlowState(status extensionsv1alpha1.InfrastructureStatus) (bool, error) {
@@ -45,6 +45,14 @@ type CloudProfileConfig struct {
 	// IgnoreVolumeAZ specifies whether the volumes AZ should be ignored when scheduling to nodes,
 	// to allow for differences between volume and compute zone naming.
 	IgnoreVolumeAZ *bool
+	// IgnoreNetworkAZ specifies whether the availability zone hints of the router and the network of new shoots are not
+	// derived from the zones of their worker pools, e.g. because the network availability zones of Neutron are named
+	// differently than the compute availability zones.
+	IgnoreNetworkAZ *bool
+	// VolumeAZMappings maps the compute availability zones to the block storage availability zones, for OpenStack
+	// installations which name the zones of Nova and Cinder differently. The mapped zones are used for the topology of
+	// the Cinder CSI driver.
+	VolumeAZMappings []VolumeAZMapping
 	// NodeVolumeAttachLimit specifies how many volumes can be attached to a node.
 	NodeVolumeAttachLimit *int32
 	// UseSNAT specifies whether S-NAT is supposed to be used for the Gardener managed OpenStack router.
@@ -56,6 +64,23 @@ type CloudProfileConfig struct {
 	// StorageClasses defines storageclasses for the shoot
 	// +optional
 	StorageClasses []StorageClassDefinition
+	// FlavorPCIAliases maps the PCI aliases used in the `pci_passthrough:alias` extra spec of flavors to the resources
+	// they provide on the nodes. It is used to derive the node templates of worker pools for scaling from zero.
+	// +optional
+	FlavorPCIAliases []FlavorPCIAlias
+	// UseFlavorCapacity specifies whether the node templates of worker pools are derived from the flavors of their
+	// machine types instead of the capacity of the machine types in the CloudProfile.
+	UseFlavorCapacity *bool
+	// ServerMetadata is additional server metadata which is set on all worker machines. It is not set as Nova server tags.
+	ServerMetadata map[string]string
+	// StoreOversizedUserData specifies whether the user data of worker machines which exceeds the limit of Nova even
+	// after compression may be stored in Swift. The machines download it via a temporary URL, hence Swift must support
+	// temporary URLs and be reachable from the worker nodes. Worker pools must opt in to it in their WorkerConfig.
+	StoreOversizedUserData *bool
+	// MachineTypes contains provider-specific settings of the machine types of the CloudProfile.
+	MachineTypes []MachineType
+	// VolumeTypes contains provider-specific settings of the volume types of the CloudProfile.
+	VolumeTypes []VolumeType
 }
 
 // Constraints is an object containing constraints for the shoots.
@@ -92,6 +117,16 @@ type KeyStoneURL struct {
 	CACert *string
 }
 
+// VolumeAZMapping maps a compute availability zone of a region to the block storage availability zone.
+type VolumeAZMapping struct {
+	// Region is the name of the region.
+	Region string
+	// ComputeZone is the name of the Nova availability zone.
+	ComputeZone string
+	// VolumeZone is the name of the Cinder availability zone.
+	VolumeZone string
+}
+
 // LoadBalancerClass defines a restricted network setting for generic LoadBalancer classes.
 type LoadBalancerClass struct {
 	// Name is the name of the LB class
@@ -109,6 +144,15 @@ type LoadBalancerClass struct {
 	// SubnetID is the ID of a local subnet used for LoadBalancer provisioning. Only usable if no FloatingPool
 	// configuration is done.
 	SubnetID *string
+	// IPv6SubnetID is the ID of a local IPv6 subnet used for the provisioning of IPv6 load balancers in dual-stack
+	// shoots. Only usable if no IPv6FloatingSubnetID is set.
+	IPv6SubnetID *string
+	// IPv6FloatingSubnetID is the ID of an IPv6 subnet in the floating network pool, in which IPv6 load balancers of
+	// dual-stack shoots get their public VIP.
+	IPv6FloatingSubnetID *string
+	// Settings override the load balancer settings of the ControlPlaneConfig. They can only be set for the default
+	// load balancer class, as the cloud-controller-manager applies them to all load balancers.
+	Settings *LoadBalancerSettings
 }
 
 // IsSemanticallyEqual checks if the load balancer class is semantically equal to
@@ -129,6 +173,12 @@ func (l LoadBalancerClass) IsSemanticallyEqual(e LoadBalancerClass) bool {
 	if !utils.StringEqual(l.SubnetID, e.SubnetID) {
 		return false
 	}
+	if !utils.StringEqual(l.IPv6SubnetID, e.IPv6SubnetID) {
+		return false
+	}
+	if !utils.StringEqual(l.IPv6FloatingSubnetID, e.IPv6FloatingSubnetID) {
+		return false
+	}
 	return true
 }
 
@@ -238,3 +288,50 @@ type StorageClassDefinition struct {
 	// +optional
 	VolumeBindingMode *string
 }
+
+// MachineType contains provider-specific settings of a machine type.
+type MachineType struct {
+	// Name is the name of the machine type.
+	Name string
+	// BareMetal specifies whether the flavor of the machine type is provisioned on bare metal nodes by Ironic.
+	BareMetal *bool
+}
+
+// VolumeType contains provider-specific settings of a volume type.
+type VolumeType struct {
+	// Name is the name of the volume type.
+	Name string
+	// Encrypted specifies whether Cinder encrypts the volumes of the volume type. Only encrypted volume types can be
+	// used by storage classes of shoots which require encryption.
+	Encrypted *bool
+}
+
+const (
+	// CapabilitySecureBoot is the name of the machine capability for UEFI secure boot. Machine types which require
+	// secure boot must only be combined with machine images which support it.
+	CapabilitySecureBoot = "secureBoot"
+	// CapabilityTPM is the name of the machine capability for a virtual TPM, which is used for measured boot.
+	CapabilityTPM = "tpm"
+	// CapabilityConfidentialComputing is the name of the machine capability for memory encryption of confidential
+	// computing machines.
+	CapabilityConfidentialComputing = "confidentialComputing"
+
+	// CapabilityValueEnabled is the value of the secure boot and TPM capabilities for machines which use the feature.
+	CapabilityValueEnabled = "enabled"
+	// CapabilityValueDisabled is the value of the secure boot and TPM capabilities for machines which do not use the feature.
+	CapabilityValueDisabled = "disabled"
+	// ConfidentialComputingNone is the value of the confidential computing capability for machines without memory encryption.
+	ConfidentialComputingNone = "none"
+	// ConfidentialComputingSEV is the value of the confidential computing capability for AMD SEV.
+	ConfidentialComputingSEV = "sev"
+	// ConfidentialComputingTDX is the value of the confidential computing capability for Intel TDX.
+	ConfidentialComputingTDX = "tdx"
+)
+
+// FlavorPCIAlias maps a PCI alias of flavors to the resource it provides on the nodes.
+type FlavorPCIAlias struct {
+	// Name is the name of the PCI alias.
+	Name string
+	// ResourceName is the name of the node resource provided by a device of this alias, e.g. `gpu`.
+	ResourceName string
+}
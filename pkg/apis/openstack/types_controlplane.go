@@ -25,6 +25,19 @@ type ControlPlaneConfig struct {
 	Zone *string
 	// Storage contains configuration for storage in the cluster.
 	Storage *Storage
+	// LoadBalancer contains settings for the load balancers of services of type `LoadBalancer`, which are created by
+	// the cloud-controller-manager.
+	LoadBalancer *LoadBalancerSettings
+	// KMS contains the configuration of the Barbican KMS plugin which encrypts the resources of the shoot in etcd with a
+	// key held in Barbican. It cannot be changed or removed once it is set.
+	KMS *KMS
+	// KeystoneAuth enables the authentication and authorization of users of the shoot with Keystone tokens.
+	KeystoneAuth *KeystoneAuth
+	// ApplicationCredentials enables dedicated application credentials with access rules limited to the required APIs
+	// for the cloud-controller-manager and the CSI drivers instead of passing them the credentials of the shoot.
+	ApplicationCredentials *ApplicationCredentials
+	// LoadBalancerPolicy restricts the load balancers of services of type `LoadBalancer` in the shoot.
+	LoadBalancerPolicy *LoadBalancerPolicy
 }
 
 const (
@@ -34,22 +47,174 @@ const (
 	PrivateLoadBalancerClass = "private"
 	// VPNLoadBalancerClass defines the floating pool class used by the VPN service.
 	VPNLoadBalancerClass = "vpn"
+	// IPv6LoadBalancerClass defines the load balancer class of IPv6 load balancers in the IPv6 node subnet of dual-stack
+	// shoots.
+	IPv6LoadBalancerClass = "ipv6"
 )
 
+// LoadBalancerSettings contains settings for the load balancers created by the cloud-controller-manager.
+type LoadBalancerSettings struct {
+	// FlavorID is the ID of the Octavia flavor used for the load balancers.
+	FlavorID *string
+	// AvailabilityZone is the Octavia availability zone in which the load balancers are created.
+	AvailabilityZone *string
+	// Method is the load balancing algorithm used to distribute traffic to the members, e.g. `ROUND_ROBIN`.
+	Method *string
+	// Monitor contains settings for the health monitors of the load balancers.
+	Monitor *LoadBalancerMonitor
+	// EnableIngressHostname specifies whether the ingress of services is reported as hostname instead of IP address.
+	// This is required for services which use the PROXY protocol, so that traffic from inside the cluster is not
+	// short-circuited by kube-proxy.
+	EnableIngressHostname *bool
+	// MaxSharedLB is the maximum number of services which may share a load balancer.
+	MaxSharedLB *int32
+}
+
+// LoadBalancerMonitor contains settings for the health monitors of the load balancers.
+type LoadBalancerMonitor struct {
+	// Enabled specifies whether health monitors are created for the load balancers.
+	Enabled *bool
+	// Delay is the time between sending probes to the members.
+	Delay *metav1.Duration
+	// Timeout is the maximum time to wait for a probe to respond.
+	Timeout *metav1.Duration
+	// MaxRetries is the number of successful probes before the status of a member is changed to online.
+	MaxRetries *int32
+	// MaxRetriesDown is the number of failed probes before the status of a member is changed to error.
+	MaxRetriesDown *int32
+}
+
+const (
+	// LoadBalancerMethodRoundRobin distributes the traffic to the members in turn.
+	LoadBalancerMethodRoundRobin = "ROUND_ROBIN"
+	// LoadBalancerMethodLeastConnections distributes the traffic to the member with the fewest connections.
+	LoadBalancerMethodLeastConnections = "LEAST_CONNECTIONS"
+	// LoadBalancerMethodSourceIP distributes the traffic based on a hash of the source IP address.
+	LoadBalancerMethodSourceIP = "SOURCE_IP"
+	// LoadBalancerMethodSourceIPPort distributes the traffic based on a hash of the source IP address and port. It is
+	// the only method supported by the `ovn` provider.
+	LoadBalancerMethodSourceIPPort = "SOURCE_IP_PORT"
+)
+
+// LoadBalancerPolicy contains the policy for services of type `LoadBalancer`, which is enforced by a webhook in the
+// shoot.
+type LoadBalancerPolicy struct {
+	// NamespaceDefaults are the default load balancer classes of services in namespaces with matching labels. The first
+	// matching entry is used for services which do not reference a load balancer class.
+	NamespaceDefaults []NamespaceLoadBalancerClass
+	// InternalNamespaceSelector selects the namespaces in which only internal load balancers without floating IP can
+	// be created.
+	InternalNamespaceSelector *metav1.LabelSelector
+}
+
+// NamespaceLoadBalancerClass is the default load balancer class of services in namespaces with matching labels.
+type NamespaceLoadBalancerClass struct {
+	// NamespaceSelector selects the namespaces.
+	NamespaceSelector metav1.LabelSelector
+	// LoadBalancerClass is the name of the load balancer class.
+	LoadBalancerClass string
+}
+
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
 type CloudControllerManagerConfig struct {
 	// FeatureGates contains information about enabled feature gates.
 	FeatureGates map[string]bool
 }
 
+// KMS contains the configuration of the Barbican KMS plugin.
+type KMS struct {
+	// KeyID is the ID of the Barbican secret which is used as key encryption key.
+	KeyID string
+}
+
+// KeystoneAuth contains the configuration of the Keystone authentication and authorization webhook.
+type KeystoneAuth struct {
+	// RoleMappings maps the Keystone roles of the users in the project of the shoot to Kubernetes groups.
+	RoleMappings []KeystoneRoleMapping
+	// AuthorizationPolicy is an authorization policy of k8s-keystone-auth in JSON format, which is evaluated after RBAC.
+	AuthorizationPolicy *string
+}
+
+// KeystoneRoleMapping maps a Keystone role to Kubernetes groups.
+type KeystoneRoleMapping struct {
+	// KeystoneRole is the name of the Keystone role.
+	KeystoneRole string
+	// Groups are the Kubernetes groups of the users with the Keystone role.
+	Groups []string
+}
+
+// ApplicationCredentials contains the configuration of the application credentials which are created for the
+// components of the shoot.
+type ApplicationCredentials struct {
+	// RotationPeriod is the period after which the application credentials are rotated. They expire after twice the
+	// rotation period. Defaults to 720h.
+	RotationPeriod *metav1.Duration
+}
+
 // Storage contains configuration for storage in the cluster.
 type Storage struct {
 	// CSIManila contains configuration for CSI Manila driver (support for NFS volumes)
 	CSIManila *CSIManila
+	// StorageClasses are storage classes defined for the shoot. They override storage classes of the CloudProfile with
+	// the same name.
+	StorageClasses []StorageClass
+}
+
+// StorageClass is a storage class defined for the shoot.
+type StorageClass struct {
+	// Name is the name of the storage class.
+	Name string
+	// Backend is the storage backend of the storage class, either `cinder` or `manila`. Defaults to `cinder`.
+	Backend *string
+	// Default marks the storage class as the default storage class of the shoot.
+	Default *bool
+	// VolumeType is the Cinder volume type or the Manila share type of the volumes.
+	VolumeType *string
+	// AvailabilityZone is the availability zone in which the volumes are created.
+	AvailabilityZone *string
+	// Encrypted specifies that the volumes must be encrypted. Cinder encrypts volumes depending on their volume type,
+	// hence the volume type must be set, too.
+	Encrypted *bool
+	// FSType is the filesystem type of Cinder volumes.
+	FSType *string
+	// ReclaimPolicy is the reclaim policy of the persistent volumes, either `Delete` or `Retain`.
+	ReclaimPolicy *string
+	// VolumeBindingMode is the volume binding mode of the storage class, either `Immediate` or `WaitForFirstConsumer`.
+	VolumeBindingMode *string
+	// ShareProtocol is the share protocol of Manila shares, either `NFS` or `CEPHFS`. Defaults to `NFS`.
+	ShareProtocol *string
+	// ShareNetworkID is the ID of the share network in which Manila shares are created. Defaults to the share network
+	// of the infrastructure. Not supported for CephFS shares.
+	ShareNetworkID *string
 }
 
+const (
+	// StorageBackendCinder is the storage backend for Cinder volumes.
+	StorageBackendCinder = "cinder"
+	// StorageBackendManila is the storage backend for Manila shares.
+	StorageBackendManila = "manila"
+
+	// ShareProtocolNFS is the share protocol for NFS shares.
+	ShareProtocolNFS = "NFS"
+	// ShareProtocolCephFS is the share protocol for CephFS shares.
+	ShareProtocolCephFS = "CEPHFS"
+
+	// CephFSMounterKernel mounts CephFS shares with the kernel client.
+	CephFSMounterKernel = "kernel"
+	// CephFSMounterFuse mounts CephFS shares with ceph-fuse.
+	CephFSMounterFuse = "fuse"
+)
+
 // CSIManila contains configuration for CSI Manila driver (support for NFS volumes)
 type CSIManila struct {
 	// Enabled is the switch to enable the CSI Manila driver support
 	Enabled bool
+	// CephFS enables the support for CephFS shares in addition to NFS shares.
+	CephFS *CSIManilaCephFS
+}
+
+// CSIManilaCephFS contains configuration for CephFS shares of the CSI Manila driver.
+type CSIManilaCephFS struct {
+	// Mounter is the client used to mount CephFS shares, either `kernel` or `fuse`. Defaults to `kernel`.
+	Mounter *string
 }
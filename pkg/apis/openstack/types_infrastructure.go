@@ -45,6 +45,21 @@ type Networks struct {
 	// IPv6 holds information about the IPv6 CIDRs.
 	// +optional
 	IPv6 *IPv6Config
+	// DNSServers is a list of IP addresses of DNS servers for the node subnets.
+	// If set, they override the DNS servers configured in the CloudProfile.
+	DNSServers []string
+	// HostRoutes is a list of routes which are announced to the nodes via DHCP for the IPv4 node subnet.
+	HostRoutes []Route
+	// AllocationPools is a list of IP address ranges of the IPv4 node subnet from which IP addresses are allocated for the nodes.
+	// If not set, the whole subnet is used.
+	AllocationPools []AllocationPool
+	// AvailabilityZoneHints are the availability zones in which the router and the network of the shoot are scheduled by Neutron.
+	// If not set, the zones of the worker pools are used for new shoots. The hints are only applied when the resources are created.
+	AvailabilityZoneHints []string
+	// QoSPolicy is a Neutron QoS policy which is attached to the network of the shoot.
+	QoSPolicy *QoSPolicy
+	// Firewall is a Neutron FWaaS v2 firewall group which is attached to the router ports of the shoot's subnets.
+	Firewall *Firewall
 }
 
 // SubnetPool specifies an OpenStack subnet pool from which a CIDR will be automatically allocated.
@@ -72,6 +87,90 @@ type IPv6Config struct {
 type Router struct {
 	// ID is the router id of an existing OpenStack router.
 	ID string
+	// Routes is a list of additional static routes which are added to the router.
+	// Routes which are managed by other components (e.g. the cloud-controller-manager) are preserved.
+	Routes []Route
+}
+
+// Route is a static route.
+type Route struct {
+	// Destination is the destination CIDR of the route.
+	Destination string
+	// NextHop is the IP address of the next hop of the route.
+	NextHop string
+}
+
+// AllocationPool is a range of IP addresses of a subnet.
+type AllocationPool struct {
+	// Start is the first IP address of the range.
+	Start string
+	// End is the last IP address of the range.
+	End string
+}
+
+// QoSPolicy either references an existing Neutron QoS policy or describes the rules of a QoS policy
+// which is created for the shoot.
+type QoSPolicy struct {
+	// ID is the ID of an existing QoS policy. Mutually exclusive with the rules.
+	ID *string
+	// BandwidthLimitRules limit the bandwidth of the ports in the network.
+	BandwidthLimitRules []BandwidthLimitRule
+	// MinimumBandwidthRules guarantee a minimum bandwidth for the ports in the network.
+	MinimumBandwidthRules []MinimumBandwidthRule
+	// DSCPMarkingRules mark the traffic of the ports in the network with a DSCP value.
+	DSCPMarkingRules []DSCPMarkingRule
+}
+
+// BandwidthLimitRule is a QoS rule limiting the bandwidth.
+type BandwidthLimitRule struct {
+	// MaxKbps is the maximum bandwidth in kilobits per second.
+	MaxKbps int
+	// MaxBurstKbps is the maximum burst size in kilobits.
+	MaxBurstKbps *int
+	// Direction is the direction of the traffic, either "egress" or "ingress". Defaults to "egress".
+	Direction *string
+}
+
+// MinimumBandwidthRule is a QoS rule guaranteeing a minimum bandwidth.
+type MinimumBandwidthRule struct {
+	// MinKbps is the minimum bandwidth in kilobits per second.
+	MinKbps int
+	// Direction is the direction of the traffic, either "egress" or "ingress". Defaults to "egress".
+	Direction *string
+}
+
+// DSCPMarkingRule is a QoS rule marking the traffic with a DSCP value.
+type DSCPMarkingRule struct {
+	// DSCPMark is the DSCP value the traffic is marked with.
+	DSCPMark int
+}
+
+// Firewall contains the rules of the firewall group of the shoot. The rules of each direction are evaluated in order.
+// Traffic between the node and pod networks of the shoot is always allowed. Traffic not matching any rule of a direction
+// is denied. If no rules are specified for a direction, all traffic in this direction is allowed.
+type Firewall struct {
+	// EgressRules are the rules for traffic leaving the shoot's subnets through the router.
+	EgressRules []FirewallRule
+	// IngressRules are the rules for traffic entering the shoot's subnets through the router.
+	IngressRules []FirewallRule
+}
+
+// FirewallRule is a rule of a firewall policy.
+type FirewallRule struct {
+	// Action is the action for matching traffic, one of "allow", "deny" or "reject".
+	Action string
+	// Protocol is the protocol of the traffic, one of "tcp", "udp", "icmp" or "any". Defaults to "any".
+	Protocol *string
+	// IPVersion is the IP version of the traffic, either 4 or 6. Defaults to 4.
+	IPVersion *int
+	// SourceCIDR is the source CIDR of the traffic.
+	SourceCIDR *string
+	// DestinationCIDR is the destination CIDR of the traffic.
+	DestinationCIDR *string
+	// SourcePort is the source port or port range (e.g. "8000:8080") of the traffic. Only valid for tcp and udp.
+	SourcePort *string
+	// DestinationPort is the destination port or port range (e.g. "8000:8080") of the traffic. Only valid for tcp and udp.
+	DestinationPort *string
 }
 
 // ShareNetwork holds information about the share network (used for shared file systems like NFS)
@@ -113,6 +212,8 @@ type NetworkStatus struct {
 	Subnets []Subnet
 	// ShareNetwork contains information about a created/provided ShareNetwork
 	ShareNetwork *ShareNetworkStatus
+	// AvailabilityZones are the availability zones in which the network is scheduled.
+	AvailabilityZones []string
 }
 
 // RouterStatus contains information about a generated Router or resources attached to an existing Router.
@@ -124,6 +225,8 @@ type RouterStatus struct {
 	IP string
 	// ExternalFixedIPs is the list of the router's assigned external fixed IPs.
 	ExternalFixedIPs []string
+	// AvailabilityZones are the availability zones in which the router is scheduled.
+	AvailabilityZones []string
 }
 
 // FloatingPoolStatus contains information about the floating pool.
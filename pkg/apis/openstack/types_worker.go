@@ -25,6 +25,18 @@ type WorkerStatus struct {
 
 	// ServerGroupDependencies is a list of external machine dependencies.
 	ServerGroupDependencies []ServerGroupDependency
+
+	// WorkerPoolZones is a list of the zones of the worker pools and the indices used in the names of their machine
+	// deployments. It keeps the names of existing machine deployments stable when zones are added to or removed from a pool.
+	WorkerPoolZones []WorkerPoolZones
+
+	// ExhaustedZones is a list of the zones in which machines of worker pools with zone fallback could not be created
+	// because Nova found no valid host.
+	ExhaustedZones []ExhaustedZone
+
+	// Flavors is a list of the flavors of the machine types used by the worker pools. They are read from Nova on every
+	// reconciliation and used if Nova is not available.
+	Flavors []Flavor
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -52,6 +64,51 @@ type ServerGroupDependency struct {
 	ID string
 	// Name is the name of the server group
 	Name string
+	// Index is the index of the server group among the server groups of the worker pool. A worker pool uses several
+	// server groups if it can have more machines than a single server group can have members.
+	Index int32
+	// Zone is the availability zone of the server group if the worker pool uses one server group per zone.
+	Zone string
+}
+
+// ExhaustedZone is a zone in which machines of a worker pool could not be created because Nova found no valid host.
+type ExhaustedZone struct {
+	// PoolName is the name of the worker pool.
+	PoolName string
+	// Zone is the name of the zone.
+	Zone string
+	// LastDetectionTime is the time at which the capacity shortage was detected last.
+	LastDetectionTime metav1.Time
+}
+
+// Flavor contains the properties of the flavor of a machine type.
+type Flavor struct {
+	// Name is the name of the flavor.
+	Name string
+	// VCPUs is the number of virtual CPUs of the flavor.
+	VCPUs int32
+	// RAM is the memory of the flavor in MiB.
+	RAM int32
+	// Disk is the size of the root disk of the flavor in GiB.
+	Disk int32
+	// ExtraSpecs are the extra specs of the flavor.
+	ExtraSpecs map[string]string
+}
+
+// WorkerPoolZones contains the zones of a worker pool.
+type WorkerPoolZones struct {
+	// PoolName is the name of the worker pool.
+	PoolName string
+	// Zones is the list of zones of the worker pool.
+	Zones []ZoneIndex
+}
+
+// ZoneIndex is a mapping from a zone to the index used in the name of the machine deployment for this zone.
+type ZoneIndex struct {
+	// Name is the name of the zone.
+	Name string
+	// Index is the index of the machine deployment for this zone.
+	Index int32
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -75,6 +132,19 @@ type WorkerConfig struct {
 	// auto-managed "nodes" security group.
 	// +optional
 	AdditionalSecurityGroups []string
+
+	// UseConfigDrive specifies whether the user data and the metadata are provided to the machines via a config drive
+	// instead of the metadata service.
+	UseConfigDrive *bool
+
+	// ZoneFallback specifies whether the minimum and maximum of the worker pool are shifted from zones in which Nova
+	// finds no valid host for its machines to the other zones of the worker pool.
+	ZoneFallback *bool
+
+	// StoreOversizedUserData specifies whether the user data of the machines is stored in Swift if it exceeds the limit
+	// of Nova even after compression. It contains the bootstrap credentials of the machines and can be downloaded by
+	// anyone who knows its temporary URL. Storing oversized user data must be enabled in the CloudProfile as well.
+	StoreOversizedUserData *bool
 }
 
 // MachineLabel define key value pair to label machines.
@@ -97,4 +167,15 @@ type ServerGroup struct {
 	// Policy describes the kind of affinity policy for instances of the server group.
 	// https://docs.openstack.org/python-openstackclient/ussuri/cli/command-objects/server-group.html
 	Policy string
+	// MaxServerPerHost is the maximum number of machines of the server group which may run on the same host. It is
+	// only supported for the `anti-affinity` policy.
+	MaxServerPerHost *int32
+	// PerZone specifies that one server group is created per availability zone of the worker pool instead of a
+	// single one for the whole worker pool. This allows the `affinity` policy for worker pools with several zones.
+	PerZone *bool
+	// MaxMembers is the maximum number of members of a server group. If the machines of the worker pool, including the
+	// machines which are surged during a rolling update, exceed it, the worker pool is spread over several server groups.
+	// By default, a single server group is used. It is only supported for the `anti-affinity` and `soft-anti-affinity`
+	// policies, as the members of different server groups are not placed with affinity.
+	MaxMembers *int32
 }
@@ -51,6 +51,16 @@ type CloudProfileConfig struct {
 	// to allow for differences between volume and compute zone naming.
 	// +optional
 	IgnoreVolumeAZ *bool `json:"ignoreVolumeAZ,omitempty"`
+	// IgnoreNetworkAZ specifies whether the availability zone hints of the router and the network of new shoots are not
+	// derived from the zones of their worker pools, e.g. because the network availability zones of Neutron are named
+	// differently than the compute availability zones.
+	// +optional
+	IgnoreNetworkAZ *bool `json:"ignoreNetworkAZ,omitempty"`
+	// VolumeAZMappings maps the compute availability zones to the block storage availability zones, for OpenStack
+	// installations which name the zones of Nova and Cinder differently. The mapped zones are used for the topology of
+	// the Cinder CSI driver.
+	// +optional
+	VolumeAZMappings []VolumeAZMapping `json:"volumeAZMappings,omitempty"`
 	// NodeVolumeAttachLimit specifies how many volumes can be attached to a node.
 	// +optional
 	NodeVolumeAttachLimit *int32 `json:"nodeVolumeAttachLimit,omitempty"`
@@ -66,6 +76,28 @@ type CloudProfileConfig struct {
 	// StorageClasses defines storageclasses for the shoot
 	// +optional
 	StorageClasses []StorageClassDefinition `json:"storageClasses,omitempty"`
+	// FlavorPCIAliases maps the PCI aliases used in the `pci_passthrough:alias` extra spec of flavors to the resources
+	// they provide on the nodes. It is used to derive the node templates of worker pools for scaling from zero.
+	// +optional
+	FlavorPCIAliases []FlavorPCIAlias `json:"flavorPCIAliases,omitempty"`
+	// UseFlavorCapacity specifies whether the node templates of worker pools are derived from the flavors of their
+	// machine types instead of the capacity of the machine types in the CloudProfile.
+	// +optional
+	UseFlavorCapacity *bool `json:"useFlavorCapacity,omitempty"`
+	// ServerMetadata is additional server metadata which is set on all worker machines. It is not set as Nova server tags.
+	// +optional
+	ServerMetadata map[string]string `json:"serverMetadata,omitempty"`
+	// StoreOversizedUserData specifies whether the user data of worker machines which exceeds the limit of Nova even
+	// after compression may be stored in Swift. The machines download it via a temporary URL, hence Swift must support
+	// temporary URLs and be reachable from the worker nodes. Worker pools must opt in to it in their WorkerConfig.
+	// +optional
+	StoreOversizedUserData *bool `json:"storeOversizedUserData,omitempty"`
+	// MachineTypes contains provider-specific settings of the machine types of the CloudProfile.
+	// +optional
+	MachineTypes []MachineType `json:"machineTypes,omitempty"`
+	// VolumeTypes contains provider-specific settings of the volume types of the CloudProfile.
+	// +optional
+	VolumeTypes []VolumeType `json:"volumeTypes,omitempty"`
 }
 
 // Constraints is an object containing constraints for the shoots.
@@ -107,6 +139,16 @@ type KeyStoneURL struct {
 	CACert *string `json:"caCert,omitempty"`
 }
 
+// VolumeAZMapping maps a compute availability zone of a region to the block storage availability zone.
+type VolumeAZMapping struct {
+	// Region is the name of the region.
+	Region string `json:"region"`
+	// ComputeZone is the name of the Nova availability zone.
+	ComputeZone string `json:"computeZone"`
+	// VolumeZone is the name of the Cinder availability zone.
+	VolumeZone string `json:"volumeZone"`
+}
+
 // LoadBalancerClass defines a restricted network setting for generic LoadBalancer classes.
 type LoadBalancerClass struct {
 	// Name is the name of the LB class
@@ -130,6 +172,18 @@ type LoadBalancerClass struct {
 	// configuration is done.
 	// +optional
 	SubnetID *string `json:"subnetID,omitempty"`
+	// IPv6SubnetID is the ID of a local IPv6 subnet used for the provisioning of IPv6 load balancers in dual-stack
+	// shoots. Only usable if no IPv6FloatingSubnetID is set.
+	// +optional
+	IPv6SubnetID *string `json:"ipv6SubnetID,omitempty"`
+	// IPv6FloatingSubnetID is the ID of an IPv6 subnet in the floating network pool, in which IPv6 load balancers of
+	// dual-stack shoots get their public VIP.
+	// +optional
+	IPv6FloatingSubnetID *string `json:"ipv6FloatingSubnetID,omitempty"`
+	// Settings override the load balancer settings of the ControlPlaneConfig. They can only be set for the default
+	// load balancer class, as the cloud-controller-manager applies them to all load balancers.
+	// +optional
+	Settings *LoadBalancerSettings `json:"settings,omitempty"`
 }
 
 // LoadBalancerProvider contains constraints regarding allowed values of the 'loadBalancerProvider' block in the control plane config.
@@ -217,3 +271,30 @@ type StorageClassDefinition struct {
 	// +optional
 	VolumeBindingMode *string `json:"volumeBindingMode,omitempty"`
 }
+
+// MachineType contains provider-specific settings of a machine type.
+type MachineType struct {
+	// Name is the name of the machine type.
+	Name string `json:"name"`
+	// BareMetal specifies whether the flavor of the machine type is provisioned on bare metal nodes by Ironic.
+	// +optional
+	BareMetal *bool `json:"bareMetal,omitempty"`
+}
+
+// VolumeType contains provider-specific settings of a volume type.
+type VolumeType struct {
+	// Name is the name of the volume type.
+	Name string `json:"name"`
+	// Encrypted specifies whether Cinder encrypts the volumes of the volume type. Only encrypted volume types can be
+	// used by storage classes of shoots which require encryption.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+}
+
+// FlavorPCIAlias maps a PCI alias of flavors to the resource it provides on the nodes.
+type FlavorPCIAlias struct {
+	// Name is the name of the PCI alias.
+	Name string `json:"name"`
+	// ResourceName is the name of the node resource provided by a device of this alias, e.g. `gpu`.
+	ResourceName string `json:"resourceName"`
+}
@@ -30,6 +30,88 @@ type ControlPlaneConfig struct {
 	// Storage contains configuration for storage in the cluster.
 	// +optional
 	Storage *Storage `json:"storage,omitempty"`
+	// LoadBalancer contains settings for the load balancers of services of type `LoadBalancer`, which are created by
+	// the cloud-controller-manager.
+	// +optional
+	LoadBalancer *LoadBalancerSettings `json:"loadBalancer,omitempty"`
+	// KMS contains the configuration of the Barbican KMS plugin which encrypts the resources of the shoot in etcd with a
+	// key held in Barbican. It cannot be changed or removed once it is set.
+	// +optional
+	KMS *KMS `json:"kms,omitempty"`
+	// KeystoneAuth enables the authentication and authorization of users of the shoot with Keystone tokens.
+	// +optional
+	KeystoneAuth *KeystoneAuth `json:"keystoneAuth,omitempty"`
+	// ApplicationCredentials enables dedicated application credentials with access rules limited to the required APIs
+	// for the cloud-controller-manager and the CSI drivers instead of passing them the credentials of the shoot.
+	// +optional
+	ApplicationCredentials *ApplicationCredentials `json:"applicationCredentials,omitempty"`
+	// LoadBalancerPolicy restricts the load balancers of services of type `LoadBalancer` in the shoot.
+	// +optional
+	LoadBalancerPolicy *LoadBalancerPolicy `json:"loadBalancerPolicy,omitempty"`
+}
+
+// LoadBalancerSettings contains settings for the load balancers created by the cloud-controller-manager.
+type LoadBalancerSettings struct {
+	// FlavorID is the ID of the Octavia flavor used for the load balancers.
+	// +optional
+	FlavorID *string `json:"flavorID,omitempty"`
+	// AvailabilityZone is the Octavia availability zone in which the load balancers are created.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+	// Method is the load balancing algorithm used to distribute traffic to the members, e.g. `ROUND_ROBIN`.
+	// +optional
+	Method *string `json:"method,omitempty"`
+	// Monitor contains settings for the health monitors of the load balancers.
+	// +optional
+	Monitor *LoadBalancerMonitor `json:"monitor,omitempty"`
+	// EnableIngressHostname specifies whether the ingress of services is reported as hostname instead of IP address.
+	// This is required for services which use the PROXY protocol, so that traffic from inside the cluster is not
+	// short-circuited by kube-proxy.
+	// +optional
+	EnableIngressHostname *bool `json:"enableIngressHostname,omitempty"`
+	// MaxSharedLB is the maximum number of services which may share a load balancer.
+	// +optional
+	MaxSharedLB *int32 `json:"maxSharedLB,omitempty"`
+}
+
+// LoadBalancerMonitor contains settings for the health monitors of the load balancers.
+type LoadBalancerMonitor struct {
+	// Enabled specifies whether health monitors are created for the load balancers.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// Delay is the time between sending probes to the members.
+	// +optional
+	Delay *metav1.Duration `json:"delay,omitempty"`
+	// Timeout is the maximum time to wait for a probe to respond.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// MaxRetries is the number of successful probes before the status of a member is changed to online.
+	// +optional
+	MaxRetries *int32 `json:"maxRetries,omitempty"`
+	// MaxRetriesDown is the number of failed probes before the status of a member is changed to error.
+	// +optional
+	MaxRetriesDown *int32 `json:"maxRetriesDown,omitempty"`
+}
+
+// LoadBalancerPolicy contains the policy for services of type `LoadBalancer`, which is enforced by a webhook in the
+// shoot.
+type LoadBalancerPolicy struct {
+	// NamespaceDefaults are the default load balancer classes of services in namespaces with matching labels. The first
+	// matching entry is used for services which do not reference a load balancer class.
+	// +optional
+	NamespaceDefaults []NamespaceLoadBalancerClass `json:"namespaceDefaults,omitempty"`
+	// InternalNamespaceSelector selects the namespaces in which only internal load balancers without floating IP can
+	// be created.
+	// +optional
+	InternalNamespaceSelector *metav1.LabelSelector `json:"internalNamespaceSelector,omitempty"`
+}
+
+// NamespaceLoadBalancerClass is the default load balancer class of services in namespaces with matching labels.
+type NamespaceLoadBalancerClass struct {
+	// NamespaceSelector selects the namespaces.
+	NamespaceSelector metav1.LabelSelector `json:"namespaceSelector"`
+	// LoadBalancerClass is the name of the load balancer class.
+	LoadBalancerClass string `json:"loadBalancerClass"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -39,15 +121,100 @@ type CloudControllerManagerConfig struct {
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
 }
 
+// KMS contains the configuration of the Barbican KMS plugin.
+type KMS struct {
+	// KeyID is the ID of the Barbican secret which is used as key encryption key.
+	KeyID string `json:"keyID"`
+}
+
+// KeystoneAuth contains the configuration of the Keystone authentication and authorization webhook.
+type KeystoneAuth struct {
+	// RoleMappings maps the Keystone roles of the users in the project of the shoot to Kubernetes groups.
+	// +optional
+	RoleMappings []KeystoneRoleMapping `json:"roleMappings,omitempty"`
+	// AuthorizationPolicy is an authorization policy of k8s-keystone-auth in JSON format, which is evaluated after RBAC.
+	// +optional
+	AuthorizationPolicy *string `json:"authorizationPolicy,omitempty"`
+}
+
+// KeystoneRoleMapping maps a Keystone role to Kubernetes groups.
+type KeystoneRoleMapping struct {
+	// KeystoneRole is the name of the Keystone role.
+	KeystoneRole string `json:"keystoneRole"`
+	// Groups are the Kubernetes groups of the users with the Keystone role.
+	Groups []string `json:"groups"`
+}
+
+// ApplicationCredentials contains the configuration of the application credentials which are created for the
+// components of the shoot.
+type ApplicationCredentials struct {
+	// RotationPeriod is the period after which the application credentials are rotated. They expire after twice the
+	// rotation period. Defaults to 720h.
+	// +optional
+	RotationPeriod *metav1.Duration `json:"rotationPeriod,omitempty"`
+}
+
 // Storage contains configuration for storage in the cluster.
 type Storage struct {
 	// CSIManila contains configuration for CSI Manila driver (support for NFS volumes)
 	// +optional
 	CSIManila *CSIManila `json:"csiManila,omitempty"`
+	// StorageClasses are storage classes defined for the shoot. They override storage classes of the CloudProfile with
+	// the same name.
+	// +optional
+	StorageClasses []StorageClass `json:"storageClasses,omitempty"`
+}
+
+// StorageClass is a storage class defined for the shoot.
+type StorageClass struct {
+	// Name is the name of the storage class.
+	Name string `json:"name"`
+	// Backend is the storage backend of the storage class, either `cinder` or `manila`. Defaults to `cinder`.
+	// +optional
+	Backend *string `json:"backend,omitempty"`
+	// Default marks the storage class as the default storage class of the shoot.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+	// VolumeType is the Cinder volume type or the Manila share type of the volumes.
+	// +optional
+	VolumeType *string `json:"volumeType,omitempty"`
+	// AvailabilityZone is the availability zone in which the volumes are created.
+	// +optional
+	AvailabilityZone *string `json:"availabilityZone,omitempty"`
+	// Encrypted specifies that the volumes must be encrypted. Cinder encrypts volumes depending on their volume type,
+	// hence the volume type must be set, too.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+	// FSType is the filesystem type of Cinder volumes.
+	// +optional
+	FSType *string `json:"fsType,omitempty"`
+	// ReclaimPolicy is the reclaim policy of the persistent volumes, either `Delete` or `Retain`.
+	// +optional
+	ReclaimPolicy *string `json:"reclaimPolicy,omitempty"`
+	// VolumeBindingMode is the volume binding mode of the storage class, either `Immediate` or `WaitForFirstConsumer`.
+	// +optional
+	VolumeBindingMode *string `json:"volumeBindingMode,omitempty"`
+	// ShareProtocol is the share protocol of Manila shares, either `NFS` or `CEPHFS`. Defaults to `NFS`.
+	// +optional
+	ShareProtocol *string `json:"shareProtocol,omitempty"`
+	// ShareNetworkID is the ID of the share network in which Manila shares are created. Defaults to the share network
+	// of the infrastructure. Not supported for CephFS shares.
+	// +optional
+	ShareNetworkID *string `json:"shareNetworkID,omitempty"`
 }
 
 // CSIManila contains configuration for CSI Manila driver (support for NFS volumes)
 type CSIManila struct {
 	// Enabled is the switch to enable the CSI Manila driver support
 	Enabled bool `json:"enabled"`
+	// CephFS enables the support for CephFS shares in addition to NFS shares.
+	// +optional
+	CephFS *CSIManilaCephFS `json:"cephFS,omitempty"`
+}
+
+// CSIManilaCephFS contains configuration for CephFS shares of the CSI Manila driver.
+type CSIManilaCephFS struct {
+	// Mounter is the client used to mount CephFS shares, either `kernel` or `fuse`. Defaults to `kernel`.
+	// +optional
+	Mounter *string `json:"mounter,omitempty"`
 }
@@ -50,6 +50,27 @@ type Networks struct {
 	// IPv6 holds information about the IPv6 CIDRs.
 	// +optional
 	IPv6 *IPv6Config `json:"ipv6,omitempty"`
+	// DNSServers is a list of IP addresses of DNS servers for the node subnets.
+	// If set, they override the DNS servers configured in the CloudProfile.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
+	// HostRoutes is a list of routes which are announced to the nodes via DHCP for the IPv4 node subnet.
+	// +optional
+	HostRoutes []Route `json:"hostRoutes,omitempty"`
+	// AllocationPools is a list of IP address ranges of the IPv4 node subnet from which IP addresses are allocated for the nodes.
+	// If not set, the whole subnet is used.
+	// +optional
+	AllocationPools []AllocationPool `json:"allocationPools,omitempty"`
+	// AvailabilityZoneHints are the availability zones in which the router and the network of the shoot are scheduled by Neutron.
+	// If not set, the zones of the worker pools are used for new shoots. The hints are only applied when the resources are created.
+	// +optional
+	AvailabilityZoneHints []string `json:"availabilityZoneHints,omitempty"`
+	// QoSPolicy is a Neutron QoS policy which is attached to the network of the shoot.
+	// +optional
+	QoSPolicy *QoSPolicy `json:"qosPolicy,omitempty"`
+	// Firewall is a Neutron FWaaS v2 firewall group which is attached to the router ports of the shoot's subnets.
+	// +optional
+	Firewall *Firewall `json:"firewall,omitempty"`
 }
 
 // SubnetPool specifies an OpenStack subnet pool from which a CIDR will be automatically allocated.
@@ -80,7 +101,108 @@ type IPv6Config struct {
 // Router indicates whether to use an existing router or create a new one.
 type Router struct {
 	// ID is the router id of an existing OpenStack router.
-	ID string `json:"id"`
+	// +optional
+	ID string `json:"id,omitempty"`
+	// Routes is a list of additional static routes which are added to the router.
+	// Routes which are managed by other components (e.g. the cloud-controller-manager) are preserved.
+	// +optional
+	Routes []Route `json:"routes,omitempty"`
+}
+
+// Route is a static route.
+type Route struct {
+	// Destination is the destination CIDR of the route.
+	Destination string `json:"destination"`
+	// NextHop is the IP address of the next hop of the route.
+	NextHop string `json:"nextHop"`
+}
+
+// AllocationPool is a range of IP addresses of a subnet.
+type AllocationPool struct {
+	// Start is the first IP address of the range.
+	Start string `json:"start"`
+	// End is the last IP address of the range.
+	End string `json:"end"`
+}
+
+// QoSPolicy either references an existing Neutron QoS policy or describes the rules of a QoS policy
+// which is created for the shoot.
+type QoSPolicy struct {
+	// ID is the ID of an existing QoS policy. Mutually exclusive with the rules.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// BandwidthLimitRules limit the bandwidth of the ports in the network.
+	// +optional
+	BandwidthLimitRules []BandwidthLimitRule `json:"bandwidthLimitRules,omitempty"`
+	// MinimumBandwidthRules guarantee a minimum bandwidth for the ports in the network.
+	// +optional
+	MinimumBandwidthRules []MinimumBandwidthRule `json:"minimumBandwidthRules,omitempty"`
+	// DSCPMarkingRules mark the traffic of the ports in the network with a DSCP value.
+	// +optional
+	DSCPMarkingRules []DSCPMarkingRule `json:"dscpMarkingRules,omitempty"`
+}
+
+// BandwidthLimitRule is a QoS rule limiting the bandwidth.
+type BandwidthLimitRule struct {
+	// MaxKbps is the maximum bandwidth in kilobits per second.
+	MaxKbps int `json:"maxKbps"`
+	// MaxBurstKbps is the maximum burst size in kilobits.
+	// +optional
+	MaxBurstKbps *int `json:"maxBurstKbps,omitempty"`
+	// Direction is the direction of the traffic, either "egress" or "ingress". Defaults to "egress".
+	// +optional
+	Direction *string `json:"direction,omitempty"`
+}
+
+// MinimumBandwidthRule is a QoS rule guaranteeing a minimum bandwidth.
+type MinimumBandwidthRule struct {
+	// MinKbps is the minimum bandwidth in kilobits per second.
+	MinKbps int `json:"minKbps"`
+	// Direction is the direction of the traffic, either "egress" or "ingress". Defaults to "egress".
+	// +optional
+	Direction *string `json:"direction,omitempty"`
+}
+
+// DSCPMarkingRule is a QoS rule marking the traffic with a DSCP value.
+type DSCPMarkingRule struct {
+	// DSCPMark is the DSCP value the traffic is marked with.
+	DSCPMark int `json:"dscpMark"`
+}
+
+// Firewall contains the rules of the firewall group of the shoot. The rules of each direction are evaluated in order.
+// Traffic between the node and pod networks of the shoot is always allowed. Traffic not matching any rule of a direction
+// is denied. If no rules are specified for a direction, all traffic in this direction is allowed.
+type Firewall struct {
+	// EgressRules are the rules for traffic leaving the shoot's subnets through the router.
+	// +optional
+	EgressRules []FirewallRule `json:"egressRules,omitempty"`
+	// IngressRules are the rules for traffic entering the shoot's subnets through the router.
+	// +optional
+	IngressRules []FirewallRule `json:"ingressRules,omitempty"`
+}
+
+// FirewallRule is a rule of a firewall policy.
+type FirewallRule struct {
+	// Action is the action for matching traffic, one of "allow", "deny" or "reject".
+	Action string `json:"action"`
+	// Protocol is the protocol of the traffic, one of "tcp", "udp", "icmp" or "any". Defaults to "any".
+	// +optional
+	Protocol *string `json:"protocol,omitempty"`
+	// IPVersion is the IP version of the traffic, either 4 or 6. Defaults to 4.
+	// +optional
+	IPVersion *int `json:"ipVersion,omitempty"`
+	// SourceCIDR is the source CIDR of the traffic.
+	// +optional
+	SourceCIDR *string `json:"sourceCIDR,omitempty"`
+	// DestinationCIDR is the destination CIDR of the traffic.
+	// +optional
+	DestinationCIDR *string `json:"destinationCIDR,omitempty"`
+	// SourcePort is the source port or port range (e.g. "8000:8080") of the traffic. Only valid for tcp and udp.
+	// +optional
+	SourcePort *string `json:"sourcePort,omitempty"`
+	// DestinationPort is the destination port or port range (e.g. "8000:8080") of the traffic. Only valid for tcp and udp.
+	// +optional
+	DestinationPort *string `json:"destinationPort,omitempty"`
 }
 
 // ShareNetwork holds information about the share network (used for shared file systems like NFS)
@@ -123,6 +245,9 @@ type NetworkStatus struct {
 	// ShareNetwork contains information about a created/provided ShareNetwork
 	// +optional
 	ShareNetwork *ShareNetworkStatus `json:"shareNetwork,omitempty"`
+	// AvailabilityZones are the availability zones in which the network is scheduled.
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
 }
 
 // RouterStatus contains information about a generated Router or resources attached to an existing Router.
@@ -134,6 +259,9 @@ type RouterStatus struct {
 	IP string `json:"ip"`
 	// ExternalFixedIPs is the list of the router's assigned external fixed IPs.
 	ExternalFixedIPs []string `json:"externalFixedIP"`
+	// AvailabilityZones are the availability zones in which the router is scheduled.
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
 }
 
 // FloatingPoolStatus contains information about the floating pool.
@@ -28,6 +28,21 @@ type WorkerStatus struct {
 	// ServerGroupDependencies is a list of external server group dependencies.
 	// +optional
 	ServerGroupDependencies []ServerGroupDependency `json:"serverGroupDependencies,omitempty"`
+
+	// WorkerPoolZones is a list of the zones of the worker pools and the indices used in the names of their machine
+	// deployments. It keeps the names of existing machine deployments stable when zones are added to or removed from a pool.
+	// +optional
+	WorkerPoolZones []WorkerPoolZones `json:"workerPoolZones,omitempty"`
+
+	// ExhaustedZones is a list of the zones in which machines of worker pools with zone fallback could not be created
+	// because Nova found no valid host.
+	// +optional
+	ExhaustedZones []ExhaustedZone `json:"exhaustedZones,omitempty"`
+
+	// Flavors is a list of the flavors of the machine types used by the worker pools. They are read from Nova on every
+	// reconciliation and used if Nova is not available.
+	// +optional
+	Flavors []Flavor `json:"flavors,omitempty"`
 }
 
 // MachineImage is a mapping from logical names and versions to provider-specific machine image data.
@@ -55,6 +70,54 @@ type ServerGroupDependency struct {
 	ID string `json:"id"`
 	// Name is the name of the server group
 	Name string `json:"name"`
+	// Index is the index of the server group among the server groups of the worker pool. A worker pool uses several
+	// server groups if it can have more machines than a single server group can have members.
+	// +optional
+	Index int32 `json:"index,omitempty"`
+	// Zone is the availability zone of the server group if the worker pool uses one server group per zone.
+	// +optional
+	Zone string `json:"zone,omitempty"`
+}
+
+// ExhaustedZone is a zone in which machines of a worker pool could not be created because Nova found no valid host.
+type ExhaustedZone struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// Zone is the name of the zone.
+	Zone string `json:"zone"`
+	// LastDetectionTime is the time at which the capacity shortage was detected last.
+	LastDetectionTime metav1.Time `json:"lastDetectionTime"`
+}
+
+// Flavor contains the properties of the flavor of a machine type.
+type Flavor struct {
+	// Name is the name of the flavor.
+	Name string `json:"name"`
+	// VCPUs is the number of virtual CPUs of the flavor.
+	VCPUs int32 `json:"vcpus"`
+	// RAM is the memory of the flavor in MiB.
+	RAM int32 `json:"ram"`
+	// Disk is the size of the root disk of the flavor in GiB.
+	Disk int32 `json:"disk"`
+	// ExtraSpecs are the extra specs of the flavor.
+	// +optional
+	ExtraSpecs map[string]string `json:"extraSpecs,omitempty"`
+}
+
+// WorkerPoolZones contains the zones of a worker pool.
+type WorkerPoolZones struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// Zones is the list of zones of the worker pool.
+	Zones []ZoneIndex `json:"zones"`
+}
+
+// ZoneIndex is a mapping from a zone to the index used in the name of the machine deployment for this zone.
+type ZoneIndex struct {
+	// Name is the name of the zone.
+	Name string `json:"name"`
+	// Index is the index of the machine deployment for this zone.
+	Index int32 `json:"index"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -78,6 +141,22 @@ type WorkerConfig struct {
 	// auto-managed "nodes" security group.
 	// +optional
 	AdditionalSecurityGroups []string `json:"additionalSecurityGroups,omitempty"`
+
+	// UseConfigDrive specifies whether the user data and the metadata are provided to the machines via a config drive
+	// instead of the metadata service.
+	// +optional
+	UseConfigDrive *bool `json:"useConfigDrive,omitempty"`
+
+	// ZoneFallback specifies whether the minimum and maximum of the worker pool are shifted from zones in which Nova
+	// finds no valid host for its machines to the other zones of the worker pool.
+	// +optional
+	ZoneFallback *bool `json:"zoneFallback,omitempty"`
+
+	// StoreOversizedUserData specifies whether the user data of the machines is stored in Swift if it exceeds the limit
+	// of Nova even after compression. It contains the bootstrap credentials of the machines and can be downloaded by
+	// anyone who knows its temporary URL. Storing oversized user data must be enabled in the CloudProfile as well.
+	// +optional
+	StoreOversizedUserData *bool `json:"storeOversizedUserData,omitempty"`
 }
 
 // MachineLabel define key value pair to label machines.
@@ -95,4 +174,18 @@ type ServerGroup struct {
 	// Policy describes the kind of affinity policy for instances of the server group.
 	// https://docs.openstack.org/python-openstackclient/ussuri/cli/command-objects/server-group.html
 	Policy string `json:"policy"`
+	// MaxServerPerHost is the maximum number of machines of the server group which may run on the same host. It is
+	// only supported for the `anti-affinity` policy.
+	// +optional
+	MaxServerPerHost *int32 `json:"maxServerPerHost,omitempty"`
+	// PerZone specifies that one server group is created per availability zone of the worker pool instead of a
+	// single one for the whole worker pool. This allows the `affinity` policy for worker pools with several zones.
+	// +optional
+	PerZone *bool `json:"perZone,omitempty"`
+	// MaxMembers is the maximum number of members of a server group. If the machines of the worker pool, including the
+	// machines which are surged during a rolling update, exceed it, the worker pool is spread over several server groups.
+	// By default, a single server group is used. It is only supported for the `anti-affinity` and `soft-anti-affinity`
+	// policies, as the members of different server groups are not placed with affinity.
+	// +optional
+	MaxMembers *int32 `json:"maxMembers,omitempty"`
 }
@@ -27,6 +27,36 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AllocationPool)(nil), (*openstack.AllocationPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_AllocationPool_To_openstack_AllocationPool(a.(*AllocationPool), b.(*openstack.AllocationPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.AllocationPool)(nil), (*AllocationPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_AllocationPool_To_v1alpha1_AllocationPool(a.(*openstack.AllocationPool), b.(*AllocationPool), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ApplicationCredentials)(nil), (*openstack.ApplicationCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApplicationCredentials_To_openstack_ApplicationCredentials(a.(*ApplicationCredentials), b.(*openstack.ApplicationCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ApplicationCredentials)(nil), (*ApplicationCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ApplicationCredentials_To_v1alpha1_ApplicationCredentials(a.(*openstack.ApplicationCredentials), b.(*ApplicationCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BandwidthLimitRule)(nil), (*openstack.BandwidthLimitRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BandwidthLimitRule_To_openstack_BandwidthLimitRule(a.(*BandwidthLimitRule), b.(*openstack.BandwidthLimitRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.BandwidthLimitRule)(nil), (*BandwidthLimitRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_BandwidthLimitRule_To_v1alpha1_BandwidthLimitRule(a.(*openstack.BandwidthLimitRule), b.(*BandwidthLimitRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CSIManila)(nil), (*openstack.CSIManila)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CSIManila_To_openstack_CSIManila(a.(*CSIManila), b.(*openstack.CSIManila), scope)
 	}); err != nil {
@@ -37,6 +67,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CSIManilaCephFS)(nil), (*openstack.CSIManilaCephFS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIManilaCephFS_To_openstack_CSIManilaCephFS(a.(*CSIManilaCephFS), b.(*openstack.CSIManilaCephFS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.CSIManilaCephFS)(nil), (*CSIManilaCephFS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_CSIManilaCephFS_To_v1alpha1_CSIManilaCephFS(a.(*openstack.CSIManilaCephFS), b.(*CSIManilaCephFS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*openstack.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*openstack.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -77,6 +117,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DSCPMarkingRule)(nil), (*openstack.DSCPMarkingRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DSCPMarkingRule_To_openstack_DSCPMarkingRule(a.(*DSCPMarkingRule), b.(*openstack.DSCPMarkingRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.DSCPMarkingRule)(nil), (*DSCPMarkingRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_DSCPMarkingRule_To_v1alpha1_DSCPMarkingRule(a.(*openstack.DSCPMarkingRule), b.(*DSCPMarkingRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExhaustedZone)(nil), (*openstack.ExhaustedZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExhaustedZone_To_openstack_ExhaustedZone(a.(*ExhaustedZone), b.(*openstack.ExhaustedZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ExhaustedZone)(nil), (*ExhaustedZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ExhaustedZone_To_v1alpha1_ExhaustedZone(a.(*openstack.ExhaustedZone), b.(*ExhaustedZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Firewall)(nil), (*openstack.Firewall)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Firewall_To_openstack_Firewall(a.(*Firewall), b.(*openstack.Firewall), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.Firewall)(nil), (*Firewall)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_Firewall_To_v1alpha1_Firewall(a.(*openstack.Firewall), b.(*Firewall), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FirewallRule)(nil), (*openstack.FirewallRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FirewallRule_To_openstack_FirewallRule(a.(*FirewallRule), b.(*openstack.FirewallRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.FirewallRule)(nil), (*FirewallRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_FirewallRule_To_v1alpha1_FirewallRule(a.(*openstack.FirewallRule), b.(*FirewallRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Flavor)(nil), (*openstack.Flavor)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Flavor_To_openstack_Flavor(a.(*Flavor), b.(*openstack.Flavor), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.Flavor)(nil), (*Flavor)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_Flavor_To_v1alpha1_Flavor(a.(*openstack.Flavor), b.(*Flavor), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FlavorPCIAlias)(nil), (*openstack.FlavorPCIAlias)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlavorPCIAlias_To_openstack_FlavorPCIAlias(a.(*FlavorPCIAlias), b.(*openstack.FlavorPCIAlias), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.FlavorPCIAlias)(nil), (*FlavorPCIAlias)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_FlavorPCIAlias_To_v1alpha1_FlavorPCIAlias(a.(*openstack.FlavorPCIAlias), b.(*FlavorPCIAlias), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FloatingPool)(nil), (*openstack.FloatingPool)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FloatingPool_To_openstack_FloatingPool(a.(*FloatingPool), b.(*openstack.FloatingPool), scope)
 	}); err != nil {
@@ -137,6 +237,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KMS)(nil), (*openstack.KMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KMS_To_openstack_KMS(a.(*KMS), b.(*openstack.KMS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.KMS)(nil), (*KMS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_KMS_To_v1alpha1_KMS(a.(*openstack.KMS), b.(*KMS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KeyStoneURL)(nil), (*openstack.KeyStoneURL)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KeyStoneURL_To_openstack_KeyStoneURL(a.(*KeyStoneURL), b.(*openstack.KeyStoneURL), scope)
 	}); err != nil {
@@ -147,6 +257,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KeystoneAuth)(nil), (*openstack.KeystoneAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KeystoneAuth_To_openstack_KeystoneAuth(a.(*KeystoneAuth), b.(*openstack.KeystoneAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.KeystoneAuth)(nil), (*KeystoneAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_KeystoneAuth_To_v1alpha1_KeystoneAuth(a.(*openstack.KeystoneAuth), b.(*KeystoneAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KeystoneRoleMapping)(nil), (*openstack.KeystoneRoleMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KeystoneRoleMapping_To_openstack_KeystoneRoleMapping(a.(*KeystoneRoleMapping), b.(*openstack.KeystoneRoleMapping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.KeystoneRoleMapping)(nil), (*KeystoneRoleMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_KeystoneRoleMapping_To_v1alpha1_KeystoneRoleMapping(a.(*openstack.KeystoneRoleMapping), b.(*KeystoneRoleMapping), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadBalancerClass)(nil), (*openstack.LoadBalancerClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(a.(*LoadBalancerClass), b.(*openstack.LoadBalancerClass), scope)
 	}); err != nil {
@@ -157,6 +287,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerMonitor)(nil), (*openstack.LoadBalancerMonitor)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerMonitor_To_openstack_LoadBalancerMonitor(a.(*LoadBalancerMonitor), b.(*openstack.LoadBalancerMonitor), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.LoadBalancerMonitor)(nil), (*LoadBalancerMonitor)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_LoadBalancerMonitor_To_v1alpha1_LoadBalancerMonitor(a.(*openstack.LoadBalancerMonitor), b.(*LoadBalancerMonitor), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerPolicy)(nil), (*openstack.LoadBalancerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerPolicy_To_openstack_LoadBalancerPolicy(a.(*LoadBalancerPolicy), b.(*openstack.LoadBalancerPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.LoadBalancerPolicy)(nil), (*LoadBalancerPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_LoadBalancerPolicy_To_v1alpha1_LoadBalancerPolicy(a.(*openstack.LoadBalancerPolicy), b.(*LoadBalancerPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadBalancerProvider)(nil), (*openstack.LoadBalancerProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LoadBalancerProvider_To_openstack_LoadBalancerProvider(a.(*LoadBalancerProvider), b.(*openstack.LoadBalancerProvider), scope)
 	}); err != nil {
@@ -167,6 +317,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerSettings)(nil), (*openstack.LoadBalancerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerSettings_To_openstack_LoadBalancerSettings(a.(*LoadBalancerSettings), b.(*openstack.LoadBalancerSettings), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.LoadBalancerSettings)(nil), (*LoadBalancerSettings)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_LoadBalancerSettings_To_v1alpha1_LoadBalancerSettings(a.(*openstack.LoadBalancerSettings), b.(*LoadBalancerSettings), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*openstack.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_openstack_MachineImage(a.(*MachineImage), b.(*openstack.MachineImage), scope)
 	}); err != nil {
@@ -217,6 +377,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineType)(nil), (*openstack.MachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MachineType_To_openstack_MachineType(a.(*MachineType), b.(*openstack.MachineType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.MachineType)(nil), (*MachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_MachineType_To_v1alpha1_MachineType(a.(*openstack.MachineType), b.(*MachineType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*MinimumBandwidthRule)(nil), (*openstack.MinimumBandwidthRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_MinimumBandwidthRule_To_openstack_MinimumBandwidthRule(a.(*MinimumBandwidthRule), b.(*openstack.MinimumBandwidthRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.MinimumBandwidthRule)(nil), (*MinimumBandwidthRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_MinimumBandwidthRule_To_v1alpha1_MinimumBandwidthRule(a.(*openstack.MinimumBandwidthRule), b.(*MinimumBandwidthRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceLoadBalancerClass)(nil), (*openstack.NamespaceLoadBalancerClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceLoadBalancerClass_To_openstack_NamespaceLoadBalancerClass(a.(*NamespaceLoadBalancerClass), b.(*openstack.NamespaceLoadBalancerClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.NamespaceLoadBalancerClass)(nil), (*NamespaceLoadBalancerClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_NamespaceLoadBalancerClass_To_v1alpha1_NamespaceLoadBalancerClass(a.(*openstack.NamespaceLoadBalancerClass), b.(*NamespaceLoadBalancerClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkStatus)(nil), (*openstack.NetworkStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(a.(*NetworkStatus), b.(*openstack.NetworkStatus), scope)
 	}); err != nil {
@@ -247,6 +437,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QoSPolicy)(nil), (*openstack.QoSPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_QoSPolicy_To_openstack_QoSPolicy(a.(*QoSPolicy), b.(*openstack.QoSPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.QoSPolicy)(nil), (*QoSPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_QoSPolicy_To_v1alpha1_QoSPolicy(a.(*openstack.QoSPolicy), b.(*QoSPolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RegionIDMapping)(nil), (*openstack.RegionIDMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RegionIDMapping_To_openstack_RegionIDMapping(a.(*RegionIDMapping), b.(*openstack.RegionIDMapping), scope)
 	}); err != nil {
@@ -257,6 +457,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Route)(nil), (*openstack.Route)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Route_To_openstack_Route(a.(*Route), b.(*openstack.Route), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.Route)(nil), (*Route)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_Route_To_v1alpha1_Route(a.(*openstack.Route), b.(*Route), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Router)(nil), (*openstack.Router)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Router_To_openstack_Router(a.(*Router), b.(*openstack.Router), scope)
 	}); err != nil {
@@ -337,6 +547,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*openstack.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_openstack_StorageClass(a.(*StorageClass), b.(*openstack.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_StorageClass_To_v1alpha1_StorageClass(a.(*openstack.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StorageClassDefinition)(nil), (*openstack.StorageClassDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_StorageClassDefinition_To_openstack_StorageClassDefinition(a.(*StorageClassDefinition), b.(*openstack.StorageClassDefinition), scope)
 	}); err != nil {
@@ -367,6 +587,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VolumeAZMapping)(nil), (*openstack.VolumeAZMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeAZMapping_To_openstack_VolumeAZMapping(a.(*VolumeAZMapping), b.(*openstack.VolumeAZMapping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.VolumeAZMapping)(nil), (*VolumeAZMapping)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_VolumeAZMapping_To_v1alpha1_VolumeAZMapping(a.(*openstack.VolumeAZMapping), b.(*VolumeAZMapping), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VolumeType)(nil), (*openstack.VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeType_To_openstack_VolumeType(a.(*VolumeType), b.(*openstack.VolumeType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.VolumeType)(nil), (*VolumeType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_VolumeType_To_v1alpha1_VolumeType(a.(*openstack.VolumeType), b.(*VolumeType), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerConfig)(nil), (*openstack.WorkerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(a.(*WorkerConfig), b.(*openstack.WorkerConfig), scope)
 	}); err != nil {
@@ -377,6 +617,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolZones)(nil), (*openstack.WorkerPoolZones)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WorkerPoolZones_To_openstack_WorkerPoolZones(a.(*WorkerPoolZones), b.(*openstack.WorkerPoolZones), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.WorkerPoolZones)(nil), (*WorkerPoolZones)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_WorkerPoolZones_To_v1alpha1_WorkerPoolZones(a.(*openstack.WorkerPoolZones), b.(*WorkerPoolZones), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*openstack.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(a.(*WorkerStatus), b.(*openstack.WorkerStatus), scope)
 	}); err != nil {
@@ -387,11 +637,88 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ZoneIndex)(nil), (*openstack.ZoneIndex)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ZoneIndex_To_openstack_ZoneIndex(a.(*ZoneIndex), b.(*openstack.ZoneIndex), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*openstack.ZoneIndex)(nil), (*ZoneIndex)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_openstack_ZoneIndex_To_v1alpha1_ZoneIndex(a.(*openstack.ZoneIndex), b.(*ZoneIndex), scope)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+func autoConvert_v1alpha1_AllocationPool_To_openstack_AllocationPool(in *AllocationPool, out *openstack.AllocationPool, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
+	return nil
+}
+
+// Convert_v1alpha1_AllocationPool_To_openstack_AllocationPool is an autogenerated conversion function.
+func Convert_v1alpha1_AllocationPool_To_openstack_AllocationPool(in *AllocationPool, out *openstack.AllocationPool, s conversion.Scope) error {
+	return autoConvert_v1alpha1_AllocationPool_To_openstack_AllocationPool(in, out, s)
+}
+
+func autoConvert_openstack_AllocationPool_To_v1alpha1_AllocationPool(in *openstack.AllocationPool, out *AllocationPool, s conversion.Scope) error {
+	out.Start = in.Start
+	out.End = in.End
 	return nil
 }
 
+// Convert_openstack_AllocationPool_To_v1alpha1_AllocationPool is an autogenerated conversion function.
+func Convert_openstack_AllocationPool_To_v1alpha1_AllocationPool(in *openstack.AllocationPool, out *AllocationPool, s conversion.Scope) error {
+	return autoConvert_openstack_AllocationPool_To_v1alpha1_AllocationPool(in, out, s)
+}
+
+func autoConvert_v1alpha1_ApplicationCredentials_To_openstack_ApplicationCredentials(in *ApplicationCredentials, out *openstack.ApplicationCredentials, s conversion.Scope) error {
+	out.RotationPeriod = (*v1.Duration)(unsafe.Pointer(in.RotationPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ApplicationCredentials_To_openstack_ApplicationCredentials is an autogenerated conversion function.
+func Convert_v1alpha1_ApplicationCredentials_To_openstack_ApplicationCredentials(in *ApplicationCredentials, out *openstack.ApplicationCredentials, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApplicationCredentials_To_openstack_ApplicationCredentials(in, out, s)
+}
+
+func autoConvert_openstack_ApplicationCredentials_To_v1alpha1_ApplicationCredentials(in *openstack.ApplicationCredentials, out *ApplicationCredentials, s conversion.Scope) error {
+	out.RotationPeriod = (*v1.Duration)(unsafe.Pointer(in.RotationPeriod))
+	return nil
+}
+
+// Convert_openstack_ApplicationCredentials_To_v1alpha1_ApplicationCredentials is an autogenerated conversion function.
+func Convert_openstack_ApplicationCredentials_To_v1alpha1_ApplicationCredentials(in *openstack.ApplicationCredentials, out *ApplicationCredentials, s conversion.Scope) error {
+	return autoConvert_openstack_ApplicationCredentials_To_v1alpha1_ApplicationCredentials(in, out, s)
+}
+
+func autoConvert_v1alpha1_BandwidthLimitRule_To_openstack_BandwidthLimitRule(in *BandwidthLimitRule, out *openstack.BandwidthLimitRule, s conversion.Scope) error {
+	out.MaxKbps = in.MaxKbps
+	out.MaxBurstKbps = (*int)(unsafe.Pointer(in.MaxBurstKbps))
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
+	return nil
+}
+
+// Convert_v1alpha1_BandwidthLimitRule_To_openstack_BandwidthLimitRule is an autogenerated conversion function.
+func Convert_v1alpha1_BandwidthLimitRule_To_openstack_BandwidthLimitRule(in *BandwidthLimitRule, out *openstack.BandwidthLimitRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BandwidthLimitRule_To_openstack_BandwidthLimitRule(in, out, s)
+}
+
+func autoConvert_openstack_BandwidthLimitRule_To_v1alpha1_BandwidthLimitRule(in *openstack.BandwidthLimitRule, out *BandwidthLimitRule, s conversion.Scope) error {
+	out.MaxKbps = in.MaxKbps
+	out.MaxBurstKbps = (*int)(unsafe.Pointer(in.MaxBurstKbps))
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
+	return nil
+}
+
+// Convert_openstack_BandwidthLimitRule_To_v1alpha1_BandwidthLimitRule is an autogenerated conversion function.
+func Convert_openstack_BandwidthLimitRule_To_v1alpha1_BandwidthLimitRule(in *openstack.BandwidthLimitRule, out *BandwidthLimitRule, s conversion.Scope) error {
+	return autoConvert_openstack_BandwidthLimitRule_To_v1alpha1_BandwidthLimitRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_CSIManila_To_openstack_CSIManila(in *CSIManila, out *openstack.CSIManila, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.CephFS = (*openstack.CSIManilaCephFS)(unsafe.Pointer(in.CephFS))
 	return nil
 }
 
@@ -402,6 +729,7 @@ func Convert_v1alpha1_CSIManila_To_openstack_CSIManila(in *CSIManila, out *opens
 
 func autoConvert_openstack_CSIManila_To_v1alpha1_CSIManila(in *openstack.CSIManila, out *CSIManila, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.CephFS = (*CSIManilaCephFS)(unsafe.Pointer(in.CephFS))
 	return nil
 }
 
@@ -410,6 +738,26 @@ func Convert_openstack_CSIManila_To_v1alpha1_CSIManila(in *openstack.CSIManila,
 	return autoConvert_openstack_CSIManila_To_v1alpha1_CSIManila(in, out, s)
 }
 
+func autoConvert_v1alpha1_CSIManilaCephFS_To_openstack_CSIManilaCephFS(in *CSIManilaCephFS, out *openstack.CSIManilaCephFS, s conversion.Scope) error {
+	out.Mounter = (*string)(unsafe.Pointer(in.Mounter))
+	return nil
+}
+
+// Convert_v1alpha1_CSIManilaCephFS_To_openstack_CSIManilaCephFS is an autogenerated conversion function.
+func Convert_v1alpha1_CSIManilaCephFS_To_openstack_CSIManilaCephFS(in *CSIManilaCephFS, out *openstack.CSIManilaCephFS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIManilaCephFS_To_openstack_CSIManilaCephFS(in, out, s)
+}
+
+func autoConvert_openstack_CSIManilaCephFS_To_v1alpha1_CSIManilaCephFS(in *openstack.CSIManilaCephFS, out *CSIManilaCephFS, s conversion.Scope) error {
+	out.Mounter = (*string)(unsafe.Pointer(in.Mounter))
+	return nil
+}
+
+// Convert_openstack_CSIManilaCephFS_To_v1alpha1_CSIManilaCephFS is an autogenerated conversion function.
+func Convert_openstack_CSIManilaCephFS_To_v1alpha1_CSIManilaCephFS(in *openstack.CSIManilaCephFS, out *CSIManilaCephFS, s conversion.Scope) error {
+	return autoConvert_openstack_CSIManilaCephFS_To_v1alpha1_CSIManilaCephFS(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_openstack_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *openstack.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -444,11 +792,19 @@ func autoConvert_v1alpha1_CloudProfileConfig_To_openstack_CloudProfileConfig(in
 	out.RequestTimeout = (*v1.Duration)(unsafe.Pointer(in.RequestTimeout))
 	out.RescanBlockStorageOnResize = (*bool)(unsafe.Pointer(in.RescanBlockStorageOnResize))
 	out.IgnoreVolumeAZ = (*bool)(unsafe.Pointer(in.IgnoreVolumeAZ))
+	out.IgnoreNetworkAZ = (*bool)(unsafe.Pointer(in.IgnoreNetworkAZ))
+	out.VolumeAZMappings = *(*[]openstack.VolumeAZMapping)(unsafe.Pointer(&in.VolumeAZMappings))
 	out.NodeVolumeAttachLimit = (*int32)(unsafe.Pointer(in.NodeVolumeAttachLimit))
 	out.UseSNAT = (*bool)(unsafe.Pointer(in.UseSNAT))
 	out.ServerGroupPolicies = *(*[]string)(unsafe.Pointer(&in.ServerGroupPolicies))
 	out.ResolvConfOptions = *(*[]string)(unsafe.Pointer(&in.ResolvConfOptions))
 	out.StorageClasses = *(*[]openstack.StorageClassDefinition)(unsafe.Pointer(&in.StorageClasses))
+	out.FlavorPCIAliases = *(*[]openstack.FlavorPCIAlias)(unsafe.Pointer(&in.FlavorPCIAliases))
+	out.UseFlavorCapacity = (*bool)(unsafe.Pointer(in.UseFlavorCapacity))
+	out.ServerMetadata = *(*map[string]string)(unsafe.Pointer(&in.ServerMetadata))
+	out.StoreOversizedUserData = (*bool)(unsafe.Pointer(in.StoreOversizedUserData))
+	out.MachineTypes = *(*[]openstack.MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]openstack.VolumeType)(unsafe.Pointer(&in.VolumeTypes))
 	return nil
 }
 
@@ -471,11 +827,19 @@ func autoConvert_openstack_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in
 	out.RequestTimeout = (*v1.Duration)(unsafe.Pointer(in.RequestTimeout))
 	out.RescanBlockStorageOnResize = (*bool)(unsafe.Pointer(in.RescanBlockStorageOnResize))
 	out.IgnoreVolumeAZ = (*bool)(unsafe.Pointer(in.IgnoreVolumeAZ))
+	out.IgnoreNetworkAZ = (*bool)(unsafe.Pointer(in.IgnoreNetworkAZ))
+	out.VolumeAZMappings = *(*[]VolumeAZMapping)(unsafe.Pointer(&in.VolumeAZMappings))
 	out.NodeVolumeAttachLimit = (*int32)(unsafe.Pointer(in.NodeVolumeAttachLimit))
 	out.UseSNAT = (*bool)(unsafe.Pointer(in.UseSNAT))
 	out.ServerGroupPolicies = *(*[]string)(unsafe.Pointer(&in.ServerGroupPolicies))
 	out.ResolvConfOptions = *(*[]string)(unsafe.Pointer(&in.ResolvConfOptions))
 	out.StorageClasses = *(*[]StorageClassDefinition)(unsafe.Pointer(&in.StorageClasses))
+	out.FlavorPCIAliases = *(*[]FlavorPCIAlias)(unsafe.Pointer(&in.FlavorPCIAliases))
+	out.UseFlavorCapacity = (*bool)(unsafe.Pointer(in.UseFlavorCapacity))
+	out.ServerMetadata = *(*map[string]string)(unsafe.Pointer(&in.ServerMetadata))
+	out.StoreOversizedUserData = (*bool)(unsafe.Pointer(in.StoreOversizedUserData))
+	out.MachineTypes = *(*[]MachineType)(unsafe.Pointer(&in.MachineTypes))
+	out.VolumeTypes = *(*[]VolumeType)(unsafe.Pointer(&in.VolumeTypes))
 	return nil
 }
 
@@ -512,6 +876,11 @@ func autoConvert_v1alpha1_ControlPlaneConfig_To_openstack_ControlPlaneConfig(in
 	out.LoadBalancerProvider = in.LoadBalancerProvider
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Storage = (*openstack.Storage)(unsafe.Pointer(in.Storage))
+	out.LoadBalancer = (*openstack.LoadBalancerSettings)(unsafe.Pointer(in.LoadBalancer))
+	out.KMS = (*openstack.KMS)(unsafe.Pointer(in.KMS))
+	out.KeystoneAuth = (*openstack.KeystoneAuth)(unsafe.Pointer(in.KeystoneAuth))
+	out.ApplicationCredentials = (*openstack.ApplicationCredentials)(unsafe.Pointer(in.ApplicationCredentials))
+	out.LoadBalancerPolicy = (*openstack.LoadBalancerPolicy)(unsafe.Pointer(in.LoadBalancerPolicy))
 	return nil
 }
 
@@ -526,6 +895,11 @@ func autoConvert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in
 	out.LoadBalancerProvider = in.LoadBalancerProvider
 	out.Zone = (*string)(unsafe.Pointer(in.Zone))
 	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
+	out.LoadBalancer = (*LoadBalancerSettings)(unsafe.Pointer(in.LoadBalancer))
+	out.KMS = (*KMS)(unsafe.Pointer(in.KMS))
+	out.KeystoneAuth = (*KeystoneAuth)(unsafe.Pointer(in.KeystoneAuth))
+	out.ApplicationCredentials = (*ApplicationCredentials)(unsafe.Pointer(in.ApplicationCredentials))
+	out.LoadBalancerPolicy = (*LoadBalancerPolicy)(unsafe.Pointer(in.LoadBalancerPolicy))
 	return nil
 }
 
@@ -534,6 +908,154 @@ func Convert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *ope
 	return autoConvert_openstack_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_DSCPMarkingRule_To_openstack_DSCPMarkingRule(in *DSCPMarkingRule, out *openstack.DSCPMarkingRule, s conversion.Scope) error {
+	out.DSCPMark = in.DSCPMark
+	return nil
+}
+
+// Convert_v1alpha1_DSCPMarkingRule_To_openstack_DSCPMarkingRule is an autogenerated conversion function.
+func Convert_v1alpha1_DSCPMarkingRule_To_openstack_DSCPMarkingRule(in *DSCPMarkingRule, out *openstack.DSCPMarkingRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DSCPMarkingRule_To_openstack_DSCPMarkingRule(in, out, s)
+}
+
+func autoConvert_openstack_DSCPMarkingRule_To_v1alpha1_DSCPMarkingRule(in *openstack.DSCPMarkingRule, out *DSCPMarkingRule, s conversion.Scope) error {
+	out.DSCPMark = in.DSCPMark
+	return nil
+}
+
+// Convert_openstack_DSCPMarkingRule_To_v1alpha1_DSCPMarkingRule is an autogenerated conversion function.
+func Convert_openstack_DSCPMarkingRule_To_v1alpha1_DSCPMarkingRule(in *openstack.DSCPMarkingRule, out *DSCPMarkingRule, s conversion.Scope) error {
+	return autoConvert_openstack_DSCPMarkingRule_To_v1alpha1_DSCPMarkingRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExhaustedZone_To_openstack_ExhaustedZone(in *ExhaustedZone, out *openstack.ExhaustedZone, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.LastDetectionTime = in.LastDetectionTime
+	return nil
+}
+
+// Convert_v1alpha1_ExhaustedZone_To_openstack_ExhaustedZone is an autogenerated conversion function.
+func Convert_v1alpha1_ExhaustedZone_To_openstack_ExhaustedZone(in *ExhaustedZone, out *openstack.ExhaustedZone, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExhaustedZone_To_openstack_ExhaustedZone(in, out, s)
+}
+
+func autoConvert_openstack_ExhaustedZone_To_v1alpha1_ExhaustedZone(in *openstack.ExhaustedZone, out *ExhaustedZone, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zone = in.Zone
+	out.LastDetectionTime = in.LastDetectionTime
+	return nil
+}
+
+// Convert_openstack_ExhaustedZone_To_v1alpha1_ExhaustedZone is an autogenerated conversion function.
+func Convert_openstack_ExhaustedZone_To_v1alpha1_ExhaustedZone(in *openstack.ExhaustedZone, out *ExhaustedZone, s conversion.Scope) error {
+	return autoConvert_openstack_ExhaustedZone_To_v1alpha1_ExhaustedZone(in, out, s)
+}
+
+func autoConvert_v1alpha1_Firewall_To_openstack_Firewall(in *Firewall, out *openstack.Firewall, s conversion.Scope) error {
+	out.EgressRules = *(*[]openstack.FirewallRule)(unsafe.Pointer(&in.EgressRules))
+	out.IngressRules = *(*[]openstack.FirewallRule)(unsafe.Pointer(&in.IngressRules))
+	return nil
+}
+
+// Convert_v1alpha1_Firewall_To_openstack_Firewall is an autogenerated conversion function.
+func Convert_v1alpha1_Firewall_To_openstack_Firewall(in *Firewall, out *openstack.Firewall, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Firewall_To_openstack_Firewall(in, out, s)
+}
+
+func autoConvert_openstack_Firewall_To_v1alpha1_Firewall(in *openstack.Firewall, out *Firewall, s conversion.Scope) error {
+	out.EgressRules = *(*[]FirewallRule)(unsafe.Pointer(&in.EgressRules))
+	out.IngressRules = *(*[]FirewallRule)(unsafe.Pointer(&in.IngressRules))
+	return nil
+}
+
+// Convert_openstack_Firewall_To_v1alpha1_Firewall is an autogenerated conversion function.
+func Convert_openstack_Firewall_To_v1alpha1_Firewall(in *openstack.Firewall, out *Firewall, s conversion.Scope) error {
+	return autoConvert_openstack_Firewall_To_v1alpha1_Firewall(in, out, s)
+}
+
+func autoConvert_v1alpha1_FirewallRule_To_openstack_FirewallRule(in *FirewallRule, out *openstack.FirewallRule, s conversion.Scope) error {
+	out.Action = in.Action
+	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
+	out.IPVersion = (*int)(unsafe.Pointer(in.IPVersion))
+	out.SourceCIDR = (*string)(unsafe.Pointer(in.SourceCIDR))
+	out.DestinationCIDR = (*string)(unsafe.Pointer(in.DestinationCIDR))
+	out.SourcePort = (*string)(unsafe.Pointer(in.SourcePort))
+	out.DestinationPort = (*string)(unsafe.Pointer(in.DestinationPort))
+	return nil
+}
+
+// Convert_v1alpha1_FirewallRule_To_openstack_FirewallRule is an autogenerated conversion function.
+func Convert_v1alpha1_FirewallRule_To_openstack_FirewallRule(in *FirewallRule, out *openstack.FirewallRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FirewallRule_To_openstack_FirewallRule(in, out, s)
+}
+
+func autoConvert_openstack_FirewallRule_To_v1alpha1_FirewallRule(in *openstack.FirewallRule, out *FirewallRule, s conversion.Scope) error {
+	out.Action = in.Action
+	out.Protocol = (*string)(unsafe.Pointer(in.Protocol))
+	out.IPVersion = (*int)(unsafe.Pointer(in.IPVersion))
+	out.SourceCIDR = (*string)(unsafe.Pointer(in.SourceCIDR))
+	out.DestinationCIDR = (*string)(unsafe.Pointer(in.DestinationCIDR))
+	out.SourcePort = (*string)(unsafe.Pointer(in.SourcePort))
+	out.DestinationPort = (*string)(unsafe.Pointer(in.DestinationPort))
+	return nil
+}
+
+// Convert_openstack_FirewallRule_To_v1alpha1_FirewallRule is an autogenerated conversion function.
+func Convert_openstack_FirewallRule_To_v1alpha1_FirewallRule(in *openstack.FirewallRule, out *FirewallRule, s conversion.Scope) error {
+	return autoConvert_openstack_FirewallRule_To_v1alpha1_FirewallRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_Flavor_To_openstack_Flavor(in *Flavor, out *openstack.Flavor, s conversion.Scope) error {
+	out.Name = in.Name
+	out.VCPUs = in.VCPUs
+	out.RAM = in.RAM
+	out.Disk = in.Disk
+	out.ExtraSpecs = *(*map[string]string)(unsafe.Pointer(&in.ExtraSpecs))
+	return nil
+}
+
+// Convert_v1alpha1_Flavor_To_openstack_Flavor is an autogenerated conversion function.
+func Convert_v1alpha1_Flavor_To_openstack_Flavor(in *Flavor, out *openstack.Flavor, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Flavor_To_openstack_Flavor(in, out, s)
+}
+
+func autoConvert_openstack_Flavor_To_v1alpha1_Flavor(in *openstack.Flavor, out *Flavor, s conversion.Scope) error {
+	out.Name = in.Name
+	out.VCPUs = in.VCPUs
+	out.RAM = in.RAM
+	out.Disk = in.Disk
+	out.ExtraSpecs = *(*map[string]string)(unsafe.Pointer(&in.ExtraSpecs))
+	return nil
+}
+
+// Convert_openstack_Flavor_To_v1alpha1_Flavor is an autogenerated conversion function.
+func Convert_openstack_Flavor_To_v1alpha1_Flavor(in *openstack.Flavor, out *Flavor, s conversion.Scope) error {
+	return autoConvert_openstack_Flavor_To_v1alpha1_Flavor(in, out, s)
+}
+
+func autoConvert_v1alpha1_FlavorPCIAlias_To_openstack_FlavorPCIAlias(in *FlavorPCIAlias, out *openstack.FlavorPCIAlias, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_v1alpha1_FlavorPCIAlias_To_openstack_FlavorPCIAlias is an autogenerated conversion function.
+func Convert_v1alpha1_FlavorPCIAlias_To_openstack_FlavorPCIAlias(in *FlavorPCIAlias, out *openstack.FlavorPCIAlias, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlavorPCIAlias_To_openstack_FlavorPCIAlias(in, out, s)
+}
+
+func autoConvert_openstack_FlavorPCIAlias_To_v1alpha1_FlavorPCIAlias(in *openstack.FlavorPCIAlias, out *FlavorPCIAlias, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ResourceName = in.ResourceName
+	return nil
+}
+
+// Convert_openstack_FlavorPCIAlias_To_v1alpha1_FlavorPCIAlias is an autogenerated conversion function.
+func Convert_openstack_FlavorPCIAlias_To_v1alpha1_FlavorPCIAlias(in *openstack.FlavorPCIAlias, out *FlavorPCIAlias, s conversion.Scope) error {
+	return autoConvert_openstack_FlavorPCIAlias_To_v1alpha1_FlavorPCIAlias(in, out, s)
+}
+
 func autoConvert_v1alpha1_FloatingPool_To_openstack_FloatingPool(in *FloatingPool, out *openstack.FloatingPool, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Region = (*string)(unsafe.Pointer(in.Region))
@@ -692,6 +1214,26 @@ func Convert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in
 	return autoConvert_openstack_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_KMS_To_openstack_KMS(in *KMS, out *openstack.KMS, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	return nil
+}
+
+// Convert_v1alpha1_KMS_To_openstack_KMS is an autogenerated conversion function.
+func Convert_v1alpha1_KMS_To_openstack_KMS(in *KMS, out *openstack.KMS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KMS_To_openstack_KMS(in, out, s)
+}
+
+func autoConvert_openstack_KMS_To_v1alpha1_KMS(in *openstack.KMS, out *KMS, s conversion.Scope) error {
+	out.KeyID = in.KeyID
+	return nil
+}
+
+// Convert_openstack_KMS_To_v1alpha1_KMS is an autogenerated conversion function.
+func Convert_openstack_KMS_To_v1alpha1_KMS(in *openstack.KMS, out *KMS, s conversion.Scope) error {
+	return autoConvert_openstack_KMS_To_v1alpha1_KMS(in, out, s)
+}
+
 func autoConvert_v1alpha1_KeyStoneURL_To_openstack_KeyStoneURL(in *KeyStoneURL, out *openstack.KeyStoneURL, s conversion.Scope) error {
 	out.Region = in.Region
 	out.URL = in.URL
@@ -716,6 +1258,50 @@ func Convert_openstack_KeyStoneURL_To_v1alpha1_KeyStoneURL(in *openstack.KeySton
 	return autoConvert_openstack_KeyStoneURL_To_v1alpha1_KeyStoneURL(in, out, s)
 }
 
+func autoConvert_v1alpha1_KeystoneAuth_To_openstack_KeystoneAuth(in *KeystoneAuth, out *openstack.KeystoneAuth, s conversion.Scope) error {
+	out.RoleMappings = *(*[]openstack.KeystoneRoleMapping)(unsafe.Pointer(&in.RoleMappings))
+	out.AuthorizationPolicy = (*string)(unsafe.Pointer(in.AuthorizationPolicy))
+	return nil
+}
+
+// Convert_v1alpha1_KeystoneAuth_To_openstack_KeystoneAuth is an autogenerated conversion function.
+func Convert_v1alpha1_KeystoneAuth_To_openstack_KeystoneAuth(in *KeystoneAuth, out *openstack.KeystoneAuth, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KeystoneAuth_To_openstack_KeystoneAuth(in, out, s)
+}
+
+func autoConvert_openstack_KeystoneAuth_To_v1alpha1_KeystoneAuth(in *openstack.KeystoneAuth, out *KeystoneAuth, s conversion.Scope) error {
+	out.RoleMappings = *(*[]KeystoneRoleMapping)(unsafe.Pointer(&in.RoleMappings))
+	out.AuthorizationPolicy = (*string)(unsafe.Pointer(in.AuthorizationPolicy))
+	return nil
+}
+
+// Convert_openstack_KeystoneAuth_To_v1alpha1_KeystoneAuth is an autogenerated conversion function.
+func Convert_openstack_KeystoneAuth_To_v1alpha1_KeystoneAuth(in *openstack.KeystoneAuth, out *KeystoneAuth, s conversion.Scope) error {
+	return autoConvert_openstack_KeystoneAuth_To_v1alpha1_KeystoneAuth(in, out, s)
+}
+
+func autoConvert_v1alpha1_KeystoneRoleMapping_To_openstack_KeystoneRoleMapping(in *KeystoneRoleMapping, out *openstack.KeystoneRoleMapping, s conversion.Scope) error {
+	out.KeystoneRole = in.KeystoneRole
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_v1alpha1_KeystoneRoleMapping_To_openstack_KeystoneRoleMapping is an autogenerated conversion function.
+func Convert_v1alpha1_KeystoneRoleMapping_To_openstack_KeystoneRoleMapping(in *KeystoneRoleMapping, out *openstack.KeystoneRoleMapping, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KeystoneRoleMapping_To_openstack_KeystoneRoleMapping(in, out, s)
+}
+
+func autoConvert_openstack_KeystoneRoleMapping_To_v1alpha1_KeystoneRoleMapping(in *openstack.KeystoneRoleMapping, out *KeystoneRoleMapping, s conversion.Scope) error {
+	out.KeystoneRole = in.KeystoneRole
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_openstack_KeystoneRoleMapping_To_v1alpha1_KeystoneRoleMapping is an autogenerated conversion function.
+func Convert_openstack_KeystoneRoleMapping_To_v1alpha1_KeystoneRoleMapping(in *openstack.KeystoneRoleMapping, out *KeystoneRoleMapping, s conversion.Scope) error {
+	return autoConvert_openstack_KeystoneRoleMapping_To_v1alpha1_KeystoneRoleMapping(in, out, s)
+}
+
 func autoConvert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(in *LoadBalancerClass, out *openstack.LoadBalancerClass, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Purpose = (*string)(unsafe.Pointer(in.Purpose))
@@ -724,6 +1310,9 @@ func autoConvert_v1alpha1_LoadBalancerClass_To_openstack_LoadBalancerClass(in *L
 	out.FloatingSubnetName = (*string)(unsafe.Pointer(in.FloatingSubnetName))
 	out.FloatingNetworkID = (*string)(unsafe.Pointer(in.FloatingNetworkID))
 	out.SubnetID = (*string)(unsafe.Pointer(in.SubnetID))
+	out.IPv6SubnetID = (*string)(unsafe.Pointer(in.IPv6SubnetID))
+	out.IPv6FloatingSubnetID = (*string)(unsafe.Pointer(in.IPv6FloatingSubnetID))
+	out.Settings = (*openstack.LoadBalancerSettings)(unsafe.Pointer(in.Settings))
 	return nil
 }
 
@@ -740,6 +1329,9 @@ func autoConvert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(in *o
 	out.FloatingSubnetName = (*string)(unsafe.Pointer(in.FloatingSubnetName))
 	out.FloatingNetworkID = (*string)(unsafe.Pointer(in.FloatingNetworkID))
 	out.SubnetID = (*string)(unsafe.Pointer(in.SubnetID))
+	out.IPv6SubnetID = (*string)(unsafe.Pointer(in.IPv6SubnetID))
+	out.IPv6FloatingSubnetID = (*string)(unsafe.Pointer(in.IPv6FloatingSubnetID))
+	out.Settings = (*LoadBalancerSettings)(unsafe.Pointer(in.Settings))
 	return nil
 }
 
@@ -748,6 +1340,56 @@ func Convert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(in *opens
 	return autoConvert_openstack_LoadBalancerClass_To_v1alpha1_LoadBalancerClass(in, out, s)
 }
 
+func autoConvert_v1alpha1_LoadBalancerMonitor_To_openstack_LoadBalancerMonitor(in *LoadBalancerMonitor, out *openstack.LoadBalancerMonitor, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Delay = (*v1.Duration)(unsafe.Pointer(in.Delay))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.MaxRetries = (*int32)(unsafe.Pointer(in.MaxRetries))
+	out.MaxRetriesDown = (*int32)(unsafe.Pointer(in.MaxRetriesDown))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerMonitor_To_openstack_LoadBalancerMonitor is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerMonitor_To_openstack_LoadBalancerMonitor(in *LoadBalancerMonitor, out *openstack.LoadBalancerMonitor, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerMonitor_To_openstack_LoadBalancerMonitor(in, out, s)
+}
+
+func autoConvert_openstack_LoadBalancerMonitor_To_v1alpha1_LoadBalancerMonitor(in *openstack.LoadBalancerMonitor, out *LoadBalancerMonitor, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.Delay = (*v1.Duration)(unsafe.Pointer(in.Delay))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.MaxRetries = (*int32)(unsafe.Pointer(in.MaxRetries))
+	out.MaxRetriesDown = (*int32)(unsafe.Pointer(in.MaxRetriesDown))
+	return nil
+}
+
+// Convert_openstack_LoadBalancerMonitor_To_v1alpha1_LoadBalancerMonitor is an autogenerated conversion function.
+func Convert_openstack_LoadBalancerMonitor_To_v1alpha1_LoadBalancerMonitor(in *openstack.LoadBalancerMonitor, out *LoadBalancerMonitor, s conversion.Scope) error {
+	return autoConvert_openstack_LoadBalancerMonitor_To_v1alpha1_LoadBalancerMonitor(in, out, s)
+}
+
+func autoConvert_v1alpha1_LoadBalancerPolicy_To_openstack_LoadBalancerPolicy(in *LoadBalancerPolicy, out *openstack.LoadBalancerPolicy, s conversion.Scope) error {
+	out.NamespaceDefaults = *(*[]openstack.NamespaceLoadBalancerClass)(unsafe.Pointer(&in.NamespaceDefaults))
+	out.InternalNamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.InternalNamespaceSelector))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerPolicy_To_openstack_LoadBalancerPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerPolicy_To_openstack_LoadBalancerPolicy(in *LoadBalancerPolicy, out *openstack.LoadBalancerPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerPolicy_To_openstack_LoadBalancerPolicy(in, out, s)
+}
+
+func autoConvert_openstack_LoadBalancerPolicy_To_v1alpha1_LoadBalancerPolicy(in *openstack.LoadBalancerPolicy, out *LoadBalancerPolicy, s conversion.Scope) error {
+	out.NamespaceDefaults = *(*[]NamespaceLoadBalancerClass)(unsafe.Pointer(&in.NamespaceDefaults))
+	out.InternalNamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.InternalNamespaceSelector))
+	return nil
+}
+
+// Convert_openstack_LoadBalancerPolicy_To_v1alpha1_LoadBalancerPolicy is an autogenerated conversion function.
+func Convert_openstack_LoadBalancerPolicy_To_v1alpha1_LoadBalancerPolicy(in *openstack.LoadBalancerPolicy, out *LoadBalancerPolicy, s conversion.Scope) error {
+	return autoConvert_openstack_LoadBalancerPolicy_To_v1alpha1_LoadBalancerPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_LoadBalancerProvider_To_openstack_LoadBalancerProvider(in *LoadBalancerProvider, out *openstack.LoadBalancerProvider, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Region = (*string)(unsafe.Pointer(in.Region))
@@ -770,6 +1412,36 @@ func Convert_openstack_LoadBalancerProvider_To_v1alpha1_LoadBalancerProvider(in
 	return autoConvert_openstack_LoadBalancerProvider_To_v1alpha1_LoadBalancerProvider(in, out, s)
 }
 
+func autoConvert_v1alpha1_LoadBalancerSettings_To_openstack_LoadBalancerSettings(in *LoadBalancerSettings, out *openstack.LoadBalancerSettings, s conversion.Scope) error {
+	out.FlavorID = (*string)(unsafe.Pointer(in.FlavorID))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	out.Method = (*string)(unsafe.Pointer(in.Method))
+	out.Monitor = (*openstack.LoadBalancerMonitor)(unsafe.Pointer(in.Monitor))
+	out.EnableIngressHostname = (*bool)(unsafe.Pointer(in.EnableIngressHostname))
+	out.MaxSharedLB = (*int32)(unsafe.Pointer(in.MaxSharedLB))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerSettings_To_openstack_LoadBalancerSettings is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerSettings_To_openstack_LoadBalancerSettings(in *LoadBalancerSettings, out *openstack.LoadBalancerSettings, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerSettings_To_openstack_LoadBalancerSettings(in, out, s)
+}
+
+func autoConvert_openstack_LoadBalancerSettings_To_v1alpha1_LoadBalancerSettings(in *openstack.LoadBalancerSettings, out *LoadBalancerSettings, s conversion.Scope) error {
+	out.FlavorID = (*string)(unsafe.Pointer(in.FlavorID))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	out.Method = (*string)(unsafe.Pointer(in.Method))
+	out.Monitor = (*LoadBalancerMonitor)(unsafe.Pointer(in.Monitor))
+	out.EnableIngressHostname = (*bool)(unsafe.Pointer(in.EnableIngressHostname))
+	out.MaxSharedLB = (*int32)(unsafe.Pointer(in.MaxSharedLB))
+	return nil
+}
+
+// Convert_openstack_LoadBalancerSettings_To_v1alpha1_LoadBalancerSettings is an autogenerated conversion function.
+func Convert_openstack_LoadBalancerSettings_To_v1alpha1_LoadBalancerSettings(in *openstack.LoadBalancerSettings, out *LoadBalancerSettings, s conversion.Scope) error {
+	return autoConvert_openstack_LoadBalancerSettings_To_v1alpha1_LoadBalancerSettings(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_openstack_MachineImage(in *MachineImage, out *openstack.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -896,6 +1568,72 @@ func Convert_openstack_MachineLabel_To_v1alpha1_MachineLabel(in *openstack.Machi
 	return autoConvert_openstack_MachineLabel_To_v1alpha1_MachineLabel(in, out, s)
 }
 
+func autoConvert_v1alpha1_MachineType_To_openstack_MachineType(in *MachineType, out *openstack.MachineType, s conversion.Scope) error {
+	out.Name = in.Name
+	out.BareMetal = (*bool)(unsafe.Pointer(in.BareMetal))
+	return nil
+}
+
+// Convert_v1alpha1_MachineType_To_openstack_MachineType is an autogenerated conversion function.
+func Convert_v1alpha1_MachineType_To_openstack_MachineType(in *MachineType, out *openstack.MachineType, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MachineType_To_openstack_MachineType(in, out, s)
+}
+
+func autoConvert_openstack_MachineType_To_v1alpha1_MachineType(in *openstack.MachineType, out *MachineType, s conversion.Scope) error {
+	out.Name = in.Name
+	out.BareMetal = (*bool)(unsafe.Pointer(in.BareMetal))
+	return nil
+}
+
+// Convert_openstack_MachineType_To_v1alpha1_MachineType is an autogenerated conversion function.
+func Convert_openstack_MachineType_To_v1alpha1_MachineType(in *openstack.MachineType, out *MachineType, s conversion.Scope) error {
+	return autoConvert_openstack_MachineType_To_v1alpha1_MachineType(in, out, s)
+}
+
+func autoConvert_v1alpha1_MinimumBandwidthRule_To_openstack_MinimumBandwidthRule(in *MinimumBandwidthRule, out *openstack.MinimumBandwidthRule, s conversion.Scope) error {
+	out.MinKbps = in.MinKbps
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
+	return nil
+}
+
+// Convert_v1alpha1_MinimumBandwidthRule_To_openstack_MinimumBandwidthRule is an autogenerated conversion function.
+func Convert_v1alpha1_MinimumBandwidthRule_To_openstack_MinimumBandwidthRule(in *MinimumBandwidthRule, out *openstack.MinimumBandwidthRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_MinimumBandwidthRule_To_openstack_MinimumBandwidthRule(in, out, s)
+}
+
+func autoConvert_openstack_MinimumBandwidthRule_To_v1alpha1_MinimumBandwidthRule(in *openstack.MinimumBandwidthRule, out *MinimumBandwidthRule, s conversion.Scope) error {
+	out.MinKbps = in.MinKbps
+	out.Direction = (*string)(unsafe.Pointer(in.Direction))
+	return nil
+}
+
+// Convert_openstack_MinimumBandwidthRule_To_v1alpha1_MinimumBandwidthRule is an autogenerated conversion function.
+func Convert_openstack_MinimumBandwidthRule_To_v1alpha1_MinimumBandwidthRule(in *openstack.MinimumBandwidthRule, out *MinimumBandwidthRule, s conversion.Scope) error {
+	return autoConvert_openstack_MinimumBandwidthRule_To_v1alpha1_MinimumBandwidthRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_NamespaceLoadBalancerClass_To_openstack_NamespaceLoadBalancerClass(in *NamespaceLoadBalancerClass, out *openstack.NamespaceLoadBalancerClass, s conversion.Scope) error {
+	out.NamespaceSelector = in.NamespaceSelector
+	out.LoadBalancerClass = in.LoadBalancerClass
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceLoadBalancerClass_To_openstack_NamespaceLoadBalancerClass is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceLoadBalancerClass_To_openstack_NamespaceLoadBalancerClass(in *NamespaceLoadBalancerClass, out *openstack.NamespaceLoadBalancerClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceLoadBalancerClass_To_openstack_NamespaceLoadBalancerClass(in, out, s)
+}
+
+func autoConvert_openstack_NamespaceLoadBalancerClass_To_v1alpha1_NamespaceLoadBalancerClass(in *openstack.NamespaceLoadBalancerClass, out *NamespaceLoadBalancerClass, s conversion.Scope) error {
+	out.NamespaceSelector = in.NamespaceSelector
+	out.LoadBalancerClass = in.LoadBalancerClass
+	return nil
+}
+
+// Convert_openstack_NamespaceLoadBalancerClass_To_v1alpha1_NamespaceLoadBalancerClass is an autogenerated conversion function.
+func Convert_openstack_NamespaceLoadBalancerClass_To_v1alpha1_NamespaceLoadBalancerClass(in *openstack.NamespaceLoadBalancerClass, out *NamespaceLoadBalancerClass, s conversion.Scope) error {
+	return autoConvert_openstack_NamespaceLoadBalancerClass_To_v1alpha1_NamespaceLoadBalancerClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in *NetworkStatus, out *openstack.NetworkStatus, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Name = in.Name
@@ -907,6 +1645,7 @@ func autoConvert_v1alpha1_NetworkStatus_To_openstack_NetworkStatus(in *NetworkSt
 	}
 	out.Subnets = *(*[]openstack.Subnet)(unsafe.Pointer(&in.Subnets))
 	out.ShareNetwork = (*openstack.ShareNetworkStatus)(unsafe.Pointer(in.ShareNetwork))
+	out.AvailabilityZones = *(*[]string)(unsafe.Pointer(&in.AvailabilityZones))
 	return nil
 }
 
@@ -926,6 +1665,7 @@ func autoConvert_openstack_NetworkStatus_To_v1alpha1_NetworkStatus(in *openstack
 	}
 	out.Subnets = *(*[]Subnet)(unsafe.Pointer(&in.Subnets))
 	out.ShareNetwork = (*ShareNetworkStatus)(unsafe.Pointer(in.ShareNetwork))
+	out.AvailabilityZones = *(*[]string)(unsafe.Pointer(&in.AvailabilityZones))
 	return nil
 }
 
@@ -942,6 +1682,12 @@ func autoConvert_v1alpha1_Networks_To_openstack_Networks(in *Networks, out *open
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.ShareNetwork = (*openstack.ShareNetwork)(unsafe.Pointer(in.ShareNetwork))
 	out.IPv6 = (*openstack.IPv6Config)(unsafe.Pointer(in.IPv6))
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.HostRoutes = *(*[]openstack.Route)(unsafe.Pointer(&in.HostRoutes))
+	out.AllocationPools = *(*[]openstack.AllocationPool)(unsafe.Pointer(&in.AllocationPools))
+	out.AvailabilityZoneHints = *(*[]string)(unsafe.Pointer(&in.AvailabilityZoneHints))
+	out.QoSPolicy = (*openstack.QoSPolicy)(unsafe.Pointer(in.QoSPolicy))
+	out.Firewall = (*openstack.Firewall)(unsafe.Pointer(in.Firewall))
 	return nil
 }
 
@@ -958,6 +1704,12 @@ func autoConvert_openstack_Networks_To_v1alpha1_Networks(in *openstack.Networks,
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.ShareNetwork = (*ShareNetwork)(unsafe.Pointer(in.ShareNetwork))
 	out.IPv6 = (*IPv6Config)(unsafe.Pointer(in.IPv6))
+	out.DNSServers = *(*[]string)(unsafe.Pointer(&in.DNSServers))
+	out.HostRoutes = *(*[]Route)(unsafe.Pointer(&in.HostRoutes))
+	out.AllocationPools = *(*[]AllocationPool)(unsafe.Pointer(&in.AllocationPools))
+	out.AvailabilityZoneHints = *(*[]string)(unsafe.Pointer(&in.AvailabilityZoneHints))
+	out.QoSPolicy = (*QoSPolicy)(unsafe.Pointer(in.QoSPolicy))
+	out.Firewall = (*Firewall)(unsafe.Pointer(in.Firewall))
 	return nil
 }
 
@@ -986,6 +1738,32 @@ func Convert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in *openstack.NodeStatu
 	return autoConvert_openstack_NodeStatus_To_v1alpha1_NodeStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_QoSPolicy_To_openstack_QoSPolicy(in *QoSPolicy, out *openstack.QoSPolicy, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.BandwidthLimitRules = *(*[]openstack.BandwidthLimitRule)(unsafe.Pointer(&in.BandwidthLimitRules))
+	out.MinimumBandwidthRules = *(*[]openstack.MinimumBandwidthRule)(unsafe.Pointer(&in.MinimumBandwidthRules))
+	out.DSCPMarkingRules = *(*[]openstack.DSCPMarkingRule)(unsafe.Pointer(&in.DSCPMarkingRules))
+	return nil
+}
+
+// Convert_v1alpha1_QoSPolicy_To_openstack_QoSPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_QoSPolicy_To_openstack_QoSPolicy(in *QoSPolicy, out *openstack.QoSPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_QoSPolicy_To_openstack_QoSPolicy(in, out, s)
+}
+
+func autoConvert_openstack_QoSPolicy_To_v1alpha1_QoSPolicy(in *openstack.QoSPolicy, out *QoSPolicy, s conversion.Scope) error {
+	out.ID = (*string)(unsafe.Pointer(in.ID))
+	out.BandwidthLimitRules = *(*[]BandwidthLimitRule)(unsafe.Pointer(&in.BandwidthLimitRules))
+	out.MinimumBandwidthRules = *(*[]MinimumBandwidthRule)(unsafe.Pointer(&in.MinimumBandwidthRules))
+	out.DSCPMarkingRules = *(*[]DSCPMarkingRule)(unsafe.Pointer(&in.DSCPMarkingRules))
+	return nil
+}
+
+// Convert_openstack_QoSPolicy_To_v1alpha1_QoSPolicy is an autogenerated conversion function.
+func Convert_openstack_QoSPolicy_To_v1alpha1_QoSPolicy(in *openstack.QoSPolicy, out *QoSPolicy, s conversion.Scope) error {
+	return autoConvert_openstack_QoSPolicy_To_v1alpha1_QoSPolicy(in, out, s)
+}
+
 func autoConvert_v1alpha1_RegionIDMapping_To_openstack_RegionIDMapping(in *RegionIDMapping, out *openstack.RegionIDMapping, s conversion.Scope) error {
 	out.Name = in.Name
 	out.ID = in.ID
@@ -1010,8 +1788,31 @@ func Convert_openstack_RegionIDMapping_To_v1alpha1_RegionIDMapping(in *openstack
 	return autoConvert_openstack_RegionIDMapping_To_v1alpha1_RegionIDMapping(in, out, s)
 }
 
+func autoConvert_v1alpha1_Route_To_openstack_Route(in *Route, out *openstack.Route, s conversion.Scope) error {
+	out.Destination = in.Destination
+	out.NextHop = in.NextHop
+	return nil
+}
+
+// Convert_v1alpha1_Route_To_openstack_Route is an autogenerated conversion function.
+func Convert_v1alpha1_Route_To_openstack_Route(in *Route, out *openstack.Route, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Route_To_openstack_Route(in, out, s)
+}
+
+func autoConvert_openstack_Route_To_v1alpha1_Route(in *openstack.Route, out *Route, s conversion.Scope) error {
+	out.Destination = in.Destination
+	out.NextHop = in.NextHop
+	return nil
+}
+
+// Convert_openstack_Route_To_v1alpha1_Route is an autogenerated conversion function.
+func Convert_openstack_Route_To_v1alpha1_Route(in *openstack.Route, out *Route, s conversion.Scope) error {
+	return autoConvert_openstack_Route_To_v1alpha1_Route(in, out, s)
+}
+
 func autoConvert_v1alpha1_Router_To_openstack_Router(in *Router, out *openstack.Router, s conversion.Scope) error {
 	out.ID = in.ID
+	out.Routes = *(*[]openstack.Route)(unsafe.Pointer(&in.Routes))
 	return nil
 }
 
@@ -1022,6 +1823,7 @@ func Convert_v1alpha1_Router_To_openstack_Router(in *Router, out *openstack.Rout
 
 func autoConvert_openstack_Router_To_v1alpha1_Router(in *openstack.Router, out *Router, s conversion.Scope) error {
 	out.ID = in.ID
+	out.Routes = *(*[]Route)(unsafe.Pointer(&in.Routes))
 	return nil
 }
 
@@ -1034,6 +1836,7 @@ func autoConvert_v1alpha1_RouterStatus_To_openstack_RouterStatus(in *RouterStatu
 	out.ID = in.ID
 	out.IP = in.IP
 	out.ExternalFixedIPs = *(*[]string)(unsafe.Pointer(&in.ExternalFixedIPs))
+	out.AvailabilityZones = *(*[]string)(unsafe.Pointer(&in.AvailabilityZones))
 	return nil
 }
 
@@ -1046,6 +1849,7 @@ func autoConvert_openstack_RouterStatus_To_v1alpha1_RouterStatus(in *openstack.R
 	out.ID = in.ID
 	out.IP = in.IP
 	out.ExternalFixedIPs = *(*[]string)(unsafe.Pointer(&in.ExternalFixedIPs))
+	out.AvailabilityZones = *(*[]string)(unsafe.Pointer(&in.AvailabilityZones))
 	return nil
 }
 
@@ -1080,6 +1884,9 @@ func Convert_openstack_SecurityGroup_To_v1alpha1_SecurityGroup(in *openstack.Sec
 
 func autoConvert_v1alpha1_ServerGroup_To_openstack_ServerGroup(in *ServerGroup, out *openstack.ServerGroup, s conversion.Scope) error {
 	out.Policy = in.Policy
+	out.MaxServerPerHost = (*int32)(unsafe.Pointer(in.MaxServerPerHost))
+	out.PerZone = (*bool)(unsafe.Pointer(in.PerZone))
+	out.MaxMembers = (*int32)(unsafe.Pointer(in.MaxMembers))
 	return nil
 }
 
@@ -1090,6 +1897,9 @@ func Convert_v1alpha1_ServerGroup_To_openstack_ServerGroup(in *ServerGroup, out
 
 func autoConvert_openstack_ServerGroup_To_v1alpha1_ServerGroup(in *openstack.ServerGroup, out *ServerGroup, s conversion.Scope) error {
 	out.Policy = in.Policy
+	out.MaxServerPerHost = (*int32)(unsafe.Pointer(in.MaxServerPerHost))
+	out.PerZone = (*bool)(unsafe.Pointer(in.PerZone))
+	out.MaxMembers = (*int32)(unsafe.Pointer(in.MaxMembers))
 	return nil
 }
 
@@ -1102,6 +1912,8 @@ func autoConvert_v1alpha1_ServerGroupDependency_To_openstack_ServerGroupDependen
 	out.PoolName = in.PoolName
 	out.ID = in.ID
 	out.Name = in.Name
+	out.Index = in.Index
+	out.Zone = in.Zone
 	return nil
 }
 
@@ -1114,6 +1926,8 @@ func autoConvert_openstack_ServerGroupDependency_To_v1alpha1_ServerGroupDependen
 	out.PoolName = in.PoolName
 	out.ID = in.ID
 	out.Name = in.Name
+	out.Index = in.Index
+	out.Zone = in.Zone
 	return nil
 }
 
@@ -1166,6 +1980,7 @@ func Convert_openstack_ShareNetworkStatus_To_v1alpha1_ShareNetworkStatus(in *ope
 
 func autoConvert_v1alpha1_Storage_To_openstack_Storage(in *Storage, out *openstack.Storage, s conversion.Scope) error {
 	out.CSIManila = (*openstack.CSIManila)(unsafe.Pointer(in.CSIManila))
+	out.StorageClasses = *(*[]openstack.StorageClass)(unsafe.Pointer(&in.StorageClasses))
 	return nil
 }
 
@@ -1176,6 +1991,7 @@ func Convert_v1alpha1_Storage_To_openstack_Storage(in *Storage, out *openstack.S
 
 func autoConvert_openstack_Storage_To_v1alpha1_Storage(in *openstack.Storage, out *Storage, s conversion.Scope) error {
 	out.CSIManila = (*CSIManila)(unsafe.Pointer(in.CSIManila))
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
 	return nil
 }
 
@@ -1184,6 +2000,46 @@ func Convert_openstack_Storage_To_v1alpha1_Storage(in *openstack.Storage, out *S
 	return autoConvert_openstack_Storage_To_v1alpha1_Storage(in, out, s)
 }
 
+func autoConvert_v1alpha1_StorageClass_To_openstack_StorageClass(in *StorageClass, out *openstack.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Backend = (*string)(unsafe.Pointer(in.Backend))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.VolumeType = (*string)(unsafe.Pointer(in.VolumeType))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.FSType = (*string)(unsafe.Pointer(in.FSType))
+	out.ReclaimPolicy = (*string)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*string)(unsafe.Pointer(in.VolumeBindingMode))
+	out.ShareProtocol = (*string)(unsafe.Pointer(in.ShareProtocol))
+	out.ShareNetworkID = (*string)(unsafe.Pointer(in.ShareNetworkID))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_openstack_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_openstack_StorageClass(in *StorageClass, out *openstack.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_openstack_StorageClass(in, out, s)
+}
+
+func autoConvert_openstack_StorageClass_To_v1alpha1_StorageClass(in *openstack.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Backend = (*string)(unsafe.Pointer(in.Backend))
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.VolumeType = (*string)(unsafe.Pointer(in.VolumeType))
+	out.AvailabilityZone = (*string)(unsafe.Pointer(in.AvailabilityZone))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.FSType = (*string)(unsafe.Pointer(in.FSType))
+	out.ReclaimPolicy = (*string)(unsafe.Pointer(in.ReclaimPolicy))
+	out.VolumeBindingMode = (*string)(unsafe.Pointer(in.VolumeBindingMode))
+	out.ShareProtocol = (*string)(unsafe.Pointer(in.ShareProtocol))
+	out.ShareNetworkID = (*string)(unsafe.Pointer(in.ShareNetworkID))
+	return nil
+}
+
+// Convert_openstack_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_openstack_StorageClass_To_v1alpha1_StorageClass(in *openstack.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_openstack_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_StorageClassDefinition_To_openstack_StorageClassDefinition(in *StorageClassDefinition, out *openstack.StorageClassDefinition, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Default = (*bool)(unsafe.Pointer(in.Default))
@@ -1264,11 +2120,60 @@ func Convert_openstack_SubnetPool_To_v1alpha1_SubnetPool(in *openstack.SubnetPoo
 	return autoConvert_openstack_SubnetPool_To_v1alpha1_SubnetPool(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeAZMapping_To_openstack_VolumeAZMapping(in *VolumeAZMapping, out *openstack.VolumeAZMapping, s conversion.Scope) error {
+	out.Region = in.Region
+	out.ComputeZone = in.ComputeZone
+	out.VolumeZone = in.VolumeZone
+	return nil
+}
+
+// Convert_v1alpha1_VolumeAZMapping_To_openstack_VolumeAZMapping is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeAZMapping_To_openstack_VolumeAZMapping(in *VolumeAZMapping, out *openstack.VolumeAZMapping, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeAZMapping_To_openstack_VolumeAZMapping(in, out, s)
+}
+
+func autoConvert_openstack_VolumeAZMapping_To_v1alpha1_VolumeAZMapping(in *openstack.VolumeAZMapping, out *VolumeAZMapping, s conversion.Scope) error {
+	out.Region = in.Region
+	out.ComputeZone = in.ComputeZone
+	out.VolumeZone = in.VolumeZone
+	return nil
+}
+
+// Convert_openstack_VolumeAZMapping_To_v1alpha1_VolumeAZMapping is an autogenerated conversion function.
+func Convert_openstack_VolumeAZMapping_To_v1alpha1_VolumeAZMapping(in *openstack.VolumeAZMapping, out *VolumeAZMapping, s conversion.Scope) error {
+	return autoConvert_openstack_VolumeAZMapping_To_v1alpha1_VolumeAZMapping(in, out, s)
+}
+
+func autoConvert_v1alpha1_VolumeType_To_openstack_VolumeType(in *VolumeType, out *openstack.VolumeType, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeType_To_openstack_VolumeType is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeType_To_openstack_VolumeType(in *VolumeType, out *openstack.VolumeType, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeType_To_openstack_VolumeType(in, out, s)
+}
+
+func autoConvert_openstack_VolumeType_To_v1alpha1_VolumeType(in *openstack.VolumeType, out *VolumeType, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	return nil
+}
+
+// Convert_openstack_VolumeType_To_v1alpha1_VolumeType is an autogenerated conversion function.
+func Convert_openstack_VolumeType_To_v1alpha1_VolumeType(in *openstack.VolumeType, out *VolumeType, s conversion.Scope) error {
+	return autoConvert_openstack_VolumeType_To_v1alpha1_VolumeType(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerConfig_To_openstack_WorkerConfig(in *WorkerConfig, out *openstack.WorkerConfig, s conversion.Scope) error {
 	out.NodeTemplate = (*extensionsv1alpha1.NodeTemplate)(unsafe.Pointer(in.NodeTemplate))
 	out.ServerGroup = (*openstack.ServerGroup)(unsafe.Pointer(in.ServerGroup))
 	out.MachineLabels = *(*[]openstack.MachineLabel)(unsafe.Pointer(&in.MachineLabels))
 	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
+	out.UseConfigDrive = (*bool)(unsafe.Pointer(in.UseConfigDrive))
+	out.ZoneFallback = (*bool)(unsafe.Pointer(in.ZoneFallback))
+	out.StoreOversizedUserData = (*bool)(unsafe.Pointer(in.StoreOversizedUserData))
 	return nil
 }
 
@@ -1282,6 +2187,9 @@ func autoConvert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in *openstack.W
 	out.ServerGroup = (*ServerGroup)(unsafe.Pointer(in.ServerGroup))
 	out.MachineLabels = *(*[]MachineLabel)(unsafe.Pointer(&in.MachineLabels))
 	out.AdditionalSecurityGroups = *(*[]string)(unsafe.Pointer(&in.AdditionalSecurityGroups))
+	out.UseConfigDrive = (*bool)(unsafe.Pointer(in.UseConfigDrive))
+	out.ZoneFallback = (*bool)(unsafe.Pointer(in.ZoneFallback))
+	out.StoreOversizedUserData = (*bool)(unsafe.Pointer(in.StoreOversizedUserData))
 	return nil
 }
 
@@ -1290,9 +2198,34 @@ func Convert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in *openstack.Worke
 	return autoConvert_openstack_WorkerConfig_To_v1alpha1_WorkerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_WorkerPoolZones_To_openstack_WorkerPoolZones(in *WorkerPoolZones, out *openstack.WorkerPoolZones, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zones = *(*[]openstack.ZoneIndex)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_v1alpha1_WorkerPoolZones_To_openstack_WorkerPoolZones is an autogenerated conversion function.
+func Convert_v1alpha1_WorkerPoolZones_To_openstack_WorkerPoolZones(in *WorkerPoolZones, out *openstack.WorkerPoolZones, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WorkerPoolZones_To_openstack_WorkerPoolZones(in, out, s)
+}
+
+func autoConvert_openstack_WorkerPoolZones_To_v1alpha1_WorkerPoolZones(in *openstack.WorkerPoolZones, out *WorkerPoolZones, s conversion.Scope) error {
+	out.PoolName = in.PoolName
+	out.Zones = *(*[]ZoneIndex)(unsafe.Pointer(&in.Zones))
+	return nil
+}
+
+// Convert_openstack_WorkerPoolZones_To_v1alpha1_WorkerPoolZones is an autogenerated conversion function.
+func Convert_openstack_WorkerPoolZones_To_v1alpha1_WorkerPoolZones(in *openstack.WorkerPoolZones, out *WorkerPoolZones, s conversion.Scope) error {
+	return autoConvert_openstack_WorkerPoolZones_To_v1alpha1_WorkerPoolZones(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in *WorkerStatus, out *openstack.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]openstack.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.ServerGroupDependencies = *(*[]openstack.ServerGroupDependency)(unsafe.Pointer(&in.ServerGroupDependencies))
+	out.WorkerPoolZones = *(*[]openstack.WorkerPoolZones)(unsafe.Pointer(&in.WorkerPoolZones))
+	out.ExhaustedZones = *(*[]openstack.ExhaustedZone)(unsafe.Pointer(&in.ExhaustedZones))
+	out.Flavors = *(*[]openstack.Flavor)(unsafe.Pointer(&in.Flavors))
 	return nil
 }
 
@@ -1304,6 +2237,9 @@ func Convert_v1alpha1_WorkerStatus_To_openstack_WorkerStatus(in *WorkerStatus, o
 func autoConvert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in *openstack.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
 	out.ServerGroupDependencies = *(*[]ServerGroupDependency)(unsafe.Pointer(&in.ServerGroupDependencies))
+	out.WorkerPoolZones = *(*[]WorkerPoolZones)(unsafe.Pointer(&in.WorkerPoolZones))
+	out.ExhaustedZones = *(*[]ExhaustedZone)(unsafe.Pointer(&in.ExhaustedZones))
+	out.Flavors = *(*[]Flavor)(unsafe.Pointer(&in.Flavors))
 	return nil
 }
 
@@ -1311,3 +2247,25 @@ func autoConvert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in *openstack.W
 func Convert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in *openstack.WorkerStatus, out *WorkerStatus, s conversion.Scope) error {
 	return autoConvert_openstack_WorkerStatus_To_v1alpha1_WorkerStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_ZoneIndex_To_openstack_ZoneIndex(in *ZoneIndex, out *openstack.ZoneIndex, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Index = in.Index
+	return nil
+}
+
+// Convert_v1alpha1_ZoneIndex_To_openstack_ZoneIndex is an autogenerated conversion function.
+func Convert_v1alpha1_ZoneIndex_To_openstack_ZoneIndex(in *ZoneIndex, out *openstack.ZoneIndex, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ZoneIndex_To_openstack_ZoneIndex(in, out, s)
+}
+
+func autoConvert_openstack_ZoneIndex_To_v1alpha1_ZoneIndex(in *openstack.ZoneIndex, out *ZoneIndex, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Index = in.Index
+	return nil
+}
+
+// Convert_openstack_ZoneIndex_To_v1alpha1_ZoneIndex is an autogenerated conversion function.
+func Convert_openstack_ZoneIndex_To_v1alpha1_ZoneIndex(in *openstack.ZoneIndex, out *ZoneIndex, s conversion.Scope) error {
+	return autoConvert_openstack_ZoneIndex_To_v1alpha1_ZoneIndex(in, out, s)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"maps"
 	"net"
+	"regexp"
 	"slices"
 
 	gardencoreapi "github.com/gardener/gardener/pkg/api"
@@ -18,12 +19,20 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/gardener"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 )
 
+// maxServerMetadataLength is the maximum length of the keys and values of Nova server metadata.
+const maxServerMetadataLength = 255
+
+// serverMetadataKeyRegex matches the keys which are allowed for server metadata, see NormalizeLabelsForMachineClass.
+var serverMetadataKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9-_:. ]{1,255}$`)
+
 // ValidateCloudProfileConfig validates a CloudProfileConfig object.
 func ValidateCloudProfileConfig(cloudProfile *api.CloudProfileConfig, machineImages []core.MachineImage, capabilityDefinitions []gardencorev1beta1.CapabilityDefinition, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -148,6 +157,31 @@ func ValidateCloudProfileConfig(cloudProfile *api.CloudProfileConfig, machineIma
 		allErrs = append(allErrs, field.Required(fldPath.Child("dhcpDomain"), "must provide a dhcp domain when the key is specified"))
 	}
 
+	volumeAZMappingsPath := fldPath.Child("volumeAZMappings")
+	if len(cloudProfile.VolumeAZMappings) > 0 && ptr.Deref(cloudProfile.IgnoreVolumeAZ, false) {
+		allErrs = append(allErrs, field.Forbidden(volumeAZMappingsPath, "must not be set if the volume availability zones are ignored"))
+	}
+	computeZonesFound := sets.New[string]()
+	for i, mapping := range cloudProfile.VolumeAZMappings {
+		idxPath := volumeAZMappingsPath.Index(i)
+
+		if len(mapping.Region) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("region"), "must provide a region"))
+		}
+		if len(mapping.ComputeZone) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("computeZone"), "must provide a compute availability zone"))
+		}
+		if len(mapping.VolumeZone) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("volumeZone"), "must provide a volume availability zone"))
+		}
+
+		key := mapping.Region + "/" + mapping.ComputeZone
+		if computeZonesFound.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("computeZone"), mapping.ComputeZone))
+		}
+		computeZonesFound.Insert(key)
+	}
+
 	serverGroupPath := fldPath.Child("serverGroupPolicies")
 	for i, policy := range cloudProfile.ServerGroupPolicies {
 		idxPath := serverGroupPath.Index(i)
@@ -157,6 +191,79 @@ func ValidateCloudProfileConfig(cloudProfile *api.CloudProfileConfig, machineIma
 		}
 	}
 
+	pciAliasesPath := fldPath.Child("flavorPCIAliases")
+	pciAliasesFound := sets.New[string]()
+	for i, alias := range cloudProfile.FlavorPCIAliases {
+		idxPath := pciAliasesPath.Index(i)
+
+		if len(alias.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if pciAliasesFound.Has(alias.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), alias.Name))
+		}
+		pciAliasesFound.Insert(alias.Name)
+
+		for _, msg := range validation.IsQualifiedName(alias.ResourceName) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("resourceName"), alias.ResourceName, msg))
+		}
+	}
+
+	serverMetadataPath := fldPath.Child("serverMetadata")
+	for _, key := range slices.Sorted(maps.Keys(cloudProfile.ServerMetadata)) {
+		if !serverMetadataKeyRegex.MatchString(key) {
+			allErrs = append(allErrs, field.Invalid(serverMetadataPath.Key(key), key, fmt.Sprintf("key must consist of at most %d alphanumeric characters, '-', '_', ':', '.' or ' '", maxServerMetadataLength)))
+		}
+		if value := cloudProfile.ServerMetadata[key]; len(value) > maxServerMetadataLength {
+			allErrs = append(allErrs, field.TooLong(serverMetadataPath.Key(key), value, maxServerMetadataLength))
+		}
+	}
+
+	machineTypesPath := fldPath.Child("machineTypes")
+	machineTypesFound := sets.New[string]()
+	for i, machineType := range cloudProfile.MachineTypes {
+		namePath := machineTypesPath.Index(i).Child("name")
+		if len(machineType.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "must provide a name"))
+		} else if machineTypesFound.Has(machineType.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, machineType.Name))
+		}
+		machineTypesFound.Insert(machineType.Name)
+	}
+
+	volumeTypesPath := fldPath.Child("volumeTypes")
+	volumeTypesFound := sets.New[string]()
+	for i, volumeType := range cloudProfile.VolumeTypes {
+		namePath := volumeTypesPath.Index(i).Child("name")
+		if len(volumeType.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "must provide a name"))
+		} else if volumeTypesFound.Has(volumeType.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, volumeType.Name))
+		}
+		volumeTypesFound.Insert(volumeType.Name)
+	}
+
+	allErrs = append(allErrs, validateBootCapabilityDefinitions(capabilityDefinitions, field.NewPath("spec").Child("machineCapabilities"))...)
+
+	return allErrs
+}
+
+// validateBootCapabilityDefinitions validates that the capabilities for the boot features of machines only define
+// values which are known to the extension, as only those can be mapped to the flavor extra specs and image properties.
+func validateBootCapabilityDefinitions(capabilityDefinitions []gardencorev1beta1.CapabilityDefinition, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, definition := range capabilityDefinitions {
+		supportedValues, ok := helper.BootCapabilityValues(definition.Name)
+		if !ok {
+			continue
+		}
+		for j, value := range definition.Values {
+			if !slices.Contains(supportedValues, value) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Index(i).Child("values").Index(j), value, supportedValues))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -348,6 +455,19 @@ func validateLoadBalancerClass(lbClass api.LoadBalancerClass, fldPath *field.Pat
 	if lbClass.FloatingSubnetID != nil {
 		allErrs = append(allErrs, uuid(*lbClass.FloatingSubnetID, fldPath.Child("floatingSubnetID"))...)
 	}
+	if lbClass.IPv6SubnetID != nil {
+		allErrs = append(allErrs, uuid(*lbClass.IPv6SubnetID, fldPath.Child("ipv6SubnetID"))...)
+	}
+	if lbClass.IPv6FloatingSubnetID != nil {
+		allErrs = append(allErrs, uuid(*lbClass.IPv6FloatingSubnetID, fldPath.Child("ipv6FloatingSubnetID"))...)
+	}
+	if lbClass.IPv6SubnetID != nil && lbClass.IPv6FloatingSubnetID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "specify IPv6 subnet id and IPv6 floating subnet id is not possible"))
+	}
+	// The vpn load balancer is always an IPv4 load balancer.
+	if lbClass.Purpose != nil && *lbClass.Purpose == api.VPNLoadBalancerClass && (lbClass.IPv6SubnetID != nil || lbClass.IPv6FloatingSubnetID != nil) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("IPv6 subnets cannot be specified for a LoadBalancerClass with purpose %q", api.VPNLoadBalancerClass)))
+	}
 
 	allErrs = append(allErrs, validateResourceName(lbClass.Name, fldPath.Child("name"))...)
 	if lbClass.FloatingSubnetName != nil {
@@ -389,6 +509,15 @@ func ValidateLoadBalancerClasses(loadBalancerClasses []api.LoadBalancerClass, fl
 		// Validate first the load balancer class itself.
 		allErrs = append(allErrs, validateLoadBalancerClass(class, lbClassPath)...)
 
+		// The cloud-controller-manager only supports network settings for additional load balancer classes, hence the
+		// load balancer settings are only allowed for the default class, which is used for the global configuration.
+		if class.Settings != nil {
+			if (class.Purpose == nil || *class.Purpose != api.DefaultLoadBalancerClass) && class.Name != api.DefaultLoadBalancerClass {
+				allErrs = append(allErrs, field.Forbidden(lbClassPath.Child("settings"), "load balancer settings can only be set for the default load balancer class"))
+			}
+			allErrs = append(allErrs, validateLoadBalancerSettings(class.Settings, lbClassPath.Child("settings"))...)
+		}
+
 		// All load balancer classes need to have an unique name. Check for duplicates.
 		if lbClassNames.Has(class.Name) {
 			allErrs = append(allErrs, field.Duplicate(lbClassPath.Child("name"), class.Name))
@@ -415,6 +544,13 @@ func ValidateLoadBalancerClasses(loadBalancerClasses []api.LoadBalancerClass, fl
 		}
 	}
 
+	// The load balancer classes derived for IPv6 load balancers must not collide with other load balancer classes.
+	for i, class := range loadBalancerClasses {
+		if name, ok := helper.IPv6LoadBalancerClassName(class); ok && lbClassNames.Has(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), class.Name, fmt.Sprintf("load balancer class %q for IPv6 load balancers collides with another load balancer class", name)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"strings"
+
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -676,6 +678,63 @@ var _ = Describe("CloudProfileConfig validation", func() {
 			})
 		})
 
+		Context("volume availability zone mapping validation", func() {
+			It("should allow valid mappings", func() {
+				cloudProfileConfig.VolumeAZMappings = []api.VolumeAZMapping{
+					{Region: "europe", ComputeZone: "nova-1", VolumeZone: "cinder-1"},
+					{Region: "europe", ComputeZone: "nova-2", VolumeZone: "cinder-1"},
+					{Region: "asia", ComputeZone: "nova-1", VolumeZone: "cinder-2"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid incomplete and duplicate mappings", func() {
+				cloudProfileConfig.VolumeAZMappings = []api.VolumeAZMapping{
+					{},
+					{Region: "europe", ComputeZone: "nova-1", VolumeZone: "cinder-1"},
+					{Region: "europe", ComputeZone: "nova-1", VolumeZone: "cinder-2"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.volumeAZMappings[0].region"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.volumeAZMappings[0].computeZone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.volumeAZMappings[0].volumeZone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("root.volumeAZMappings[2].computeZone"),
+					})),
+				))
+			})
+
+			It("should forbid mappings if the volume availability zones are ignored", func() {
+				cloudProfileConfig.IgnoreVolumeAZ = ptr.To(true)
+				cloudProfileConfig.VolumeAZMappings = []api.VolumeAZMapping{
+					{Region: "europe", ComputeZone: "nova-1", VolumeZone: "cinder-1"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("root.volumeAZMappings"),
+				}))))
+			})
+		})
+
 		Context("server group policy validation", func() {
 			It("should forbid empty server group policy", func() {
 				cloudProfileConfig.ServerGroupPolicies = []string{
@@ -691,6 +750,174 @@ var _ = Describe("CloudProfileConfig validation", func() {
 				}))))
 			})
 		})
+
+		Context("flavor PCI alias validation", func() {
+			It("should allow valid PCI aliases", func() {
+				cloudProfileConfig.FlavorPCIAliases = []api.FlavorPCIAlias{
+					{Name: "a100", ResourceName: "gpu"},
+					{Name: "mlx", ResourceName: "example.com/nic"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid empty, duplicate and invalid PCI aliases", func() {
+				cloudProfileConfig.FlavorPCIAliases = []api.FlavorPCIAlias{
+					{Name: "a100", ResourceName: "gpu"},
+					{Name: "a100", ResourceName: "gpu"},
+					{Name: "", ResourceName: "invalid/resource/name"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("root.flavorPCIAliases[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.flavorPCIAliases[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("root.flavorPCIAliases[2].resourceName"),
+					})),
+				))
+			})
+		})
+
+		Context("server metadata validation", func() {
+			It("should allow valid server metadata", func() {
+				cloudProfileConfig.ServerMetadata = map[string]string{
+					"cost-center":        "1234",
+					"billing:department": "platform",
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid invalid keys and too long values", func() {
+				cloudProfileConfig.ServerMetadata = map[string]string{
+					"billing/department": "platform",
+					"cost-center":        strings.Repeat("a", 256),
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("root.serverMetadata[billing/department]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooLong),
+						"Field": Equal("root.serverMetadata[cost-center]"),
+					})),
+				))
+			})
+		})
+
+		Context("machine type validation", func() {
+			It("should allow valid machine types", func() {
+				cloudProfileConfig.MachineTypes = []api.MachineType{
+					{Name: "bm.large", BareMetal: ptr.To(true)},
+					{Name: "m1.large"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid empty and duplicate machine type names", func() {
+				cloudProfileConfig.MachineTypes = []api.MachineType{
+					{Name: "bm.large", BareMetal: ptr.To(true)},
+					{Name: "bm.large"},
+					{Name: ""},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("root.machineTypes[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.machineTypes[2].name"),
+					})),
+				))
+			})
+		})
+
+		Context("volume type validation", func() {
+			It("should forbid empty and duplicate volume type names", func() {
+				cloudProfileConfig.VolumeTypes = []api.VolumeType{
+					{Name: "ssd-encrypted", Encrypted: ptr.To(true)},
+					{Name: "ssd-encrypted"},
+					{Name: ""},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("root.volumeTypes[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("root.volumeTypes[2].name"),
+					})),
+				))
+			})
+		})
+
+		Context("boot capability validation", func() {
+			BeforeEach(func() {
+				if !isCapabilitiesCloudProfile {
+					Skip("boot capabilities only apply to capabilities CloudProfiles")
+				}
+			})
+
+			It("should allow the supported values of the boot capabilities", func() {
+				capabilityDefinitions = append(capabilityDefinitions,
+					v1beta1.CapabilityDefinition{Name: "secureBoot", Values: []string{"disabled", "enabled"}},
+					v1beta1.CapabilityDefinition{Name: "tpm", Values: []string{"disabled", "enabled"}},
+					v1beta1.CapabilityDefinition{Name: "confidentialComputing", Values: []string{"none", "sev", "tdx"}},
+				)
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid unsupported values of the boot capabilities", func() {
+				capabilityDefinitions = append(capabilityDefinitions,
+					v1beta1.CapabilityDefinition{Name: "secureBoot", Values: []string{"disabled", "true"}},
+					v1beta1.CapabilityDefinition{Name: "confidentialComputing", Values: []string{"none", "sev-snp"}},
+				)
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.machineCapabilities[1].values[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.machineCapabilities[2].values[1]"),
+					})),
+				))
+			})
+		})
 	},
 		Entry("CloudProfile uses regions only", false),
 		Entry("CloudProfile uses capabilities", true))
@@ -772,6 +999,58 @@ var _ = Describe("LoadBalancerClass validation", func() {
 				"Field": Equal("loadBalancerClasses[0]"),
 			}))))
 		})
+
+		It("should pass as LoadBalancerClass specifies IPv6 subnets", func() {
+			loadBalancerClasses[0].IPv6SubnetID = ptr.To(testUUID)
+			Expect(ValidateLoadBalancerClasses(loadBalancerClasses, fieldPath)).To(BeEmpty())
+
+			loadBalancerClasses[0].IPv6SubnetID = nil
+			loadBalancerClasses[0].IPv6FloatingSubnetID = ptr.To(testUUID)
+			Expect(ValidateLoadBalancerClasses(loadBalancerClasses, fieldPath)).To(BeEmpty())
+		})
+
+		It("should fail as LoadBalancerClass specifies invalid IPv6 subnet ids", func() {
+			loadBalancerClasses[0].IPv6SubnetID = ptr.To("invalid-uuid")
+			loadBalancerClasses[0].IPv6FloatingSubnetID = ptr.To("invalid-uuid")
+
+			errorList := ValidateLoadBalancerClasses(loadBalancerClasses, fieldPath)
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancerClasses[0].ipv6SubnetID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancerClasses[0].ipv6FloatingSubnetID"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("loadBalancerClasses[0]"),
+				})),
+			))
+		})
+
+		It("should fail as LoadBalancerClass specifies IPv6 subnet and IPv6 floating subnet", func() {
+			loadBalancerClasses[0].IPv6SubnetID = ptr.To(testUUID)
+			loadBalancerClasses[0].IPv6FloatingSubnetID = ptr.To(testUUID)
+
+			errorList := ValidateLoadBalancerClasses(loadBalancerClasses, fieldPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("loadBalancerClasses[0]"),
+			}))))
+		})
+
+		It("should fail as LoadBalancerClass with purpose vpn specifies IPv6 subnets", func() {
+			loadBalancerClasses[0].Purpose = ptr.To("vpn")
+			loadBalancerClasses[0].IPv6FloatingSubnetID = ptr.To(testUUID)
+
+			errorList := ValidateLoadBalancerClasses(loadBalancerClasses, fieldPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("loadBalancerClasses[0]"),
+			}))))
+		})
 	})
 
 	Context("LoadBalancerClassList", func() {
@@ -797,6 +1076,17 @@ var _ = Describe("LoadBalancerClass validation", func() {
 			}))))
 		})
 
+		It("should fail as the IPv6 LoadBalancerClass collides with another LoadBalancerClass", func() {
+			loadBalancerClasses[0].IPv6SubnetID = ptr.To(testUUID)
+			loadBalancerClasses[1].Name = "test1-ipv6"
+
+			errorList := ValidateLoadBalancerClasses(loadBalancerClasses, fieldPath)
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("loadBalancerClasses[0].name"),
+			}))))
+		})
+
 		Context("Default LoadBalancerClasses", func() {
 			It("should fail as there are multiple LoadBalancerClasses with purpose default", func() {
 				loadBalancerClasses[0].Purpose = ptr.To("default")
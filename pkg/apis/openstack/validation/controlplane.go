@@ -5,13 +5,46 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const (
+	// maxLoadBalancerMonitorRetries is the maximum number of retries of Octavia health monitors.
+	maxLoadBalancerMonitorRetries = 10
+	// minApplicationCredentialRotationPeriod is the minimum period after which application credentials are rotated.
+	minApplicationCredentialRotationPeriod = 24 * time.Hour
+)
+
+var (
+	supportedLoadBalancerMethods = []string{
+		api.LoadBalancerMethodRoundRobin,
+		api.LoadBalancerMethodLeastConnections,
+		api.LoadBalancerMethodSourceIP,
+		api.LoadBalancerMethodSourceIPPort,
+	}
+	supportedFSTypes            = []string{"ext3", "ext4", "xfs"}
+	supportedReclaimPolicies    = []string{string(corev1.PersistentVolumeReclaimDelete), string(corev1.PersistentVolumeReclaimRetain)}
+	supportedVolumeBindingModes = []string{string(storagev1.VolumeBindingImmediate), string(storagev1.VolumeBindingWaitForFirstConsumer)}
+	supportedShareProtocols     = []string{api.ShareProtocolNFS, api.ShareProtocolCephFS}
+	supportedCephFSMounters     = []string{api.CephFSMounterKernel, api.CephFSMounterFuse}
 )
 
 // ValidateControlPlaneConfig validates a ControlPlaneConfig object.
@@ -30,6 +63,19 @@ func ValidateControlPlaneConfig(controlPlaneConfig *api.ControlPlaneConfig, infr
 		if class.Purpose != nil && *class.Purpose == api.VPNLoadBalancerClass {
 			allErrs = append(allErrs, field.Invalid(loadBalancerClassPath.Index(i), class.Purpose, fmt.Sprintf("not allowed to specify a LoadBalancerClass with purpose %q", api.VPNLoadBalancerClass)))
 		}
+		// IPv6 load balancers can only be created in dual-stack shoots.
+		if (class.IPv6SubnetID != nil || class.IPv6FloatingSubnetID != nil) && infraConfig.Networks.IPv6 == nil {
+			allErrs = append(allErrs, field.Forbidden(loadBalancerClassPath.Index(i), "IPv6 subnets can only be specified for dual-stack shoots with IPv6 networks in the InfrastructureConfig"))
+		}
+	}
+
+	loadBalancerPath := fldPath.Child("loadBalancer")
+	allErrs = append(allErrs, validateLoadBalancerSettings(controlPlaneConfig.LoadBalancer, loadBalancerPath)...)
+	if controlPlaneConfig.LoadBalancerProvider == "ovn" {
+		allErrs = append(allErrs, validateOVNLoadBalancerMethod(controlPlaneConfig.LoadBalancer, loadBalancerPath.Child("method"))...)
+		for i, class := range controlPlaneConfig.LoadBalancerClasses {
+			allErrs = append(allErrs, validateOVNLoadBalancerMethod(class.Settings, loadBalancerClassPath.Index(i).Child("settings", "method"))...)
+		}
 	}
 
 	if controlPlaneConfig.CloudControllerManager != nil {
@@ -38,13 +84,36 @@ func ValidateControlPlaneConfig(controlPlaneConfig *api.ControlPlaneConfig, infr
 
 	allErrs = append(allErrs, validateStorage(controlPlaneConfig.Storage, infraConfig.Networks.ShareNetwork, fldPath.Child("storage"))...)
 
+	if kms := controlPlaneConfig.KMS; kms != nil {
+		if len(kms.KeyID) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("kms", "keyID"), "must provide the ID of the Barbican secret"))
+		} else {
+			allErrs = append(allErrs, uuid(kms.KeyID, fldPath.Child("kms", "keyID"))...)
+		}
+	}
+
+	allErrs = append(allErrs, validateKeystoneAuth(controlPlaneConfig.KeystoneAuth, fldPath.Child("keystoneAuth"))...)
+
+	if applicationCredentials := controlPlaneConfig.ApplicationCredentials; applicationCredentials != nil && applicationCredentials.RotationPeriod != nil {
+		if applicationCredentials.RotationPeriod.Duration < minApplicationCredentialRotationPeriod {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("applicationCredentials", "rotationPeriod"), applicationCredentials.RotationPeriod.Duration.String(), fmt.Sprintf("must be at least %s", minApplicationCredentialRotationPeriod)))
+		}
+	}
+
+	allErrs = append(allErrs, validateLoadBalancerPolicy(controlPlaneConfig.LoadBalancerPolicy, controlPlaneConfig.LoadBalancerClasses, fldPath.Child("loadBalancerPolicy"))...)
+
 	return allErrs
 }
 
 // ValidateControlPlaneConfigUpdate validates a ControlPlaneConfig object.
-func ValidateControlPlaneConfigUpdate(_, _ *api.ControlPlaneConfig, _ *field.Path) field.ErrorList {
+func ValidateControlPlaneConfigUpdate(oldConfig, newConfig *api.ControlPlaneConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
+	// The resources encrypted by the KMS plugin can only be decrypted with the key they were encrypted with.
+	if oldConfig.KMS != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.KMS, oldConfig.KMS, fldPath.Child("kms"))...)
+	}
+
 	return allErrs
 }
 
@@ -138,13 +207,291 @@ func validateLoadBalancerClassesConstraints(floatingPools []api.FloatingPool, sh
 	return allErrs
 }
 
+// validateLoadBalancerSettings validates the settings of the load balancers created by the cloud-controller-manager.
+func validateLoadBalancerSettings(settings *api.LoadBalancerSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if settings == nil {
+		return allErrs
+	}
+
+	if settings.FlavorID != nil {
+		allErrs = append(allErrs, uuid(*settings.FlavorID, fldPath.Child("flavorID"))...)
+	}
+	if settings.AvailabilityZone != nil && len(*settings.AvailabilityZone) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("availabilityZone"), "must provide an availability zone if key is present"))
+	}
+	if settings.Method != nil && !slices.Contains(supportedLoadBalancerMethods, *settings.Method) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("method"), *settings.Method, supportedLoadBalancerMethods))
+	}
+	if settings.MaxSharedLB != nil && *settings.MaxSharedLB < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSharedLB"), *settings.MaxSharedLB, "must be at least 1"))
+	}
+
+	if monitor := settings.Monitor; monitor != nil {
+		monitorPath := fldPath.Child("monitor")
+		if monitor.Delay != nil && monitor.Delay.Duration < time.Second {
+			allErrs = append(allErrs, field.Invalid(monitorPath.Child("delay"), monitor.Delay.Duration.String(), "must be at least 1s"))
+		}
+		if monitor.Timeout != nil && monitor.Timeout.Duration < time.Second {
+			allErrs = append(allErrs, field.Invalid(monitorPath.Child("timeout"), monitor.Timeout.Duration.String(), "must be at least 1s"))
+		}
+		if monitor.MaxRetries != nil && (*monitor.MaxRetries < 1 || *monitor.MaxRetries > maxLoadBalancerMonitorRetries) {
+			allErrs = append(allErrs, field.Invalid(monitorPath.Child("maxRetries"), *monitor.MaxRetries, fmt.Sprintf("must be between 1 and %d", maxLoadBalancerMonitorRetries)))
+		}
+		if monitor.MaxRetriesDown != nil && (*monitor.MaxRetriesDown < 1 || *monitor.MaxRetriesDown > maxLoadBalancerMonitorRetries) {
+			allErrs = append(allErrs, field.Invalid(monitorPath.Child("maxRetriesDown"), *monitor.MaxRetriesDown, fmt.Sprintf("must be between 1 and %d", maxLoadBalancerMonitorRetries)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateOVNLoadBalancerMethod validates that only the load balancing method supported by the `ovn` provider is used.
+func validateOVNLoadBalancerMethod(settings *api.LoadBalancerSettings, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if settings != nil && settings.Method != nil && *settings.Method != api.LoadBalancerMethodSourceIPPort {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *settings.Method, []string{api.LoadBalancerMethodSourceIPPort}))
+	}
+	return allErrs
+}
+
 func validateStorage(storage *api.Storage, shareNetwork *api.ShareNetwork, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
-	if storage == nil || storage.CSIManila == nil || !storage.CSIManila.Enabled {
+	if storage == nil {
 		return allErrs
 	}
-	if shareNetwork == nil || !shareNetwork.Enabled {
+
+	csiManilaEnabled := storage.CSIManila != nil && storage.CSIManila.Enabled
+	if csiManilaEnabled && (shareNetwork == nil || !shareNetwork.Enabled) {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("csiManila", "enabled"), storage.CSIManila.Enabled, "share network must be created if CSI manila driver is enabled"))
 	}
+	cephFSEnabled := csiManilaEnabled && storage.CSIManila.CephFS != nil
+	if storage.CSIManila != nil && storage.CSIManila.CephFS != nil {
+		cephFSPath := fldPath.Child("csiManila", "cephFS")
+		if !csiManilaEnabled {
+			allErrs = append(allErrs, field.Forbidden(cephFSPath, "CSI Manila driver must be enabled for CephFS shares"))
+		}
+		if mounter := storage.CSIManila.CephFS.Mounter; mounter != nil && !slices.Contains(supportedCephFSMounters, *mounter) {
+			allErrs = append(allErrs, field.NotSupported(cephFSPath.Child("mounter"), *mounter, supportedCephFSMounters))
+		}
+	}
+
+	var (
+		storageClassNames = sets.New[string]()
+		defaultClasses    int
+	)
+	for i, storageClass := range storage.StorageClasses {
+		storageClassPath := fldPath.Child("storageClasses").Index(i)
+
+		allErrs = append(allErrs, validateStorageClass(storageClass, csiManilaEnabled, cephFSEnabled, storageClassPath)...)
+
+		if storageClassNames.Has(storageClass.Name) {
+			allErrs = append(allErrs, field.Duplicate(storageClassPath.Child("name"), storageClass.Name))
+		}
+		storageClassNames.Insert(storageClass.Name)
+
+		if ptr.Deref(storageClass.Default, false) {
+			defaultClasses++
+			if defaultClasses > 1 {
+				allErrs = append(allErrs, field.Forbidden(storageClassPath.Child("default"), "only one storage class can be the default storage class"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateLoadBalancerPolicy(policy *api.LoadBalancerPolicy, loadBalancerClasses []api.LoadBalancerClass, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if policy == nil {
+		return allErrs
+	}
+
+	// The load balancer classes of the CloudProfile are used if the shoot does not define its own ones, hence the
+	// referenced classes can only be validated in the latter case.
+	var loadBalancerClassNames []string
+	for _, class := range loadBalancerClasses {
+		loadBalancerClassNames = append(loadBalancerClassNames, class.Name)
+	}
+
+	for i, namespaceDefault := range policy.NamespaceDefaults {
+		namespaceDefaultPath := fldPath.Child("namespaceDefaults").Index(i)
+
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&namespaceDefault.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, namespaceDefaultPath.Child("namespaceSelector"))...)
+		if len(namespaceDefault.LoadBalancerClass) == 0 {
+			allErrs = append(allErrs, field.Required(namespaceDefaultPath.Child("loadBalancerClass"), "must provide the name of a load balancer class"))
+		} else if loadBalancerClasses != nil && !slices.Contains(loadBalancerClassNames, namespaceDefault.LoadBalancerClass) {
+			allErrs = append(allErrs, field.NotSupported(namespaceDefaultPath.Child("loadBalancerClass"), namespaceDefault.LoadBalancerClass, loadBalancerClassNames))
+		}
+	}
+
+	if policy.InternalNamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(policy.InternalNamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("internalNamespaceSelector"))...)
+	}
+
+	return allErrs
+}
+
+func validateKeystoneAuth(keystoneAuth *api.KeystoneAuth, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if keystoneAuth == nil {
+		return allErrs
+	}
+
+	keystoneRoles := sets.New[string]()
+	for i, roleMapping := range keystoneAuth.RoleMappings {
+		roleMappingPath := fldPath.Child("roleMappings").Index(i)
+
+		if len(roleMapping.KeystoneRole) == 0 {
+			allErrs = append(allErrs, field.Required(roleMappingPath.Child("keystoneRole"), "must provide the name of the Keystone role"))
+		} else if keystoneRoles.Has(roleMapping.KeystoneRole) {
+			allErrs = append(allErrs, field.Duplicate(roleMappingPath.Child("keystoneRole"), roleMapping.KeystoneRole))
+		}
+		keystoneRoles.Insert(roleMapping.KeystoneRole)
+
+		if len(roleMapping.Groups) == 0 {
+			allErrs = append(allErrs, field.Required(roleMappingPath.Child("groups"), "must provide at least one group"))
+		}
+		for j, group := range roleMapping.Groups {
+			if len(group) == 0 {
+				allErrs = append(allErrs, field.Required(roleMappingPath.Child("groups").Index(j), "must provide the name of the group"))
+			}
+		}
+	}
+
+	if policy := keystoneAuth.AuthorizationPolicy; policy != nil {
+		var rules []map[string]interface{}
+		if err := json.Unmarshal([]byte(*policy), &rules); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("authorizationPolicy"), *policy, fmt.Sprintf("must be a JSON list of policy rules: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateStorageClass(storageClass api.StorageClass, csiManilaEnabled, cephFSEnabled bool, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	namePath := fldPath.Child("name")
+	if len(storageClass.Name) == 0 {
+		allErrs = append(allErrs, field.Required(namePath, "must provide a name"))
+	} else {
+		for _, msg := range apivalidation.NameIsDNSSubdomain(storageClass.Name, false) {
+			allErrs = append(allErrs, field.Invalid(namePath, storageClass.Name, msg))
+		}
+	}
+	// The storage classes of the CSI Manila driver are managed by the csi-driver-manila chart.
+	if storageClass.Name == openstack.CSIManilaNFS || strings.HasPrefix(storageClass.Name, openstack.CSIManilaNFS+"-") {
+		allErrs = append(allErrs, field.Forbidden(namePath, "name is reserved for the storage classes of the CSI Manila driver"))
+	}
+
+	backend := ptr.Deref(storageClass.Backend, api.StorageBackendCinder)
+	switch backend {
+	case api.StorageBackendCinder:
+		if ptr.Deref(storageClass.Encrypted, false) && storageClass.VolumeType == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("volumeType"), "must provide an encrypted volume type if volumes must be encrypted"))
+		}
+		if storageClass.FSType != nil && !slices.Contains(supportedFSTypes, *storageClass.FSType) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("fsType"), *storageClass.FSType, supportedFSTypes))
+		}
+		if storageClass.ShareProtocol != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("shareProtocol"), "share protocol is only supported for storage classes of the manila backend"))
+		}
+		if storageClass.ShareNetworkID != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("shareNetworkID"), "share network is only supported for storage classes of the manila backend"))
+		}
+	case api.StorageBackendManila:
+		if !csiManilaEnabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("backend"), "CSI Manila driver must be enabled for storage classes of the manila backend"))
+		}
+		if storageClass.Encrypted != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("encrypted"), "encryption is not supported for storage classes of the manila backend"))
+		}
+		if storageClass.FSType != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("fsType"), "filesystem type is not supported for storage classes of the manila backend"))
+		}
+		if protocol := ptr.Deref(storageClass.ShareProtocol, api.ShareProtocolNFS); !slices.Contains(supportedShareProtocols, protocol) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("shareProtocol"), protocol, supportedShareProtocols))
+		} else if protocol == api.ShareProtocolCephFS && csiManilaEnabled && !cephFSEnabled {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("shareProtocol"), "CephFS must be enabled for the CSI Manila driver for storage classes of the CEPHFS share protocol"))
+		}
+		if storageClass.ShareNetworkID != nil {
+			if ptr.Deref(storageClass.ShareProtocol, api.ShareProtocolNFS) == api.ShareProtocolCephFS {
+				// The access to CephFS shares is granted to cephx users, not to the clients of a share network.
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("shareNetworkID"), "share network is not supported for storage classes of the CEPHFS share protocol"))
+			} else if len(*storageClass.ShareNetworkID) == 0 {
+				allErrs = append(allErrs, field.Required(fldPath.Child("shareNetworkID"), "must provide a share network ID if key is present"))
+			}
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("backend"), backend, []string{api.StorageBackendCinder, api.StorageBackendManila}))
+	}
+
+	if storageClass.VolumeType != nil && len(*storageClass.VolumeType) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("volumeType"), "must provide a volume type if key is present"))
+	}
+	if storageClass.AvailabilityZone != nil && len(*storageClass.AvailabilityZone) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("availabilityZone"), "must provide an availability zone if key is present"))
+	}
+	if storageClass.ReclaimPolicy != nil && !slices.Contains(supportedReclaimPolicies, *storageClass.ReclaimPolicy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("reclaimPolicy"), *storageClass.ReclaimPolicy, supportedReclaimPolicies))
+	}
+	if storageClass.VolumeBindingMode != nil && !slices.Contains(supportedVolumeBindingModes, *storageClass.VolumeBindingMode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("volumeBindingMode"), *storageClass.VolumeBindingMode, supportedVolumeBindingModes))
+	}
+
+	return allErrs
+}
+
+// ValidateStorageClassesAgainstCloudProfile validates the storage classes of the given ControlPlaneConfig against the
+// volume types and zones of the given CloudProfile. Storage classes which require encryption must use a volume type
+// which is marked as encrypted in the CloudProfileConfig.
+func ValidateStorageClassesAgainstCloudProfile(oldCpConfig, cpConfig *api.ControlPlaneConfig, shootRegion string, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if cpConfig.Storage == nil {
+		return allErrs
+	}
+	if oldCpConfig != nil && oldCpConfig.Storage != nil && equality.Semantic.DeepEqual(oldCpConfig.Storage.StorageClasses, cpConfig.Storage.StorageClasses) {
+		return allErrs
+	}
+
+	var usableVolumeTypes, encryptedVolumeTypes, zones []string
+	for _, volumeType := range cloudProfileSpec.VolumeTypes {
+		if ptr.Deref(volumeType.Usable, true) {
+			usableVolumeTypes = append(usableVolumeTypes, volumeType.Name)
+		}
+	}
+	if cloudProfileConfig != nil {
+		for _, volumeType := range cloudProfileConfig.VolumeTypes {
+			if ptr.Deref(volumeType.Encrypted, false) {
+				encryptedVolumeTypes = append(encryptedVolumeTypes, volumeType.Name)
+			}
+		}
+	}
+	for _, region := range cloudProfileSpec.Regions {
+		if region.Name != shootRegion {
+			continue
+		}
+		for _, zone := range region.Zones {
+			zones = append(zones, zone.Name)
+		}
+	}
+
+	for i, storageClass := range cpConfig.Storage.StorageClasses {
+		storageClassPath := fldPath.Child("storage", "storageClasses").Index(i)
+
+		// Manila share types are not part of the CloudProfile, hence only Cinder volume types can be validated.
+		if ptr.Deref(storageClass.Backend, api.StorageBackendCinder) == api.StorageBackendCinder && storageClass.VolumeType != nil {
+			if len(cloudProfileSpec.VolumeTypes) > 0 && !slices.Contains(usableVolumeTypes, *storageClass.VolumeType) {
+				allErrs = append(allErrs, field.NotSupported(storageClassPath.Child("volumeType"), *storageClass.VolumeType, usableVolumeTypes))
+			} else if ptr.Deref(storageClass.Encrypted, false) && !slices.Contains(encryptedVolumeTypes, *storageClass.VolumeType) {
+				allErrs = append(allErrs, field.Invalid(storageClassPath.Child("volumeType"), *storageClass.VolumeType, fmt.Sprintf("volume type is not encrypted according to the cloud profile, encrypted volume types are %v", encryptedVolumeTypes)))
+			}
+		}
+		if storageClass.AvailabilityZone != nil && !slices.Contains(zones, *storageClass.AvailabilityZone) {
+			allErrs = append(allErrs, field.NotSupported(storageClassPath.Child("availabilityZone"), *storageClass.AvailabilityZone, zones))
+		}
+	}
+
 	return allErrs
 }
@@ -5,10 +5,13 @@
 package validation_test
 
 import (
+	"time"
+
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -51,6 +54,22 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 			}))))
 		})
 
+		It("should forbid IPv6 subnets for LB classes of shoots without IPv6 networks", func() {
+			controlPlane.LoadBalancerClasses = []api.LoadBalancerClass{
+				{
+					Name:         "foo",
+					IPv6SubnetID: ptr.To("123e4567-e89b-12d3-a456-426614174000"),
+				},
+			}
+			Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("loadBalancerClasses[0]"),
+			}))))
+
+			infraConfig.Networks.IPv6 = &api.IPv6Config{SubnetPoolID: ptr.To("pool")}
+			Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(BeEmpty())
+		})
+
 		It("should succeed for valid LB classes", func() {
 			controlPlane.LoadBalancerClasses = []api.LoadBalancerClass{
 				{
@@ -113,12 +132,604 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		Context("load balancer settings", func() {
+			It("should succeed for valid load balancer settings", func() {
+				controlPlane.LoadBalancer = &api.LoadBalancerSettings{
+					FlavorID:              ptr.To("123e4567-e89b-12d3-a456-426614174000"),
+					AvailabilityZone:      ptr.To("az1"),
+					Method:                ptr.To(api.LoadBalancerMethodLeastConnections),
+					EnableIngressHostname: ptr.To(true),
+					MaxSharedLB:           ptr.To[int32](3),
+					Monitor: &api.LoadBalancerMonitor{
+						Enabled:        ptr.To(true),
+						Delay:          &metav1.Duration{Duration: 5 * time.Second},
+						Timeout:        &metav1.Duration{Duration: 3 * time.Second},
+						MaxRetries:     ptr.To[int32](1),
+						MaxRetriesDown: ptr.To[int32](3),
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(BeEmpty())
+			})
+
+			It("should fail for invalid load balancer settings", func() {
+				controlPlane.LoadBalancer = &api.LoadBalancerSettings{
+					FlavorID:         ptr.To("small"),
+					AvailabilityZone: ptr.To(""),
+					Method:           ptr.To("RANDOM"),
+					MaxSharedLB:      ptr.To[int32](0),
+					Monitor: &api.LoadBalancerMonitor{
+						Delay:          &metav1.Duration{Duration: 500 * time.Millisecond},
+						Timeout:        &metav1.Duration{},
+						MaxRetries:     ptr.To[int32](0),
+						MaxRetriesDown: ptr.To[int32](11),
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.flavorID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("loadBalancer.availabilityZone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("loadBalancer.method"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.maxSharedLB"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.monitor.delay"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.monitor.timeout"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.monitor.maxRetries"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancer.monitor.maxRetriesDown"),
+					})),
+				))
+			})
+
+			It("should only allow the SOURCE_IP_PORT method for the ovn provider", func() {
+				controlPlane.LoadBalancerProvider = "ovn"
+				controlPlane.LoadBalancer = &api.LoadBalancerSettings{Method: ptr.To(api.LoadBalancerMethodRoundRobin)}
+				controlPlane.LoadBalancerClasses = []api.LoadBalancerClass{
+					{
+						Name:     "default",
+						Settings: &api.LoadBalancerSettings{Method: ptr.To(api.LoadBalancerMethodSourceIP)},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("loadBalancer.method"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("loadBalancerClasses[0].settings.method"),
+					})),
+				))
+			})
+
+			It("should forbid load balancer settings for non-default load balancer classes", func() {
+				controlPlane.LoadBalancerClasses = []api.LoadBalancerClass{
+					{
+						Name:     "default-class",
+						Purpose:  ptr.To("default"),
+						Settings: &api.LoadBalancerSettings{MaxSharedLB: ptr.To[int32](2)},
+					},
+					{
+						Name:     "other",
+						Settings: &api.LoadBalancerSettings{MaxSharedLB: ptr.To[int32](2)},
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("loadBalancerClasses[1].settings"),
+					})),
+				))
+			})
+		})
+
+		Context("kms", func() {
+			It("should succeed for a valid key ID", func() {
+				controlPlane.KMS = &api.KMS{KeyID: "123e4567-e89b-12d3-a456-426614174000"}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(BeEmpty())
+			})
+
+			It("should fail if the key ID is missing", func() {
+				controlPlane.KMS = &api.KMS{}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("kms.keyID"),
+					})),
+				))
+			})
+
+			It("should fail if the key ID is not a valid uuid", func() {
+				controlPlane.KMS = &api.KMS{KeyID: "invalid-uuid"}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("kms.keyID"),
+					})),
+				))
+			})
+		})
+
+		Context("application credentials", func() {
+			It("should succeed without rotation period", func() {
+				controlPlane.ApplicationCredentials = &api.ApplicationCredentials{}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(BeEmpty())
+			})
+
+			It("should fail for a too short rotation period", func() {
+				controlPlane.ApplicationCredentials = &api.ApplicationCredentials{RotationPeriod: &metav1.Duration{Duration: time.Hour}}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("applicationCredentials.rotationPeriod"),
+					})),
+				))
+			})
+		})
+
+		Context("load balancer policy", func() {
+			It("should succeed for a valid policy", func() {
+				controlPlane.LoadBalancerClasses = []api.LoadBalancerClass{{Name: "internal"}, {Name: "public"}}
+				controlPlane.LoadBalancerPolicy = &api.LoadBalancerPolicy{
+					NamespaceDefaults: []api.NamespaceLoadBalancerClass{
+						{NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}}, LoadBalancerClass: "internal"},
+					},
+					InternalNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"internal": "true"}},
+				}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(BeEmpty())
+			})
+
+			It("should not validate the referenced class if the classes of the CloudProfile are used", func() {
+				controlPlane.LoadBalancerPolicy = &api.LoadBalancerPolicy{
+					NamespaceDefaults: []api.NamespaceLoadBalancerClass{{LoadBalancerClass: "from-cloud-profile"}},
+				}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(BeEmpty())
+			})
+
+			It("should fail for an invalid policy", func() {
+				controlPlane.LoadBalancerClasses = []api.LoadBalancerClass{{Name: "internal"}}
+				controlPlane.LoadBalancerPolicy = &api.LoadBalancerPolicy{
+					NamespaceDefaults: []api.NamespaceLoadBalancerClass{
+						{NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}}},
+						{NamespaceSelector: metav1.LabelSelector{MatchLabels: map[string]string{"team": "bar"}}, LoadBalancerClass: "unknown"},
+					},
+					InternalNamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "internal", Operator: "Foo"}}},
+				}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("loadBalancerPolicy.namespaceDefaults[0].loadBalancerClass"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("loadBalancerPolicy.namespaceDefaults[1].loadBalancerClass"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("loadBalancerPolicy.internalNamespaceSelector.matchExpressions[0].operator"),
+					})),
+				))
+			})
+		})
+
+		Context("keystone auth", func() {
+			It("should succeed for a valid configuration", func() {
+				controlPlane.KeystoneAuth = &api.KeystoneAuth{
+					RoleMappings: []api.KeystoneRoleMapping{
+						{KeystoneRole: "admin", Groups: []string{"system:masters"}},
+						{KeystoneRole: "member", Groups: []string{"developers", "viewers"}},
+					},
+					AuthorizationPolicy: ptr.To(`[{"resource":{"verbs":["get"],"resources":["pods"],"namespace":"default","version":"*"},"match":[{"type":"role","values":["reader"]}]}]`),
+				}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(BeEmpty())
+			})
+
+			It("should fail for invalid role mappings", func() {
+				controlPlane.KeystoneAuth = &api.KeystoneAuth{
+					RoleMappings: []api.KeystoneRoleMapping{
+						{Groups: []string{"foo"}},
+						{KeystoneRole: "member", Groups: []string{"developers"}},
+						{KeystoneRole: "member"},
+						{KeystoneRole: "reader", Groups: []string{""}},
+					},
+				}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("keystoneAuth.roleMappings[0].keystoneRole"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("keystoneAuth.roleMappings[2].keystoneRole"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("keystoneAuth.roleMappings[2].groups"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("keystoneAuth.roleMappings[3].groups[0]"),
+					})),
+				))
+			})
+
+			It("should fail if the authorization policy is not a JSON list", func() {
+				controlPlane.KeystoneAuth = &api.KeystoneAuth{
+					AuthorizationPolicy: ptr.To(`{"foo":"bar"}`),
+				}
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("keystoneAuth.authorizationPolicy"),
+					})),
+				))
+			})
+		})
+
+		Context("storage classes", func() {
+			BeforeEach(func() {
+				controlPlane.Storage = &api.Storage{CSIManila: &api.CSIManila{Enabled: true}}
+				infraConfig.Networks.ShareNetwork = &api.ShareNetwork{Enabled: true}
+			})
+
+			It("should succeed for valid storage classes", func() {
+				controlPlane.Storage.StorageClasses = []api.StorageClass{
+					{
+						Name:              "fast",
+						Default:           ptr.To(true),
+						VolumeType:        ptr.To("ssd"),
+						Encrypted:         ptr.To(true),
+						AvailabilityZone:  ptr.To("zone1"),
+						FSType:            ptr.To("xfs"),
+						ReclaimPolicy:     ptr.To("Retain"),
+						VolumeBindingMode: ptr.To("Immediate"),
+					},
+					{
+						Name:       "shares",
+						Backend:    ptr.To(api.StorageBackendManila),
+						VolumeType: ptr.To("default"),
+					},
+					{
+						Name:          "cephfs",
+						Backend:       ptr.To(api.StorageBackendManila),
+						VolumeType:    ptr.To("cephfsnative"),
+						ShareProtocol: ptr.To(api.ShareProtocolCephFS),
+					},
+					{
+						Name:           "shares-other-network",
+						Backend:        ptr.To(api.StorageBackendManila),
+						ShareNetworkID: ptr.To("share-network"),
+					},
+				}
+				controlPlane.Storage.CSIManila.CephFS = &api.CSIManilaCephFS{Mounter: ptr.To(api.CephFSMounterFuse)}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(BeEmpty())
+			})
+
+			It("should fail for invalid storage classes", func() {
+				controlPlane.Storage.StorageClasses = []api.StorageClass{
+					{
+						Name:              "Fast_SSD",
+						Default:           ptr.To(true),
+						Encrypted:         ptr.To(true),
+						FSType:            ptr.To("ntfs"),
+						ReclaimPolicy:     ptr.To("Recycle"),
+						VolumeBindingMode: ptr.To("Later"),
+					},
+					{
+						Name:    "csi-manila-nfs-zone1",
+						Default: ptr.To(true),
+						Backend: ptr.To("swift"),
+					},
+					{
+						Name:      "shares",
+						Backend:   ptr.To(api.StorageBackendManila),
+						Encrypted: ptr.To(false),
+						FSType:    ptr.To("ext4"),
+					},
+					{
+						Name:             "shares",
+						AvailabilityZone: ptr.To(""),
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("storage.storageClasses[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("storage.storageClasses[0].volumeType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("storage.storageClasses[0].fsType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("storage.storageClasses[0].reclaimPolicy"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("storage.storageClasses[0].volumeBindingMode"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("storage.storageClasses[1].backend"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[1].default"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[2].encrypted"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[2].fsType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("storage.storageClasses[3].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("storage.storageClasses[3].availabilityZone"),
+					})),
+				))
+			})
+
+			It("should forbid manila storage classes if CSI Manila is disabled", func() {
+				controlPlane.Storage = &api.Storage{
+					StorageClasses: []api.StorageClass{{Name: "shares", Backend: ptr.To(api.StorageBackendManila)}},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[0].backend"),
+					})),
+				))
+			})
+
+			It("should fail for invalid share protocols and share networks", func() {
+				controlPlane.Storage.StorageClasses = []api.StorageClass{
+					{
+						Name:           "fast",
+						ShareProtocol:  ptr.To(api.ShareProtocolNFS),
+						ShareNetworkID: ptr.To("share-network"),
+					},
+					{
+						Name:           "cephfs",
+						Backend:        ptr.To(api.StorageBackendManila),
+						ShareProtocol:  ptr.To(api.ShareProtocolCephFS),
+						ShareNetworkID: ptr.To(""),
+					},
+					{
+						Name:          "glusterfs",
+						Backend:       ptr.To(api.StorageBackendManila),
+						ShareProtocol: ptr.To("GLUSTERFS"),
+					},
+					{
+						Name:           "shares",
+						Backend:        ptr.To(api.StorageBackendManila),
+						ShareNetworkID: ptr.To(""),
+					},
+				}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[0].shareProtocol"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[0].shareNetworkID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[1].shareProtocol"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.storageClasses[1].shareNetworkID"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("storage.storageClasses[2].shareProtocol"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("storage.storageClasses[3].shareNetworkID"),
+					})),
+				))
+			})
+
+			It("should fail for an invalid CephFS configuration", func() {
+				controlPlane.Storage.CSIManila = &api.CSIManila{CephFS: &api.CSIManilaCephFS{Mounter: ptr.To("nfs")}}
+
+				Expect(ValidateControlPlaneConfig(controlPlane, infraConfig, "", nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("storage.csiManila.cephFS"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("storage.csiManila.cephFS.mounter"),
+					})),
+				))
+			})
+		})
+	})
+
+	Describe("#ValidateStorageClassesAgainstCloudProfile", func() {
+		var (
+			cloudProfileSpec   *gardencorev1beta1.CloudProfileSpec
+			cloudProfileConfig *api.CloudProfileConfig
+		)
+
+		BeforeEach(func() {
+			cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+				Regions: []gardencorev1beta1.Region{
+					{Name: "eu-1", Zones: []gardencorev1beta1.AvailabilityZone{{Name: "eu-1a"}, {Name: "eu-1b"}}},
+					{Name: "eu-2", Zones: []gardencorev1beta1.AvailabilityZone{{Name: "eu-2a"}}},
+				},
+				VolumeTypes: []gardencorev1beta1.VolumeType{
+					{Name: "standard", Usable: ptr.To(true)},
+					{Name: "ssd"},
+					{Name: "legacy", Usable: ptr.To(false)},
+				},
+			}
+			cloudProfileConfig = &api.CloudProfileConfig{
+				VolumeTypes: []api.VolumeType{
+					{Name: "ssd", Encrypted: ptr.To(true)},
+					{Name: "standard", Encrypted: ptr.To(false)},
+				},
+			}
+			controlPlane.Storage = &api.Storage{
+				StorageClasses: []api.StorageClass{
+					{Name: "fast", VolumeType: ptr.To("ssd"), AvailabilityZone: ptr.To("eu-1a")},
+					{Name: "shares", Backend: ptr.To(api.StorageBackendManila), VolumeType: ptr.To("cephfs")},
+				},
+			}
+		})
+
+		It("should succeed for volume types and zones of the CloudProfile", func() {
+			Expect(ValidateStorageClassesAgainstCloudProfile(nil, controlPlane, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(BeEmpty())
+		})
+
+		It("should fail for unknown or unusable volume types and zones of other regions", func() {
+			controlPlane.Storage.StorageClasses = append(controlPlane.Storage.StorageClasses,
+				api.StorageClass{Name: "old", VolumeType: ptr.To("legacy")},
+				api.StorageClass{Name: "typo", VolumeType: ptr.To("sdd"), AvailabilityZone: ptr.To("eu-2a")},
+			)
+
+			Expect(ValidateStorageClassesAgainstCloudProfile(nil, controlPlane, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storageClasses[2].volumeType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storageClasses[3].volumeType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storageClasses[3].availabilityZone"),
+				})),
+			))
+		})
+
+		It("should succeed for encrypted storage classes with volume types which are encrypted according to the CloudProfile", func() {
+			controlPlane.Storage.StorageClasses[0].Encrypted = ptr.To(true)
+
+			Expect(ValidateStorageClassesAgainstCloudProfile(nil, controlPlane, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(BeEmpty())
+		})
+
+		It("should fail for encrypted storage classes with volume types which are not encrypted according to the CloudProfile", func() {
+			controlPlane.Storage.StorageClasses = append(controlPlane.Storage.StorageClasses,
+				api.StorageClass{Name: "plain", VolumeType: ptr.To("standard"), Encrypted: ptr.To(true)},
+			)
+
+			Expect(ValidateStorageClassesAgainstCloudProfile(nil, controlPlane, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storageClasses[2].volumeType"),
+				})),
+			))
+			Expect(ValidateStorageClassesAgainstCloudProfile(nil, controlPlane, "eu-1", cloudProfileSpec, nil, nilPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storageClasses[2].volumeType"),
+				})),
+			))
+		})
+
+		It("should validate the encryption if a storage class was changed to require it", func() {
+			oldControlPlane := controlPlane.DeepCopy()
+			controlPlane.Storage.StorageClasses[0].VolumeType = ptr.To("standard")
+			oldControlPlane.Storage.StorageClasses[0].VolumeType = ptr.To("standard")
+			controlPlane.Storage.StorageClasses[0].Encrypted = ptr.To(true)
+
+			Expect(ValidateStorageClassesAgainstCloudProfile(oldControlPlane, controlPlane, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storageClasses[0].volumeType"),
+				})),
+			))
+		})
+
+		It("should not validate anything if the storage classes were not changed", func() {
+			controlPlane.Storage.StorageClasses[0].VolumeType = ptr.To("legacy")
+
+			Expect(ValidateStorageClassesAgainstCloudProfile(controlPlane, controlPlane, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(BeEmpty())
+		})
 	})
 
 	Describe("#ValidateControlPlaneConfigUpdate", func() {
 		It("should return no errors for an unchanged config", func() {
 			Expect(ValidateControlPlaneConfigUpdate(controlPlane, controlPlane, nilPath)).To(BeEmpty())
 		})
+
+		It("should allow enabling kms", func() {
+			newControlPlane := controlPlane.DeepCopy()
+			newControlPlane.KMS = &api.KMS{KeyID: "123e4567-e89b-12d3-a456-426614174000"}
+			Expect(ValidateControlPlaneConfigUpdate(controlPlane, newControlPlane, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid changing the kms key", func() {
+			controlPlane.KMS = &api.KMS{KeyID: "123e4567-e89b-12d3-a456-426614174000"}
+			newControlPlane := controlPlane.DeepCopy()
+			newControlPlane.KMS.KeyID = "123e4567-e89b-12d3-a456-426614174001"
+			Expect(ValidateControlPlaneConfigUpdate(controlPlane, newControlPlane, nilPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("kms"),
+				})),
+			))
+		})
+
+		It("should forbid removing kms", func() {
+			controlPlane.KMS = &api.KMS{KeyID: "123e4567-e89b-12d3-a456-426614174000"}
+			newControlPlane := controlPlane.DeepCopy()
+			newControlPlane.KMS = nil
+			Expect(ValidateControlPlaneConfigUpdate(controlPlane, newControlPlane, nilPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("kms"),
+				})),
+			))
+		})
 	})
 
 	Describe("#ValidateControlPlaneConfigAgainstCloudProfile", func() {
@@ -5,13 +5,19 @@
 package validation
 
 import (
+	"fmt"
+	"net"
+	"net/netip"
 	"reflect"
 	"sort"
+	"strconv"
+	"strings"
 
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/utils"
@@ -68,16 +74,23 @@ func ValidateInfrastructureConfig(infra *api.InfrastructureConfig, nodesCIDR *st
 	}
 	if infra.Networks.Router != nil {
 		if infra.Networks.Router.ID == "" {
-			allErrs = append(allErrs, field.Invalid(networksPath.Child("router", "id"), infra.Networks.Router.ID, "router id must not be empty when router key is provided"))
+			if len(infra.Networks.Router.Routes) == 0 {
+				allErrs = append(allErrs, field.Invalid(networksPath.Child("router", "id"), infra.Networks.Router.ID, "router id must not be empty when router key is provided without routes"))
+			}
 		} else {
 			allErrs = append(allErrs, uuid(infra.Networks.Router.ID, networksPath.Child("router").Child("id"))...)
 		}
+		var nodeSubnets []string
+		if infra.Networks.IPv6 != nil {
+			nodeSubnets = append(nodeSubnets, infra.Networks.IPv6.NodeCIDR)
+		}
+		allErrs = append(allErrs, validateRoutes(infra.Networks.Router.Routes, false, append(nodeSubnets, infra.WorkersCIDR()), networksPath.Child("router", "routes"))...)
 	}
 
 	if infra.FloatingPoolSubnetName != nil {
 		allErrs = append(allErrs, validateResourceName(*infra.FloatingPoolSubnetName, fldPath.Child("floatingPoolSubnetName"))...)
 
-		if infra.Networks.Router != nil {
+		if infra.Networks.Router != nil && infra.Networks.Router.ID != "" {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("router"), "router cannot be set when a floating subnet name is provided"))
 		}
 	}
@@ -86,6 +99,22 @@ func ValidateInfrastructureConfig(infra *api.InfrastructureConfig, nodesCIDR *st
 		allErrs = append(allErrs, validateIPv6Config(infra.Networks.IPv6, networksPath.Child("ipv6"))...)
 	}
 
+	allErrs = append(allErrs, validateDNSServers(infra.Networks.DNSServers, networksPath.Child("dnsServers"))...)
+	allErrs = append(allErrs, validateRoutes(infra.Networks.HostRoutes, true, []string{infra.WorkersCIDR()}, networksPath.Child("hostRoutes"))...)
+	allErrs = append(allErrs, validateAllocationPools(infra.Networks.AllocationPools, infra.WorkersCIDR(), networksPath.Child("allocationPools"))...)
+	allErrs = append(allErrs, validateAvailabilityZoneHints(infra.Networks.AvailabilityZoneHints, networksPath.Child("availabilityZoneHints"))...)
+
+	if infra.Networks.QoSPolicy != nil {
+		if infra.Networks.ID != nil {
+			allErrs = append(allErrs, field.Forbidden(networksPath.Child("qosPolicy"), "qos policy can only be attached to networks created for the shoot"))
+		}
+		allErrs = append(allErrs, validateQoSPolicy(infra.Networks.QoSPolicy, networksPath.Child("qosPolicy"))...)
+	}
+
+	if infra.Networks.Firewall != nil {
+		allErrs = append(allErrs, validateFirewall(infra.Networks.Firewall, networksPath.Child("firewall"))...)
+	}
+
 	return allErrs
 }
 
@@ -101,6 +130,286 @@ func validateSubnetPool(pool *api.SubnetPool, fldPath *field.Path) field.ErrorLi
 	return allErrs
 }
 
+// validateRoutes validates the given static routes. Their next hops must be within the node subnet of their IP family,
+// as they are not reachable otherwise. Subnets which are allocated from subnet pools are unknown and not checked.
+func validateRoutes(routes []api.Route, ipv4Only bool, nodeSubnets []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	destinations := sets.New[string]()
+
+	var nodeNets []*net.IPNet
+	for _, nodeSubnet := range nodeSubnets {
+		if _, nodeNet, err := net.ParseCIDR(nodeSubnet); err == nil {
+			nodeNets = append(nodeNets, nodeNet)
+		}
+	}
+
+	for i, route := range routes {
+		idxPath := fldPath.Index(i)
+
+		destination := cidrvalidation.NewCIDR(route.Destination, idxPath.Child("destination"))
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(destination)...)
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(idxPath.Child("destination"), route.Destination)...)
+		if destinations.Has(route.Destination) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("destination"), route.Destination))
+		}
+		destinations.Insert(route.Destination)
+
+		nextHop := net.ParseIP(route.NextHop)
+		if nextHop == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("nextHop"), route.NextHop, "must be a valid IP address"))
+			continue
+		}
+		if ipv4Only && nextHop.To4() == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("nextHop"), route.NextHop, "must be an IPv4 address"))
+			continue
+		}
+		if _, destinationNet, err := net.ParseCIDR(route.Destination); err == nil && (destinationNet.IP.To4() == nil) != (nextHop.To4() == nil) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("nextHop"), route.NextHop, "must have the same IP family as the destination"))
+			continue
+		}
+		for _, nodeNet := range nodeNets {
+			if (nodeNet.IP.To4() == nil) == (nextHop.To4() == nil) && !nodeNet.Contains(nextHop) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("nextHop"), route.NextHop, fmt.Sprintf("must be within the node subnet %s", nodeNet)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateDNSServers(dnsServers []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.New[string]()
+
+	for i, dnsServer := range dnsServers {
+		if net.ParseIP(dnsServer) == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), dnsServer, "must be a valid IP address"))
+		}
+		if seen.Has(dnsServer) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), dnsServer))
+		}
+		seen.Insert(dnsServer)
+	}
+
+	return allErrs
+}
+
+var (
+	qosDirections = sets.New("egress", "ingress")
+	// validDSCPMarks are the DSCP values accepted by Neutron.
+	validDSCPMarks = sets.New(0, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 46, 48, 56)
+)
+
+func validateQoSPolicy(qos *api.QoSPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	hasRules := len(qos.BandwidthLimitRules) > 0 || len(qos.MinimumBandwidthRules) > 0 || len(qos.DSCPMarkingRules) > 0
+	if qos.ID != nil {
+		allErrs = append(allErrs, uuid(*qos.ID, fldPath.Child("id"))...)
+		if hasRules {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "rules must not be specified together with the id of an existing qos policy"))
+		}
+	} else if !hasRules {
+		allErrs = append(allErrs, field.Required(fldPath, "either the id of an existing qos policy or at least one rule must be specified"))
+	}
+
+	directions := sets.New[string]()
+	for i, rule := range qos.BandwidthLimitRules {
+		idxPath := fldPath.Child("bandwidthLimitRules").Index(i)
+		if rule.MaxKbps <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxKbps"), rule.MaxKbps, "must be greater than 0"))
+		}
+		if rule.MaxBurstKbps != nil && *rule.MaxBurstKbps < 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxBurstKbps"), *rule.MaxBurstKbps, "must not be negative"))
+		}
+		allErrs = append(allErrs, validateQoSDirection(rule.Direction, directions, idxPath.Child("direction"))...)
+	}
+
+	directions = sets.New[string]()
+	for i, rule := range qos.MinimumBandwidthRules {
+		idxPath := fldPath.Child("minimumBandwidthRules").Index(i)
+		if rule.MinKbps <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("minKbps"), rule.MinKbps, "must be greater than 0"))
+		}
+		allErrs = append(allErrs, validateQoSDirection(rule.Direction, directions, idxPath.Child("direction"))...)
+	}
+
+	if len(qos.DSCPMarkingRules) > 1 {
+		allErrs = append(allErrs, field.TooMany(fldPath.Child("dscpMarkingRules"), len(qos.DSCPMarkingRules), 1))
+	}
+	for i, rule := range qos.DSCPMarkingRules {
+		if !validDSCPMarks.Has(rule.DSCPMark) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("dscpMarkingRules").Index(i).Child("dscpMark"), rule.DSCPMark, fmt.Sprintf("must be one of %v", sets.List(validDSCPMarks))))
+		}
+	}
+
+	return allErrs
+}
+
+// validateQoSDirection validates the direction of a QoS rule. Neutron allows only one rule of a type per direction.
+func validateQoSDirection(direction *string, seen sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	value := ptr.Deref(direction, "egress")
+	if !qosDirections.Has(value) {
+		return append(allErrs, field.NotSupported(fldPath, value, sets.List(qosDirections)))
+	}
+	if seen.Has(value) {
+		allErrs = append(allErrs, field.Duplicate(fldPath, value))
+	}
+	seen.Insert(value)
+	return allErrs
+}
+
+var (
+	firewallActions   = sets.New("allow", "deny", "reject")
+	firewallProtocols = sets.New("tcp", "udp", "icmp", "any")
+)
+
+func validateFirewall(firewall *api.Firewall, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(firewall.EgressRules) == 0 && len(firewall.IngressRules) == 0 {
+		return append(allErrs, field.Required(fldPath, "at least one egress or ingress rule must be specified"))
+	}
+	for i, rule := range firewall.EgressRules {
+		allErrs = append(allErrs, validateFirewallRule(rule, fldPath.Child("egressRules").Index(i))...)
+	}
+	for i, rule := range firewall.IngressRules {
+		allErrs = append(allErrs, validateFirewallRule(rule, fldPath.Child("ingressRules").Index(i))...)
+	}
+	return allErrs
+}
+
+func validateFirewallRule(rule api.FirewallRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !firewallActions.Has(rule.Action) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), rule.Action, sets.List(firewallActions)))
+	}
+	protocol := ptr.Deref(rule.Protocol, "any")
+	if !firewallProtocols.Has(protocol) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), protocol, sets.List(firewallProtocols)))
+	}
+	ipVersion := ptr.Deref(rule.IPVersion, 4)
+	if ipVersion != 4 && ipVersion != 6 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipVersion"), ipVersion, "must be either 4 or 6"))
+	}
+
+	for name, cidr := range map[string]*string{"sourceCIDR": rule.SourceCIDR, "destinationCIDR": rule.DestinationCIDR} {
+		if cidr == nil {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(*cidr)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *cidr, "must be a valid CIDR"))
+			continue
+		}
+		if (ipVersion == 4) != prefix.Addr().Is4() {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *cidr, fmt.Sprintf("must be an IPv%d CIDR", ipVersion)))
+		}
+	}
+
+	for name, port := range map[string]*string{"sourcePort": rule.SourcePort, "destinationPort": rule.DestinationPort} {
+		if port == nil {
+			continue
+		}
+		if protocol != "tcp" && protocol != "udp" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(name), "ports can only be specified for tcp and udp"))
+			continue
+		}
+		if !isValidPortRange(*port) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *port, "must be a port or a port range like 8000:8080"))
+		}
+	}
+
+	return allErrs
+}
+
+func isValidPortRange(portRange string) bool {
+	first, last, isRange := strings.Cut(portRange, ":")
+	if !isRange {
+		last = first
+	}
+	from, err := strconv.Atoi(first)
+	if err != nil {
+		return false
+	}
+	to, err := strconv.Atoi(last)
+	if err != nil {
+		return false
+	}
+	return from >= 1 && to <= 65535 && from <= to
+}
+
+func validateAvailabilityZoneHints(hints []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.New[string]()
+
+	for i, hint := range hints {
+		if hint == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), "availability zone hint must not be empty"))
+			continue
+		}
+		if seen.Has(hint) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), hint))
+		}
+		seen.Insert(hint)
+	}
+
+	return allErrs
+}
+
+func validateAllocationPools(pools []api.AllocationPool, workersCIDR string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(pools) == 0 {
+		return allErrs
+	}
+
+	workers, err := netip.ParsePrefix(workersCIDR)
+	if err != nil {
+		return append(allErrs, field.Forbidden(fldPath, "allocation pools can only be used with a valid workers CIDR"))
+	}
+
+	// Neutron uses the first address of the subnet as gateway, which must not be allocated to ports.
+	gateway := workers.Masked().Addr().Next()
+
+	type addrRange struct{ start, end netip.Addr }
+	var ranges []addrRange
+	for i, pool := range pools {
+		idxPath := fldPath.Index(i)
+		start, startErr := netip.ParseAddr(pool.Start)
+		end, endErr := netip.ParseAddr(pool.End)
+		if startErr != nil || !start.Is4() {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("start"), pool.Start, "must be a valid IPv4 address"))
+		} else if !workers.Contains(start) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("start"), pool.Start, "must be within the workers CIDR"))
+		}
+		if endErr != nil || !end.Is4() {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), pool.End, "must be a valid IPv4 address"))
+		} else if !workers.Contains(end) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), pool.End, "must be within the workers CIDR"))
+		}
+		if startErr != nil || endErr != nil {
+			continue
+		}
+		if end.Less(start) {
+			allErrs = append(allErrs, field.Invalid(idxPath, pool, "start must not be greater than end"))
+			continue
+		}
+		if !gateway.Less(start) && !end.Less(gateway) {
+			allErrs = append(allErrs, field.Invalid(idxPath, pool, fmt.Sprintf("must not contain the gateway IP %s of the subnet", gateway)))
+		}
+		for _, r := range ranges {
+			if !end.Less(r.start) && !r.end.Less(start) {
+				allErrs = append(allErrs, field.Invalid(idxPath, pool, "must not overlap with other allocation pools"))
+				break
+			}
+		}
+		ranges = append(ranges, addrRange{start: start, end: end})
+	}
+
+	return allErrs
+}
+
 func validateIPv6Config(ipv6 *api.IPv6Config, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -153,7 +462,7 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *api.Infrastructure
 	networksPath := fldPath.Child("networks")
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.ID, oldConfig.Networks.ID, networksPath.Child("id"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Router, oldConfig.Networks.Router, networksPath.Child("router"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(existingRouterID(newConfig.Networks.Router), existingRouterID(oldConfig.Networks.Router), networksPath.Child("router"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Worker, oldConfig.Networks.Worker, networksPath.Child("worker"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.Workers, oldConfig.Networks.Workers, networksPath.Child("workers"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.Networks.IPv6, oldConfig.Networks.IPv6, networksPath.Child("ipv6"))...)
@@ -168,6 +477,15 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *api.Infrastructure
 	return allErrs
 }
 
+// existingRouterID returns the ID of the existing router referenced by the given router configuration.
+// The static routes are not considered as they can be changed at any time.
+func existingRouterID(router *api.Router) string {
+	if router == nil {
+		return ""
+	}
+	return router.ID
+}
+
 // ValidateInfrastructureConfigAgainstCloudProfile validates the given InfrastructureConfig against constraints in the given CloudProfile.
 func ValidateInfrastructureConfigAgainstCloudProfile(oldInfra, infra *api.InfrastructureConfig, domain, shootRegion string, cloudProfileConfig *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
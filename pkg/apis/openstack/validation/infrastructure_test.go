@@ -89,6 +89,355 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))
 		})
 
+		It("should allow routes without a router id", func() {
+			infrastructureConfig.Networks.Router = &api.Router{
+				Routes: []api.Route{
+					{Destination: "192.168.0.0/16", NextHop: "10.250.0.10"},
+					{Destination: "fd00::/64", NextHop: "fd01::10"},
+				},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid routes", func() {
+			infrastructureConfig.Networks.Router.Routes = []api.Route{
+				{Destination: "192.168.0.1/16", NextHop: "10.250.0.10"},
+				{Destination: "10.0.0.0/8", NextHop: "foo"},
+				{Destination: "10.0.0.0/8", NextHop: "fd01::10"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.router.routes[0].destination"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.router.routes[1].nextHop"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.router.routes[2].destination"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.router.routes[2].nextHop"),
+					"Detail": Equal("must have the same IP family as the destination"),
+				})),
+			))
+		})
+
+		It("should forbid routes whose next hop is not within the node subnet", func() {
+			infrastructureConfig.Networks.IPv6 = &api.IPv6Config{NodeCIDR: "fd01::/64", PodCIDR: "fd04::/56", ServiceCIDR: "fd05::/112"}
+			infrastructureConfig.Networks.Router.Routes = []api.Route{
+				{Destination: "192.168.0.0/16", NextHop: "10.250.0.10"},
+				{Destination: "172.16.0.0/12", NextHop: "10.251.0.10"},
+				{Destination: "fd00::/64", NextHop: "fd01::10"},
+				{Destination: "fd02::/64", NextHop: "fd03::10"},
+			}
+			infrastructureConfig.Networks.HostRoutes = []api.Route{{Destination: "192.168.0.0/16", NextHop: "10.251.0.10"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.router.routes[1].nextHop"),
+					"Detail": Equal("must be within the node subnet 10.250.0.0/16"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.router.routes[3].nextHop"),
+					"Detail": Equal("must be within the node subnet fd01::/64"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.hostRoutes[0].nextHop"),
+					"Detail": Equal("must be within the node subnet 10.250.0.0/16"),
+				})),
+			))
+		})
+
+		It("should not check the next hop of routes if the node subnet is allocated from a subnet pool", func() {
+			infrastructureConfig.Networks.Workers = ""
+			infrastructureConfig.Networks.SubnetPool = &api.SubnetPool{ID: "pool-id", PrefixLength: 24}
+			infrastructureConfig.Networks.Router.Routes = []api.Route{{Destination: "192.168.0.0/16", NextHop: "10.251.0.10"}}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, nil, nilPath)).To(BeEmpty())
+		})
+
+		It("should allow floating ip subnet when only routes are specified", func() {
+			infrastructureConfig.FloatingPoolSubnetName = ptr.To("sample-floating-pool-subnet-id")
+			infrastructureConfig.Networks.Router = &api.Router{
+				Routes: []api.Route{{Destination: "192.168.0.0/16", NextHop: "10.250.0.10"}},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should allow valid subnet options", func() {
+			infrastructureConfig.Networks.DNSServers = []string{"10.0.0.53", "2001:db8::53"}
+			infrastructureConfig.Networks.HostRoutes = []api.Route{{Destination: "192.168.0.0/16", NextHop: "10.250.0.10"}}
+			infrastructureConfig.Networks.AllocationPools = []api.AllocationPool{
+				{Start: "10.250.0.100", End: "10.250.0.200"},
+				{Start: "10.250.1.0", End: "10.250.255.254"},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid dns servers and host routes", func() {
+			infrastructureConfig.Networks.DNSServers = []string{"10.0.0.53", "foo", "10.0.0.53"}
+			infrastructureConfig.Networks.HostRoutes = []api.Route{{Destination: "fd00::/64", NextHop: "fd01::10"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.dnsServers[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.dnsServers[2]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.hostRoutes[0].nextHop"),
+					"Detail": Equal("must be an IPv4 address"),
+				})),
+			))
+		})
+
+		It("should forbid invalid allocation pools", func() {
+			infrastructureConfig.Networks.AllocationPools = []api.AllocationPool{
+				{Start: "10.250.0.100", End: "10.250.0.200"},
+				{Start: "10.250.0.150", End: "10.250.0.160"},
+				{Start: "10.250.0.20", End: "10.250.0.10"},
+				{Start: "10.251.0.1", End: "foo"},
+				{Start: "10.250.0.1", End: "10.250.0.5"},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.allocationPools[1]"),
+					"Detail": Equal("must not overlap with other allocation pools"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.allocationPools[2]"),
+					"Detail": Equal("start must not be greater than end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.allocationPools[3].start"),
+					"Detail": Equal("must be within the workers CIDR"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.allocationPools[3].end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.allocationPools[4]"),
+					"Detail": Equal("must not contain the gateway IP 10.250.0.1 of the subnet"),
+				})),
+			))
+		})
+
+		It("should forbid empty and duplicate availability zone hints", func() {
+			infrastructureConfig.Networks.AvailabilityZoneHints = []string{"zone-a", "", "zone-a"}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.availabilityZoneHints[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.availabilityZoneHints[2]"),
+				})),
+			))
+		})
+
+		It("should allow a QoS policy with rules", func() {
+			infrastructureConfig.Networks.QoSPolicy = &api.QoSPolicy{
+				BandwidthLimitRules: []api.BandwidthLimitRule{
+					{MaxKbps: 100000, MaxBurstKbps: ptr.To(80000)},
+					{MaxKbps: 100000, Direction: ptr.To("ingress")},
+				},
+				MinimumBandwidthRules: []api.MinimumBandwidthRule{{MinKbps: 10000}},
+				DSCPMarkingRules:      []api.DSCPMarkingRule{{DSCPMark: 26}},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should allow referencing an existing QoS policy", func() {
+			infrastructureConfig.Networks.QoSPolicy = &api.QoSPolicy{ID: ptr.To("6f1b6c6e-7b8c-4a5e-9f2a-3c1d2e4f5a6b")}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid an empty QoS policy", func() {
+			infrastructureConfig.Networks.QoSPolicy = &api.QoSPolicy{}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.qosPolicy"),
+			}))))
+		})
+
+		It("should forbid a QoS policy for an existing network", func() {
+			infrastructureConfig.Networks.ID = ptr.To("0e4f5a6b-7b8c-4a5e-9f2a-3c1d2e4f5a6b")
+			infrastructureConfig.Networks.QoSPolicy = &api.QoSPolicy{ID: ptr.To("6f1b6c6e-7b8c-4a5e-9f2a-3c1d2e4f5a6b")}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.qosPolicy"),
+			}))))
+		})
+
+		It("should forbid invalid QoS policy rules", func() {
+			infrastructureConfig.Networks.QoSPolicy = &api.QoSPolicy{
+				ID: ptr.To("6f1b6c6e-7b8c-4a5e-9f2a-3c1d2e4f5a6b"),
+				BandwidthLimitRules: []api.BandwidthLimitRule{
+					{MaxKbps: 0, MaxBurstKbps: ptr.To(-1)},
+					{MaxKbps: 1000, Direction: ptr.To("egress")},
+				},
+				MinimumBandwidthRules: []api.MinimumBandwidthRule{{MinKbps: 1000, Direction: ptr.To("both")}},
+				DSCPMarkingRules:      []api.DSCPMarkingRule{{DSCPMark: 27}, {DSCPMark: 26}},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.qosPolicy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.qosPolicy.bandwidthLimitRules[0].maxKbps"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.qosPolicy.bandwidthLimitRules[0].maxBurstKbps"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.qosPolicy.bandwidthLimitRules[1].direction"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.qosPolicy.minimumBandwidthRules[0].direction"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeTooMany),
+					"Field": Equal("networks.qosPolicy.dscpMarkingRules"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.qosPolicy.dscpMarkingRules[0].dscpMark"),
+				})),
+			))
+		})
+
+		It("should allow a firewall with rules", func() {
+			infrastructureConfig.Networks.Firewall = &api.Firewall{
+				EgressRules: []api.FirewallRule{
+					{Action: "allow", Protocol: ptr.To("tcp"), DestinationCIDR: ptr.To("192.168.0.0/16"), DestinationPort: ptr.To("443")},
+					{Action: "deny"},
+				},
+				IngressRules: []api.FirewallRule{
+					{Action: "reject", Protocol: ptr.To("udp"), IPVersion: ptr.To(6), SourceCIDR: ptr.To("2001:db8::/32"), SourcePort: ptr.To("1000:2000")},
+				},
+			}
+
+			Expect(ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)).To(BeEmpty())
+		})
+
+		It("should forbid a firewall without rules", func() {
+			infrastructureConfig.Networks.Firewall = &api.Firewall{}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("networks.firewall"),
+			}))))
+		})
+
+		It("should forbid invalid firewall rules", func() {
+			infrastructureConfig.Networks.Firewall = &api.Firewall{
+				EgressRules: []api.FirewallRule{
+					{Action: "drop", Protocol: ptr.To("sctp"), IPVersion: ptr.To(5)},
+					{Action: "allow", Protocol: ptr.To("icmp"), DestinationCIDR: ptr.To("2001:db8::/32"), DestinationPort: ptr.To("80")},
+				},
+				IngressRules: []api.FirewallRule{
+					{Action: "allow", Protocol: ptr.To("tcp"), SourceCIDR: ptr.To("10.0.0.0/33"), SourcePort: ptr.To("2000:1000")},
+				},
+			}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.firewall.egressRules[0].action"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.firewall.egressRules[0].protocol"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.firewall.egressRules[0].ipVersion"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.firewall.egressRules[1].destinationCIDR"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.firewall.egressRules[1].destinationPort"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.firewall.ingressRules[0].sourceCIDR"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.firewall.ingressRules[0].sourcePort"),
+				})),
+			))
+		})
+
+		It("should forbid allocation pools together with a subnet pool", func() {
+			infrastructureConfig.Networks.Workers = ""
+			infrastructureConfig.Networks.SubnetPool = &api.SubnetPool{ID: "pool-id", PrefixLength: 24}
+			infrastructureConfig.Networks.AllocationPools = []api.AllocationPool{{Start: "10.250.0.100", End: "10.250.0.200"}}
+
+			errorList := ValidateInfrastructureConfig(infrastructureConfig, &nodes, nilPath)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("networks.allocationPools"),
+			}))))
+		})
+
 		It("should forbid floating ip subnet when router is specified", func() {
 			infrastructureConfig.FloatingPoolSubnetName = ptr.To("sample-floating-pool-subnet-id")
 
@@ -394,6 +743,23 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))))
 		})
 
+		It("should allow changing the router routes", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Router.Routes = []api.Route{{Destination: "192.168.0.0/16", NextHop: "10.250.0.10"}}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, nilPath)).To(BeEmpty())
+		})
+
+		It("should allow adding routes to a new router", func() {
+			infrastructureConfig.Networks.Router = nil
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Router = &api.Router{
+				Routes: []api.Route{{Destination: "192.168.0.0/16", NextHop: "10.250.0.10"}},
+			}
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig, nilPath)).To(BeEmpty())
+		})
+
 		It("should forbid changing networks.id", func() {
 			id := "new-network-id"
 			newInfrastructureConfig := infrastructureConfig.DeepCopy()
@@ -6,13 +6,14 @@ package validation
 
 import (
 	"fmt"
+	"maps"
 	"math"
 
 	corehelper "github.com/gardener/gardener/pkg/api/core/helper"
+	gardencorev1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/apis/core"
-	validationutils "github.com/gardener/gardener/pkg/utils/validation"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
-	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
@@ -51,6 +52,10 @@ func ValidateWorkers(workers []core.Worker, cloudProfileCfg *api.CloudProfileCon
 			continue
 		}
 
+		if len(worker.DataVolumes) > 0 {
+			allErrs = append(allErrs, field.Forbidden(workerFldPath.Child("dataVolumes"), "data volumes are not supported by the machine-controller-manager provider for OpenStack"))
+		}
+
 		if worker.Volume != nil && worker.Volume.Type != nil && worker.Volume.VolumeSize == "" {
 			allErrs = append(allErrs, field.Forbidden(workerFldPath.Child("volume", "type"), "specifying volume type without a custom volume size is not allowed"))
 		}
@@ -69,16 +74,51 @@ func ValidateWorkers(workers []core.Worker, cloudProfileCfg *api.CloudProfileCon
 	return allErrs
 }
 
+// ValidateWorkersBootCapabilities validates that the machines of the workers can boot, i.e. that the machine images
+// support the secure boot, TPM and confidential computing capabilities required by the machine types.
+func ValidateWorkersBootCapabilities(workers []core.Worker, region string, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, cloudProfileCfg *api.CloudProfileConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	capabilityDefinitions := helper.NormalizeCapabilityDefinitions(cloudProfileSpec.MachineCapabilities)
+	for i, worker := range workers {
+		machinePath := fldPath.Index(i).Child("machine")
+
+		machineType := gardencorev1beta1helper.FindMachineTypeByName(cloudProfileSpec.MachineTypes, worker.Machine.Type)
+		if machineType == nil {
+			continue
+		}
+		requiredCapabilities := helper.RequiredBootCapabilities(machineType.Capabilities)
+		if len(requiredCapabilities) == 0 {
+			continue
+		}
+
+		if helper.IsBareMetalMachineType(cloudProfileCfg.MachineTypes, worker.Machine.Type) {
+			if _, ok := requiredCapabilities[api.CapabilityTPM]; ok {
+				allErrs = append(allErrs, field.Forbidden(machinePath.Child("type"), fmt.Sprintf("bare metal machine type %q cannot provide a virtual TPM", worker.Machine.Type)))
+			}
+			if _, ok := requiredCapabilities[api.CapabilityConfidentialComputing]; ok {
+				allErrs = append(allErrs, field.Forbidden(machinePath.Child("type"), fmt.Sprintf("bare metal machine type %q cannot provide confidential computing", worker.Machine.Type)))
+			}
+		}
+
+		if worker.Machine.Image == nil || worker.Machine.Image.Version == "" {
+			continue
+		}
+		machineTypeCapabilities := helper.NormalizeMachineTypeCapabilities(maps.Clone(machineType.Capabilities), worker.Machine.Architecture, capabilityDefinitions)
+		if _, err := helper.FindImageInCloudProfile(cloudProfileCfg, worker.Machine.Image.Name, worker.Machine.Image.Version, region, machineTypeCapabilities, capabilityDefinitions); err != nil {
+			allErrs = append(allErrs, field.Forbidden(machinePath.Child("image"), fmt.Sprintf("machine image %s@%s does not support the boot capabilities %v required by machine type %q", worker.Machine.Image.Name, worker.Machine.Image.Version, requiredCapabilities, worker.Machine.Type)))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateWorkersUpdate validates updates on Workers.
 func ValidateWorkersUpdate(oldWorkers, newWorkers []core.Worker, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	for i, newWorker := range newWorkers {
 		for _, oldWorker := range oldWorkers {
 			if newWorker.Name == oldWorker.Name {
-				if validationutils.ShouldEnforceImmutability(newWorker.Zones, oldWorker.Zones) {
-					allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.Zones, oldWorker.Zones, fldPath.Index(i).Child("zones"))...)
-				}
-
 				if corehelper.IsUpdateStrategyInPlace(newWorker.UpdateStrategy) {
 					if !apiequality.Semantic.DeepEqual(newWorker.ProviderConfig, oldWorker.ProviderConfig) {
 						allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("providerConfig"), newWorker.ProviderConfig, "providerConfig is immutable when update strategy is in-place"))
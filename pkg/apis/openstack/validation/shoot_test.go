@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -118,6 +119,19 @@ var _ = Describe("Shoot validation", func() {
 				))
 			})
 
+			It("should forbid data volumes", func() {
+				workers[0].DataVolumes = []core.DataVolume{{Name: "data", VolumeSize: "50Gi"}}
+
+				errorList := ValidateWorkers(workers, nil, nilPath)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("[0].dataVolumes"),
+					})),
+				))
+			})
+
 			Context("#ValidateServerGroups", func() {
 				var cloudProfileConfig *openstack.CloudProfileConfig
 
@@ -367,6 +381,77 @@ var _ = Describe("Shoot validation", func() {
 			})
 		})
 
+		Describe("#ValidateWorkersBootCapabilities", func() {
+			var (
+				cloudProfileSpec   *gardencorev1beta1.CloudProfileSpec
+				cloudProfileConfig *openstack.CloudProfileConfig
+			)
+
+			BeforeEach(func() {
+				cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+					MachineCapabilities: []gardencorev1beta1.CapabilityDefinition{
+						{Name: "architecture", Values: []string{"amd64"}},
+						{Name: "secureBoot", Values: []string{"disabled", "enabled"}},
+						{Name: "confidentialComputing", Values: []string{"none", "sev", "tdx"}},
+					},
+					MachineTypes: []gardencorev1beta1.MachineType{
+						{Name: "m1.large"},
+						{Name: "m1.secure", Capabilities: gardencorev1beta1.Capabilities{"secureBoot": {"enabled"}}},
+						{Name: "m1.sev", Capabilities: gardencorev1beta1.Capabilities{"secureBoot": {"enabled"}, "confidentialComputing": {"sev"}}},
+						{Name: "bm.tdx", Capabilities: gardencorev1beta1.Capabilities{"confidentialComputing": {"tdx"}}},
+					},
+				}
+				cloudProfileConfig = &openstack.CloudProfileConfig{
+					MachineImages: []openstack.MachineImages{{
+						Name: "gardenlinux",
+						Versions: []openstack.MachineImageVersion{{
+							Version: "1.0.0",
+							CapabilityFlavors: []openstack.MachineImageFlavor{
+								{Image: "gardenlinux-bios", Capabilities: gardencorev1beta1.Capabilities{"secureBoot": {"disabled"}, "confidentialComputing": {"none"}}},
+								{Image: "gardenlinux-uefi", Capabilities: gardencorev1beta1.Capabilities{"confidentialComputing": {"none"}}},
+							},
+						}},
+					}},
+					MachineTypes: []openstack.MachineType{{Name: "bm.tdx", BareMetal: ptr.To(true)}},
+				}
+				for i := range workers {
+					workers[i].Machine = core.Machine{
+						Type:  "m1.large",
+						Image: &core.ShootMachineImage{Name: "gardenlinux", Version: "1.0.0"},
+					}
+				}
+			})
+
+			It("should allow machine types whose boot capabilities are supported by the machine images", func() {
+				workers[1].Machine.Type = "m1.secure"
+
+				Expect(ValidateWorkersBootCapabilities(workers, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(BeEmpty())
+			})
+
+			It("should forbid machine types whose boot capabilities are not supported by the machine images", func() {
+				workers[1].Machine.Type = "m1.sev"
+
+				Expect(ValidateWorkersBootCapabilities(workers, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("[1].machine.image"),
+					})),
+				))
+			})
+
+			It("should forbid confidential computing for bare metal machine types", func() {
+				workers[0].Machine.Type = "bm.tdx"
+				cloudProfileConfig.MachineImages[0].Versions[0].CapabilityFlavors[1].Capabilities = nil
+
+				Expect(ValidateWorkersBootCapabilities(workers, "eu-1", cloudProfileSpec, cloudProfileConfig, nilPath)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("[0].machine.type"),
+					})),
+				))
+			})
+		})
+
 		Describe("#ValidateWorkersUpdate", func() {
 			It("should pass because workers are unchanged", func() {
 				newWorkers := copyWorkers(workers)
@@ -391,51 +476,29 @@ var _ = Describe("Shoot validation", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
-			It("should forbid removing a zone from a worker", func() {
+			It("should allow removing a zone from a worker", func() {
 				newWorkers := copyWorkers(workers)
 				newWorkers[1].Zones = newWorkers[1].Zones[1:]
 				errorList := ValidateWorkersUpdate(workers, newWorkers, nilPath)
 
-				Expect(errorList).To(ConsistOf(
-					PointTo(MatchFields(IgnoreExtras, Fields{
-						"Type":  Equal(field.ErrorTypeInvalid),
-						"Field": Equal("[1].zones"),
-					})),
-				))
+				Expect(errorList).To(BeEmpty())
 			})
 
-			It("should forbid changing the zone order", func() {
+			It("should allow changing the zone order", func() {
 				newWorkers := copyWorkers(workers)
 				newWorkers[0].Zones[0] = workers[0].Zones[1]
 				newWorkers[0].Zones[1] = workers[0].Zones[0]
-				newWorkers[1].Zones[0] = workers[1].Zones[1]
-				newWorkers[1].Zones[1] = workers[1].Zones[0]
 				errorList := ValidateWorkersUpdate(workers, newWorkers, nilPath)
 
-				Expect(errorList).To(ConsistOf(
-					PointTo(MatchFields(IgnoreExtras, Fields{
-						"Type":  Equal(field.ErrorTypeInvalid),
-						"Field": Equal("[0].zones"),
-					})),
-					PointTo(MatchFields(IgnoreExtras, Fields{
-						"Type":  Equal(field.ErrorTypeInvalid),
-						"Field": Equal("[1].zones"),
-					})),
-				))
+				Expect(errorList).To(BeEmpty())
 			})
 
-			It("should forbid adding a zone while changing an existing one", func() {
+			It("should allow replacing a zone of a worker", func() {
 				newWorkers := copyWorkers(workers)
-				newWorkers = append(newWorkers, core.Worker{Name: "worker3", Zones: []string{"zone1"}})
-				newWorkers[1].Zones[0] = workers[1].Zones[1]
+				newWorkers[1].Zones[0] = "another-zone"
 				errorList := ValidateWorkersUpdate(workers, newWorkers, nilPath)
 
-				Expect(errorList).To(ConsistOf(
-					PointTo(MatchFields(IgnoreExtras, Fields{
-						"Type":  Equal(field.ErrorTypeInvalid),
-						"Field": Equal("[1].zones"),
-					})),
-				))
+				Expect(errorList).To(BeEmpty())
 			})
 
 			It("should forbid changing the providerConfig when update strategy is in-place", func() {
@@ -10,8 +10,10 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
 	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
@@ -24,6 +26,10 @@ func ValidateWorkerConfig(worker *core.Worker, workerConfig *api.WorkerConfig, c
 	allErrs = append(allErrs, ValidateMachineLabels(worker, workerConfig, fldPath.Child("machineLabels"))...)
 	allErrs = append(allErrs, ValidateAdditionalSecurityGroups(workerConfig.AdditionalSecurityGroups, fldPath.Child("additionalSecurityGroups"))...)
 
+	if ptr.Deref(workerConfig.StoreOversizedUserData, false) && (cloudProfileConfig == nil || !ptr.Deref(cloudProfileConfig.StoreOversizedUserData, false)) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("storeOversizedUserData"), "storing oversized user data in Swift is not enabled in the cloud profile"))
+	}
+
 	return allErrs
 }
 
@@ -40,6 +46,11 @@ func ValidateServerGroup(worker *core.Worker, sg *api.ServerGroup, cloudProfileC
 		return allErrs
 	}
 
+	if cloudProfileConfig != nil && helper.IsBareMetalMachineType(cloudProfileConfig.MachineTypes, worker.Machine.Type) {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("server groups are not supported for the bare metal machine type %q", worker.Machine.Type)))
+		return allErrs
+	}
+
 	isPolicyMatching := func() bool {
 		if cloudProfileConfig == nil {
 			return false
@@ -58,8 +69,25 @@ func ValidateServerGroup(worker *core.Worker, sg *api.ServerGroup, cloudProfileC
 		return allErrs
 	}
 
-	if len(worker.Zones) > 1 && sg.Policy == openstackclient.ServerGroupPolicyAffinity {
-		allErrs = append(allErrs, field.Forbidden(fldPath.Child("policy"), fmt.Sprintf("using %q policy with multiple availability zones is not allowed", openstackclient.ServerGroupPolicyAffinity)))
+	if len(worker.Zones) > 1 && sg.Policy == openstackclient.ServerGroupPolicyAffinity && !ptr.Deref(sg.PerZone, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("policy"), fmt.Sprintf("using %q policy with multiple availability zones is only allowed with one server group per zone", openstackclient.ServerGroupPolicyAffinity)))
+	}
+
+	if sg.MaxServerPerHost != nil {
+		if sg.Policy != openstackclient.ServerGroupPolicyAntiAffinity {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxServerPerHost"), fmt.Sprintf("maxServerPerHost is only supported for the %q policy", openstackclient.ServerGroupPolicyAntiAffinity)))
+		} else if *sg.MaxServerPerHost < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxServerPerHost"), *sg.MaxServerPerHost, "must be at least 1"))
+		}
+	}
+
+	if sg.MaxMembers != nil {
+		// Spreading a worker pool over several server groups breaks the affinity between their members.
+		if sg.Policy != openstackclient.ServerGroupPolicyAntiAffinity && sg.Policy != openstackclient.ServerGroupPolicySoftAntiAffinity {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxMembers"), fmt.Sprintf("maxMembers is only supported for the %q and %q policies", openstackclient.ServerGroupPolicyAntiAffinity, openstackclient.ServerGroupPolicySoftAntiAffinity)))
+		} else if *sg.MaxMembers < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxMembers"), *sg.MaxMembers, "must be at least 1"))
+		}
 	}
 
 	return allErrs
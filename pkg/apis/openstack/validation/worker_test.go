@@ -92,7 +92,96 @@ var _ = Describe("ValidateWorkerConfig", func() {
 				PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":   Equal(field.ErrorTypeForbidden),
 					"Field":  Equal("config.policy"),
-					"Detail": Equal("using \"affinity\" policy with multiple availability zones is not allowed"),
+					"Detail": Equal("using \"affinity\" policy with multiple availability zones is only allowed with one server group per zone"),
+				})),
+			))
+		})
+
+		It("should allow the affinity policy with multiple zones if there is one server group per zone", func() {
+			sg.Policy = openstackclient.ServerGroupPolicyAffinity
+			sg.PerZone = ptr.To(true)
+			cloudProfileConfig.ServerGroupPolicies = append(cloudProfileConfig.ServerGroupPolicies, openstackclient.ServerGroupPolicyAffinity)
+
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow maxServerPerHost for the anti-affinity policy", func() {
+			sg.Policy = openstackclient.ServerGroupPolicyAntiAffinity
+			sg.MaxServerPerHost = ptr.To[int32](2)
+			cloudProfileConfig.ServerGroupPolicies = append(cloudProfileConfig.ServerGroupPolicies, openstackclient.ServerGroupPolicyAntiAffinity)
+
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should return an error when maxServerPerHost is less than 1", func() {
+			sg.Policy = openstackclient.ServerGroupPolicyAntiAffinity
+			sg.MaxServerPerHost = ptr.To[int32](0)
+			cloudProfileConfig.ServerGroupPolicies = append(cloudProfileConfig.ServerGroupPolicies, openstackclient.ServerGroupPolicyAntiAffinity)
+
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.maxServerPerHost"),
+				})),
+			))
+		})
+
+		It("should return an error when maxServerPerHost is used with another policy than anti-affinity", func() {
+			sg.MaxServerPerHost = ptr.To[int32](2)
+
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.maxServerPerHost"),
+				})),
+			))
+		})
+
+		It("should allow maxMembers for the anti-affinity policies", func() {
+			sg.MaxMembers = ptr.To[int32](10)
+			cloudProfileConfig.ServerGroupPolicies = append(cloudProfileConfig.ServerGroupPolicies, openstackclient.ServerGroupPolicyAntiAffinity, openstackclient.ServerGroupPolicySoftAntiAffinity)
+
+			sg.Policy = openstackclient.ServerGroupPolicyAntiAffinity
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(BeEmpty())
+			sg.Policy = openstackclient.ServerGroupPolicySoftAntiAffinity
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(BeEmpty())
+		})
+
+		It("should return an error when maxMembers is used with another policy than the anti-affinity policies", func() {
+			sg.Policy = openstackclient.ServerGroupPolicyAffinity
+			sg.PerZone = ptr.To(true)
+			sg.MaxMembers = ptr.To[int32](10)
+			cloudProfileConfig.ServerGroupPolicies = append(cloudProfileConfig.ServerGroupPolicies, openstackclient.ServerGroupPolicyAffinity)
+
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.maxMembers"),
+				})),
+			))
+		})
+
+		It("should return an error when maxMembers is less than 1", func() {
+			sg.Policy = openstackclient.ServerGroupPolicySoftAntiAffinity
+			sg.MaxMembers = ptr.To[int32](0)
+			cloudProfileConfig.ServerGroupPolicies = append(cloudProfileConfig.ServerGroupPolicies, openstackclient.ServerGroupPolicySoftAntiAffinity)
+
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("config.maxMembers"),
+				})),
+			))
+		})
+
+		It("should return an error when a server group is used with a bare metal machine type", func() {
+			worker.Machine.Type = "bm.large"
+			cloudProfileConfig.MachineTypes = []api.MachineType{{Name: "bm.large", BareMetal: ptr.To(true)}}
+
+			Expect(ValidateServerGroup(worker, sg, cloudProfileConfig, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config"),
 				})),
 			))
 		})
@@ -339,6 +428,32 @@ var _ = Describe("ValidateWorkerConfig", func() {
 		})
 	})
 
+	Describe("storeOversizedUserData", func() {
+		var (
+			fldPath      = field.NewPath("config")
+			worker       *core.Worker
+			workerConfig *api.WorkerConfig
+		)
+
+		BeforeEach(func() {
+			worker = &core.Worker{Machine: core.Machine{Type: "m1.small"}}
+			workerConfig = &api.WorkerConfig{StoreOversizedUserData: ptr.To(true)}
+		})
+
+		It("should allow storing oversized user data if it is enabled in the cloud profile", func() {
+			Expect(ValidateWorkerConfig(worker, workerConfig, &api.CloudProfileConfig{StoreOversizedUserData: ptr.To(true)}, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid storing oversized user data if it is not enabled in the cloud profile", func() {
+			Expect(ValidateWorkerConfig(worker, workerConfig, &api.CloudProfileConfig{}, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("config.storeOversizedUserData"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateNodeTemplate", func() {
 		var (
 			fldPath      = field.NewPath("config")
@@ -16,9 +16,77 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllocationPool) DeepCopyInto(out *AllocationPool) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllocationPool.
+func (in *AllocationPool) DeepCopy() *AllocationPool {
+	if in == nil {
+		return nil
+	}
+	out := new(AllocationPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationCredentials) DeepCopyInto(out *ApplicationCredentials) {
+	*out = *in
+	if in.RotationPeriod != nil {
+		in, out := &in.RotationPeriod, &out.RotationPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCredentials.
+func (in *ApplicationCredentials) DeepCopy() *ApplicationCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimitRule) DeepCopyInto(out *BandwidthLimitRule) {
+	*out = *in
+	if in.MaxBurstKbps != nil {
+		in, out := &in.MaxBurstKbps, &out.MaxBurstKbps
+		*out = new(int)
+		**out = **in
+	}
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimitRule.
+func (in *BandwidthLimitRule) DeepCopy() *BandwidthLimitRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimitRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CSIManila) DeepCopyInto(out *CSIManila) {
 	*out = *in
+	if in.CephFS != nil {
+		in, out := &in.CephFS, &out.CephFS
+		*out = new(CSIManilaCephFS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -32,6 +100,27 @@ func (in *CSIManila) DeepCopy() *CSIManila {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSIManilaCephFS) DeepCopyInto(out *CSIManilaCephFS) {
+	*out = *in
+	if in.Mounter != nil {
+		in, out := &in.Mounter, &out.Mounter
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSIManilaCephFS.
+func (in *CSIManilaCephFS) DeepCopy() *CSIManilaCephFS {
+	if in == nil {
+		return nil
+	}
+	out := new(CSIManilaCephFS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -104,6 +193,16 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IgnoreNetworkAZ != nil {
+		in, out := &in.IgnoreNetworkAZ, &out.IgnoreNetworkAZ
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VolumeAZMappings != nil {
+		in, out := &in.VolumeAZMappings, &out.VolumeAZMappings
+		*out = make([]VolumeAZMapping, len(*in))
+		copy(*out, *in)
+	}
 	if in.NodeVolumeAttachLimit != nil {
 		in, out := &in.NodeVolumeAttachLimit, &out.NodeVolumeAttachLimit
 		*out = new(int32)
@@ -131,6 +230,42 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FlavorPCIAliases != nil {
+		in, out := &in.FlavorPCIAliases, &out.FlavorPCIAliases
+		*out = make([]FlavorPCIAlias, len(*in))
+		copy(*out, *in)
+	}
+	if in.UseFlavorCapacity != nil {
+		in, out := &in.UseFlavorCapacity, &out.UseFlavorCapacity
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ServerMetadata != nil {
+		in, out := &in.ServerMetadata, &out.ServerMetadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.StoreOversizedUserData != nil {
+		in, out := &in.StoreOversizedUserData, &out.StoreOversizedUserData
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MachineTypes != nil {
+		in, out := &in.MachineTypes, &out.MachineTypes
+		*out = make([]MachineType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeTypes != nil {
+		in, out := &in.VolumeTypes, &out.VolumeTypes
+		*out = make([]VolumeType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -208,6 +343,31 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(Storage)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMS)
+		**out = **in
+	}
+	if in.KeystoneAuth != nil {
+		in, out := &in.KeystoneAuth, &out.KeystoneAuth
+		*out = new(KeystoneAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplicationCredentials != nil {
+		in, out := &in.ApplicationCredentials, &out.ApplicationCredentials
+		*out = new(ApplicationCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LoadBalancerPolicy != nil {
+		in, out := &in.LoadBalancerPolicy, &out.LoadBalancerPolicy
+		*out = new(LoadBalancerPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -229,6 +389,154 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DSCPMarkingRule) DeepCopyInto(out *DSCPMarkingRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DSCPMarkingRule.
+func (in *DSCPMarkingRule) DeepCopy() *DSCPMarkingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DSCPMarkingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExhaustedZone) DeepCopyInto(out *ExhaustedZone) {
+	*out = *in
+	in.LastDetectionTime.DeepCopyInto(&out.LastDetectionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExhaustedZone.
+func (in *ExhaustedZone) DeepCopy() *ExhaustedZone {
+	if in == nil {
+		return nil
+	}
+	out := new(ExhaustedZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Firewall) DeepCopyInto(out *Firewall) {
+	*out = *in
+	if in.EgressRules != nil {
+		in, out := &in.EgressRules, &out.EgressRules
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.IngressRules != nil {
+		in, out := &in.IngressRules, &out.IngressRules
+		*out = make([]FirewallRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Firewall.
+func (in *Firewall) DeepCopy() *Firewall {
+	if in == nil {
+		return nil
+	}
+	out := new(Firewall)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FirewallRule) DeepCopyInto(out *FirewallRule) {
+	*out = *in
+	if in.Protocol != nil {
+		in, out := &in.Protocol, &out.Protocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPVersion != nil {
+		in, out := &in.IPVersion, &out.IPVersion
+		*out = new(int)
+		**out = **in
+	}
+	if in.SourceCIDR != nil {
+		in, out := &in.SourceCIDR, &out.SourceCIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationCIDR != nil {
+		in, out := &in.DestinationCIDR, &out.DestinationCIDR
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourcePort != nil {
+		in, out := &in.SourcePort, &out.SourcePort
+		*out = new(string)
+		**out = **in
+	}
+	if in.DestinationPort != nil {
+		in, out := &in.DestinationPort, &out.DestinationPort
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FirewallRule.
+func (in *FirewallRule) DeepCopy() *FirewallRule {
+	if in == nil {
+		return nil
+	}
+	out := new(FirewallRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Flavor) DeepCopyInto(out *Flavor) {
+	*out = *in
+	if in.ExtraSpecs != nil {
+		in, out := &in.ExtraSpecs, &out.ExtraSpecs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Flavor.
+func (in *Flavor) DeepCopy() *Flavor {
+	if in == nil {
+		return nil
+	}
+	out := new(Flavor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlavorPCIAlias) DeepCopyInto(out *FlavorPCIAlias) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorPCIAlias.
+func (in *FlavorPCIAlias) DeepCopy() *FlavorPCIAlias {
+	if in == nil {
+		return nil
+	}
+	out := new(FlavorPCIAlias)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FloatingPool) DeepCopyInto(out *FloatingPool) {
 	*out = *in
@@ -404,6 +712,22 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMS) DeepCopyInto(out *KMS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMS.
+func (in *KMS) DeepCopy() *KMS {
+	if in == nil {
+		return nil
+	}
+	out := new(KMS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KeyStoneURL) DeepCopyInto(out *KeyStoneURL) {
 	*out = *in
@@ -425,6 +749,55 @@ func (in *KeyStoneURL) DeepCopy() *KeyStoneURL {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeystoneAuth) DeepCopyInto(out *KeystoneAuth) {
+	*out = *in
+	if in.RoleMappings != nil {
+		in, out := &in.RoleMappings, &out.RoleMappings
+		*out = make([]KeystoneRoleMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AuthorizationPolicy != nil {
+		in, out := &in.AuthorizationPolicy, &out.AuthorizationPolicy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeystoneAuth.
+func (in *KeystoneAuth) DeepCopy() *KeystoneAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(KeystoneAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeystoneRoleMapping) DeepCopyInto(out *KeystoneRoleMapping) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeystoneRoleMapping.
+func (in *KeystoneRoleMapping) DeepCopy() *KeystoneRoleMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(KeystoneRoleMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerClass) DeepCopyInto(out *LoadBalancerClass) {
 	*out = *in
@@ -458,6 +831,21 @@ func (in *LoadBalancerClass) DeepCopyInto(out *LoadBalancerClass) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IPv6SubnetID != nil {
+		in, out := &in.IPv6SubnetID, &out.IPv6SubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.IPv6FloatingSubnetID != nil {
+		in, out := &in.IPv6FloatingSubnetID, &out.IPv6FloatingSubnetID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Settings != nil {
+		in, out := &in.Settings, &out.Settings
+		*out = new(LoadBalancerSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -471,6 +859,75 @@ func (in *LoadBalancerClass) DeepCopy() *LoadBalancerClass {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerMonitor) DeepCopyInto(out *LoadBalancerMonitor) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxRetriesDown != nil {
+		in, out := &in.MaxRetriesDown, &out.MaxRetriesDown
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerMonitor.
+func (in *LoadBalancerMonitor) DeepCopy() *LoadBalancerMonitor {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerMonitor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerPolicy) DeepCopyInto(out *LoadBalancerPolicy) {
+	*out = *in
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = make([]NamespaceLoadBalancerClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InternalNamespaceSelector != nil {
+		in, out := &in.InternalNamespaceSelector, &out.InternalNamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerPolicy.
+func (in *LoadBalancerPolicy) DeepCopy() *LoadBalancerPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerProvider) DeepCopyInto(out *LoadBalancerProvider) {
 	*out = *in
@@ -482,12 +939,58 @@ func (in *LoadBalancerProvider) DeepCopyInto(out *LoadBalancerProvider) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerProvider.
-func (in *LoadBalancerProvider) DeepCopy() *LoadBalancerProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerProvider.
+func (in *LoadBalancerProvider) DeepCopy() *LoadBalancerProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSettings) DeepCopyInto(out *LoadBalancerSettings) {
+	*out = *in
+	if in.FlavorID != nil {
+		in, out := &in.FlavorID, &out.FlavorID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(LoadBalancerMonitor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EnableIngressHostname != nil {
+		in, out := &in.EnableIngressHostname, &out.EnableIngressHostname
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxSharedLB != nil {
+		in, out := &in.MaxSharedLB, &out.MaxSharedLB
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSettings.
+func (in *LoadBalancerSettings) DeepCopy() *LoadBalancerSettings {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerProvider)
+	out := new(LoadBalancerSettings)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -635,6 +1138,65 @@ func (in *MachineLabel) DeepCopy() *MachineLabel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineType) DeepCopyInto(out *MachineType) {
+	*out = *in
+	if in.BareMetal != nil {
+		in, out := &in.BareMetal, &out.BareMetal
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineType.
+func (in *MachineType) DeepCopy() *MachineType {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinimumBandwidthRule) DeepCopyInto(out *MinimumBandwidthRule) {
+	*out = *in
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinimumBandwidthRule.
+func (in *MinimumBandwidthRule) DeepCopy() *MinimumBandwidthRule {
+	if in == nil {
+		return nil
+	}
+	out := new(MinimumBandwidthRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceLoadBalancerClass) DeepCopyInto(out *NamespaceLoadBalancerClass) {
+	*out = *in
+	in.NamespaceSelector.DeepCopyInto(&out.NamespaceSelector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceLoadBalancerClass.
+func (in *NamespaceLoadBalancerClass) DeepCopy() *NamespaceLoadBalancerClass {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceLoadBalancerClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 	*out = *in
@@ -650,6 +1212,11 @@ func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 		*out = new(ShareNetworkStatus)
 		**out = **in
 	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -669,7 +1236,7 @@ func (in *Networks) DeepCopyInto(out *Networks) {
 	if in.Router != nil {
 		in, out := &in.Router, &out.Router
 		*out = new(Router)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.SubnetPool != nil {
 		in, out := &in.SubnetPool, &out.SubnetPool
@@ -691,6 +1258,36 @@ func (in *Networks) DeepCopyInto(out *Networks) {
 		*out = new(IPv6Config)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostRoutes != nil {
+		in, out := &in.HostRoutes, &out.HostRoutes
+		*out = make([]Route, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllocationPools != nil {
+		in, out := &in.AllocationPools, &out.AllocationPools
+		*out = make([]AllocationPool, len(*in))
+		copy(*out, *in)
+	}
+	if in.AvailabilityZoneHints != nil {
+		in, out := &in.AvailabilityZoneHints, &out.AvailabilityZoneHints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.QoSPolicy != nil {
+		in, out := &in.QoSPolicy, &out.QoSPolicy
+		*out = new(QoSPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Firewall != nil {
+		in, out := &in.Firewall, &out.Firewall
+		*out = new(Firewall)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -720,6 +1317,46 @@ func (in *NodeStatus) DeepCopy() *NodeStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QoSPolicy) DeepCopyInto(out *QoSPolicy) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BandwidthLimitRules != nil {
+		in, out := &in.BandwidthLimitRules, &out.BandwidthLimitRules
+		*out = make([]BandwidthLimitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MinimumBandwidthRules != nil {
+		in, out := &in.MinimumBandwidthRules, &out.MinimumBandwidthRules
+		*out = make([]MinimumBandwidthRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DSCPMarkingRules != nil {
+		in, out := &in.DSCPMarkingRules, &out.DSCPMarkingRules
+		*out = make([]DSCPMarkingRule, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QoSPolicy.
+func (in *QoSPolicy) DeepCopy() *QoSPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(QoSPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegionIDMapping) DeepCopyInto(out *RegionIDMapping) {
 	*out = *in
@@ -741,9 +1378,30 @@ func (in *RegionIDMapping) DeepCopy() *RegionIDMapping {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Router) DeepCopyInto(out *Router) {
 	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]Route, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -765,6 +1423,11 @@ func (in *RouterStatus) DeepCopyInto(out *RouterStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -797,6 +1460,21 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerGroup) DeepCopyInto(out *ServerGroup) {
 	*out = *in
+	if in.MaxServerPerHost != nil {
+		in, out := &in.MaxServerPerHost, &out.MaxServerPerHost
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PerZone != nil {
+		in, out := &in.PerZone, &out.PerZone
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxMembers != nil {
+		in, out := &in.MaxMembers, &out.MaxMembers
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -864,7 +1542,14 @@ func (in *Storage) DeepCopyInto(out *Storage) {
 	if in.CSIManila != nil {
 		in, out := &in.CSIManila, &out.CSIManila
 		*out = new(CSIManila)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]StorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -879,6 +1564,72 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(string)
+		**out = **in
+	}
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VolumeType != nil {
+		in, out := &in.VolumeType, &out.VolumeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.AvailabilityZone != nil {
+		in, out := &in.AvailabilityZone, &out.AvailabilityZone
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FSType != nil {
+		in, out := &in.FSType, &out.FSType
+		*out = new(string)
+		**out = **in
+	}
+	if in.ReclaimPolicy != nil {
+		in, out := &in.ReclaimPolicy, &out.ReclaimPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeBindingMode != nil {
+		in, out := &in.VolumeBindingMode, &out.VolumeBindingMode
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShareProtocol != nil {
+		in, out := &in.ShareProtocol, &out.ShareProtocol
+		*out = new(string)
+		**out = **in
+	}
+	if in.ShareNetworkID != nil {
+		in, out := &in.ShareNetworkID, &out.ShareNetworkID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageClassDefinition) DeepCopyInto(out *StorageClassDefinition) {
 	*out = *in
@@ -968,6 +1719,43 @@ func (in *SubnetPool) DeepCopy() *SubnetPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeAZMapping) DeepCopyInto(out *VolumeAZMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeAZMapping.
+func (in *VolumeAZMapping) DeepCopy() *VolumeAZMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeAZMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeType) DeepCopyInto(out *VolumeType) {
+	*out = *in
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeType.
+func (in *VolumeType) DeepCopy() *VolumeType {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeType)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	*out = *in
@@ -980,7 +1768,7 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 	if in.ServerGroup != nil {
 		in, out := &in.ServerGroup, &out.ServerGroup
 		*out = new(ServerGroup)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MachineLabels != nil {
 		in, out := &in.MachineLabels, &out.MachineLabels
@@ -992,6 +1780,21 @@ func (in *WorkerConfig) DeepCopyInto(out *WorkerConfig) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.UseConfigDrive != nil {
+		in, out := &in.UseConfigDrive, &out.UseConfigDrive
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ZoneFallback != nil {
+		in, out := &in.ZoneFallback, &out.ZoneFallback
+		*out = new(bool)
+		**out = **in
+	}
+	if in.StoreOversizedUserData != nil {
+		in, out := &in.StoreOversizedUserData, &out.StoreOversizedUserData
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1013,6 +1816,27 @@ func (in *WorkerConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolZones) DeepCopyInto(out *WorkerPoolZones) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]ZoneIndex, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolZones.
+func (in *WorkerPoolZones) DeepCopy() *WorkerPoolZones {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolZones)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	*out = *in
@@ -1029,6 +1853,27 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 		*out = make([]ServerGroupDependency, len(*in))
 		copy(*out, *in)
 	}
+	if in.WorkerPoolZones != nil {
+		in, out := &in.WorkerPoolZones, &out.WorkerPoolZones
+		*out = make([]WorkerPoolZones, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExhaustedZones != nil {
+		in, out := &in.ExhaustedZones, &out.ExhaustedZones
+		*out = make([]ExhaustedZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Flavors != nil {
+		in, out := &in.Flavors, &out.Flavors
+		*out = make([]Flavor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1049,3 +1894,19 @@ func (in *WorkerStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneIndex) DeepCopyInto(out *ZoneIndex) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneIndex.
+func (in *ZoneIndex) DeepCopy() *ZoneIndex {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneIndex)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -30,6 +30,7 @@ import (
 	cloudproviderwebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/cloudprovider"
 	controlplanewebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/controlplane"
 	seedproviderwebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/seedprovider"
+	servicewebhook "github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/service"
 )
 
 // ControllerSwitchOptions are the controllercmd.SwitchOptions for the provider controllers.
@@ -53,5 +54,7 @@ func WebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(extensioncontrolplanewebhook.WebhookName, controlplanewebhook.AddToManager),
 		webhookcmd.Switch(extensioncontrolplanewebhook.SeedProviderWebhookName, seedproviderwebhook.AddToManager),
 		webhookcmd.Switch(extensionscloudproviderwebhook.WebhookName, cloudproviderwebhook.AddToManager),
+		webhookcmd.Switch(servicewebhook.MutatorWebhookName, servicewebhook.AddMutatorToManager),
+		webhookcmd.Switch(servicewebhook.ValidatorWebhookName, servicewebhook.AddValidatorToManager),
 	)
 }
@@ -7,6 +7,8 @@ package controlplane
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync/atomic"
 
 	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -19,6 +21,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 	networking "github.com/gardener/gardener-extension-provider-openstack/pkg/utils/networking"
 )
 
@@ -33,18 +37,23 @@ const (
 	AnnotationCalicoCleanupCompleted = "openstack.provider.extensions.gardener.cloud/calico-cleanup-completed"
 )
 
-// NewActuator creates a new Actuator that wraps the generic actuator and adds cleanup logic.
-func NewActuator(mgr manager.Manager, a controlplane.Actuator) controlplane.Actuator {
+// NewActuator creates a new Actuator that wraps the generic actuator and adds cleanup logic. The shoot webhooks are
+// deployed by this actuator, hence the generic actuator must be created without them.
+func NewActuator(mgr manager.Manager, a controlplane.Actuator, clientFactoryFactory openstackclient.FactoryFactory, shootWebhookConfig *atomic.Value) controlplane.Actuator {
 	return &actuator{
-		Actuator: a,
-		client:   mgr.GetClient(),
+		Actuator:             a,
+		client:               mgr.GetClient(),
+		clientFactoryFactory: clientFactoryFactory,
+		shootWebhookConfig:   shootWebhookConfig,
 	}
 }
 
 // actuator is an Actuator that acts upon and updates the status of ControlPlane resources.
 type actuator struct {
 	controlplane.Actuator
-	client client.Client
+	client               client.Client
+	clientFactoryFactory openstackclient.FactoryFactory
+	shootWebhookConfig   *atomic.Value
 }
 
 func (a *actuator) Reconcile(
@@ -53,11 +62,47 @@ func (a *actuator) Reconcile(
 	cp *extensionsv1alpha1.ControlPlane,
 	cluster *extensionscontroller.Cluster,
 ) (bool, error) {
+	cpConfig, err := helper.ControlPlaneConfigFromRawExtension(cp.Spec.ProviderConfig)
+	if err != nil {
+		return false, fmt.Errorf("could not decode providerConfig of controlplane: %w", err)
+	}
+
+	// The application credentials must exist before the generic actuator renders the cloud provider configs, while
+	// the ones which are not required anymore can only be revoked after the configs have been switched back.
+	applicationCredentialComponents := applicationCredentialComponents(cpConfig)
+	if len(applicationCredentialComponents) > 0 {
+		if err := a.reconcileApplicationCredentials(ctx, log, cp, cluster, cpConfig, applicationCredentialComponents); err != nil {
+			return false, util.DetermineError(err, helper.KnownCodes)
+		}
+	}
+
+	if err := a.reconcileKMS(ctx, cp, cluster, cpConfig); err != nil {
+		return false, err
+	}
+
+	if err := a.reconcileShootWebhooks(ctx, cp, cluster, cpConfig); err != nil {
+		return false, err
+	}
+
 	ok, err := a.Actuator.Reconcile(ctx, log, cp, cluster)
 	if err != nil {
 		return ok, err
 	}
 
+	var obsoleteApplicationCredentialComponents []string
+	for _, component := range allApplicationCredentialComponents {
+		if !slices.Contains(applicationCredentialComponents, component) {
+			obsoleteApplicationCredentialComponents = append(obsoleteApplicationCredentialComponents, component)
+		}
+	}
+	if err := a.deleteApplicationCredentials(ctx, log, cp, cluster, obsoleteApplicationCredentialComponents); err != nil {
+		return ok, util.DetermineError(err, helper.KnownCodes)
+	}
+
+	if err := a.updateKeystoneAuthCondition(ctx, cp, cpConfig); err != nil {
+		return ok, err
+	}
+
 	overlayEnabled, err := networking.IsOverlayEnabled(cluster.Shoot.Spec.Networking)
 	if err != nil {
 		log.Error(err, "Failed to determine if overlay is enabled")
@@ -91,6 +136,59 @@ func (a *actuator) Reconcile(
 	return ok, nil
 }
 
+// Delete deletes the control plane and revokes the application credentials of its components afterwards.
+func (a *actuator) Delete(
+	ctx context.Context,
+	log logr.Logger,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) error {
+	if err := a.Actuator.Delete(ctx, log, cp, cluster); err != nil {
+		return err
+	}
+	if err := a.deleteShootWebhooks(ctx, cp, cluster); err != nil {
+		return err
+	}
+
+	return util.DetermineError(a.deleteApplicationCredentials(ctx, log, cp, cluster, allApplicationCredentialComponents), helper.KnownCodes)
+}
+
+// ForceDelete forcefully deletes the control plane.
+func (a *actuator) ForceDelete(
+	ctx context.Context,
+	log logr.Logger,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) error {
+	if err := a.Actuator.ForceDelete(ctx, log, cp, cluster); err != nil {
+		return err
+	}
+	return a.deleteShootWebhooks(ctx, cp, cluster)
+}
+
+// Restore restores the control plane in the same way as it is reconciled.
+func (a *actuator) Restore(
+	ctx context.Context,
+	log logr.Logger,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) (bool, error) {
+	return a.Reconcile(ctx, log, cp, cluster)
+}
+
+// Migrate deletes the control plane components in the seed before the shoot is migrated to another seed.
+func (a *actuator) Migrate(
+	ctx context.Context,
+	log logr.Logger,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+) error {
+	if err := a.Actuator.Migrate(ctx, log, cp, cluster); err != nil {
+		return err
+	}
+	return a.deleteShootWebhooks(ctx, cp, cluster)
+}
+
 // cleanupCalicoNetworkUnavailableConditions removes NetworkUnavailable conditions from nodes
 // that were set by Calico for example "CalicoIsUp" or "CalicoIsDown".
 func (a *actuator) cleanupCalicoNetworkUnavailableConditions(
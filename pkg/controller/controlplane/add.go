@@ -6,6 +6,7 @@ package controlplane
 
 import (
 	"context"
+	"sync/atomic"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/controlplane"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/gardener/gardener-extension-provider-openstack/imagevector"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
 var (
@@ -30,6 +32,8 @@ type AddOptions struct {
 	Controller controller.Options
 	// IgnoreOperationAnnotation specifies whether to ignore the operation annotation or not.
 	IgnoreOperationAnnotation bool
+	// ShootWebhookConfig contains the desired webhook configurations of the shoot.
+	ShootWebhookConfig *atomic.Value
 	// WebhookServerNamespace is the namespace in which the webhook server runs.
 	WebhookServerNamespace string
 	// ExtensionClasses defines the extension classes this extension is responsible for.
@@ -48,8 +52,8 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 		return err
 	}
 
-	// Wrap the generic actuator with our custom actuator for cleanup logic
-	wrappedActuator := NewActuator(mgr, genericActuator)
+	// Wrap the generic actuator with our custom actuator for cleanup logic and the shoot webhooks
+	wrappedActuator := NewActuator(mgr, genericActuator, openstackclient.FactoryFactoryFunc(openstackclient.NewOpenstackClientFromCredentials), opts.ShootWebhookConfig)
 
 	return controlplane.Add(mgr, controlplane.AddArgs{
 		Actuator:          wrappedActuator,
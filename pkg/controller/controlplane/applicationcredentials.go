@@ -0,0 +1,376 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// applicationCredentialCloudControllerManager is the component name of the application credential of the
+	// cloud-controller-manager.
+	applicationCredentialCloudControllerManager = "cloud-controller-manager"
+	// applicationCredentialCSIDriverCinder is the component name of the application credential of the Cinder CSI driver.
+	applicationCredentialCSIDriverCinder = "csi-driver-cinder"
+	// applicationCredentialCSIDriverManila is the component name of the application credential of the Manila CSI driver.
+	applicationCredentialCSIDriverManila = "csi-driver-manila"
+	// applicationCredentialBarbicanKMSPlugin is the component name of the application credential of the Barbican KMS
+	// plugin.
+	applicationCredentialBarbicanKMSPlugin = "barbican-kms-plugin"
+
+	// previousApplicationCredentialID is the key in an application credential secret which holds the ID of the
+	// application credential which was used before the last rotation.
+	previousApplicationCredentialID = "previousApplicationCredentialID"
+
+	// defaultApplicationCredentialRotationPeriod is the period after which application credentials are rotated if the
+	// ControlPlaneConfig does not specify one.
+	defaultApplicationCredentialRotationPeriod = 30 * 24 * time.Hour
+)
+
+var (
+	allApplicationCredentialComponents = []string{
+		applicationCredentialCloudControllerManager,
+		applicationCredentialCSIDriverCinder,
+		applicationCredentialCSIDriverManila,
+		applicationCredentialBarbicanKMSPlugin,
+	}
+
+	// applicationCredentialAccessRules are the access rules of the application credentials of the components. The
+	// services are identified by their type in the catalog, for which both the current and the legacy names are listed.
+	applicationCredentialAccessRules = map[string][]applicationcredentials.AccessRule{
+		applicationCredentialCloudControllerManager: slices.Concat(
+			accessRules([]string{"compute"}, http.MethodGet),
+			accessRules([]string{"network", "load-balancer"}, http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete),
+			accessRules([]string{"key-manager"}, http.MethodGet),
+		),
+		applicationCredentialCSIDriverCinder: slices.Concat(
+			accessRules([]string{"compute"}, http.MethodGet, http.MethodPost, http.MethodDelete),
+			accessRules([]string{"block-storage", "volumev3"}, http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete),
+		),
+		applicationCredentialCSIDriverManila: accessRules([]string{"shared-file-system", "sharev2"}, http.MethodGet, http.MethodPost, http.MethodPut, http.MethodDelete),
+	}
+)
+
+// componentAccessRules returns the access rules of the application credential of the given component. The one of the
+// Barbican KMS plugin may only read the key of the shoot.
+func componentAccessRules(cpConfig *api.ControlPlaneConfig, component string) []applicationcredentials.AccessRule {
+	if component == applicationCredentialBarbicanKMSPlugin && cpConfig.KMS != nil {
+		return []applicationcredentials.AccessRule{
+			{Service: "key-manager", Method: http.MethodGet, Path: "/v1/secrets/" + cpConfig.KMS.KeyID},
+			{Service: "key-manager", Method: http.MethodGet, Path: "/v1/secrets/" + cpConfig.KMS.KeyID + "/payload"},
+		}
+	}
+	return applicationCredentialAccessRules[component]
+}
+
+func accessRules(services []string, methods ...string) []applicationcredentials.AccessRule {
+	var rules []applicationcredentials.AccessRule
+	for _, service := range services {
+		for _, method := range methods {
+			rules = append(rules, applicationcredentials.AccessRule{Service: service, Method: method, Path: "/**"})
+		}
+	}
+	return rules
+}
+
+// applicationCredentialSecretName returns the name of the secret holding the application credential of the given
+// component.
+func applicationCredentialSecretName(component string) string {
+	return "application-credential-" + component
+}
+
+// applicationCredentialNamePrefix returns the prefix of the names of all application credentials which are created for
+// the given component of the shoot in the given namespace.
+func applicationCredentialNamePrefix(namespace, component string) string {
+	return fmt.Sprintf("gardener/%s/%s/", namespace, component)
+}
+
+// applicationCredentialComponents returns the components which require a dedicated application credential. The Barbican
+// KMS plugin always uses one, as it runs next to the kube-apiserver and only needs to read the key of the shoot.
+func applicationCredentialComponents(cpConfig *api.ControlPlaneConfig) []string {
+	var components []string
+	if cpConfig.ApplicationCredentials != nil {
+		components = append(components, applicationCredentialCloudControllerManager, applicationCredentialCSIDriverCinder)
+		if cpConfig.Storage != nil && cpConfig.Storage.CSIManila != nil && cpConfig.Storage.CSIManila.Enabled {
+			components = append(components, applicationCredentialCSIDriverManila)
+		}
+	}
+	if cpConfig.KMS != nil {
+		components = append(components, applicationCredentialBarbicanKMSPlugin)
+	}
+	return components
+}
+
+// reconcileApplicationCredentials creates the application credentials of the given components and rotates them once
+// they are older than the rotation period. The application credential which was replaced by the last rotation is kept
+// until the next rotation, so that components which are still rolling out can use it.
+func (a *actuator) reconcileApplicationCredentials(
+	ctx context.Context,
+	log logr.Logger,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	cpConfig *api.ControlPlaneConfig,
+	components []string,
+) error {
+	identity, userID, err := a.newIdentityClient(ctx, cp, cluster)
+	if err != nil {
+		return err
+	}
+
+	existing, err := identity.ListApplicationCredentials(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed listing application credentials: %w", err)
+	}
+
+	rotationPeriod := defaultApplicationCredentialRotationPeriod
+	if cpConfig.ApplicationCredentials != nil && cpConfig.ApplicationCredentials.RotationPeriod != nil {
+		rotationPeriod = cpConfig.ApplicationCredentials.RotationPeriod.Duration
+	}
+
+	for _, component := range components {
+		if err := a.reconcileApplicationCredential(ctx, log, identity, userID, existing, cp.Namespace, component, componentAccessRules(cpConfig, component), rotationPeriod); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *actuator) reconcileApplicationCredential(
+	ctx context.Context,
+	log logr.Logger,
+	identity openstackclient.Identity,
+	userID string,
+	existing []applicationcredentials.ApplicationCredential,
+	namespace, component string,
+	accessRules []applicationcredentials.AccessRule,
+	rotationPeriod time.Duration,
+) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: applicationCredentialSecretName(component), Namespace: namespace}}
+	if err := a.client.Get(ctx, client.ObjectKeyFromObject(secret), secret); client.IgnoreNotFound(err) != nil {
+		return err
+	}
+	currentID := string(secret.Data[openstack.ApplicationCredentialID])
+	previousID := string(secret.Data[previousApplicationCredentialID])
+
+	prefix := applicationCredentialNamePrefix(namespace, component)
+	var current *applicationcredentials.ApplicationCredential
+	for i, applicationCredential := range existing {
+		if strings.HasPrefix(applicationCredential.Name, prefix) && applicationCredential.ID == currentID {
+			current = &existing[i]
+		}
+	}
+
+	now := time.Now()
+	if current == nil || current.ExpiresAt.Sub(now) < rotationPeriod {
+		expiresAt := now.Add(2 * rotationPeriod)
+		created, err := identity.CreateApplicationCredential(ctx, userID, applicationcredentials.CreateOpts{
+			Name:        prefix + strconv.FormatInt(now.Unix(), 10),
+			Description: fmt.Sprintf("Used by the %s of the shoot with the control plane namespace %s. Managed by Gardener.", component, namespace),
+			AccessRules: accessRules,
+			ExpiresAt:   &expiresAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed creating application credential for %s (application credentials can only be created with user credentials or unrestricted application credentials): %w", component, err)
+		}
+		log.Info("Created application credential", "component", component, "id", created.ID, "expiresAt", expiresAt)
+
+		if _, err := controllerutil.CreateOrUpdate(ctx, a.client, secret, func() error {
+			secret.Type = corev1.SecretTypeOpaque
+			secret.Data = map[string][]byte{
+				openstack.ApplicationCredentialID:     []byte(created.ID),
+				openstack.ApplicationCredentialSecret: []byte(created.Secret),
+			}
+			if current != nil {
+				secret.Data[previousApplicationCredentialID] = []byte(current.ID)
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+		currentID, previousID = created.ID, currentID
+	}
+
+	for _, applicationCredential := range existing {
+		if !strings.HasPrefix(applicationCredential.Name, prefix) || applicationCredential.ID == currentID || applicationCredential.ID == previousID {
+			continue
+		}
+		if err := openstackclient.IgnoreNotFoundError(identity.DeleteApplicationCredential(ctx, userID, applicationCredential.ID)); err != nil {
+			return fmt.Errorf("failed deleting application credential %s of %s: %w", applicationCredential.ID, component, err)
+		}
+		log.Info("Deleted application credential", "component", component, "id", applicationCredential.ID)
+	}
+	return nil
+}
+
+// deleteApplicationCredentials revokes all application credentials of the given components and deletes their secrets.
+// Keystone is only called if a secret of one of the components exists.
+func (a *actuator) deleteApplicationCredentials(
+	ctx context.Context,
+	log logr.Logger,
+	cp *extensionsv1alpha1.ControlPlane,
+	cluster *extensionscontroller.Cluster,
+	components []string,
+) error {
+	var secrets []*corev1.Secret
+	for _, component := range components {
+		secret := &corev1.Secret{}
+		if err := a.client.Get(ctx, client.ObjectKey{Namespace: cp.Namespace, Name: applicationCredentialSecretName(component)}, secret); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		secrets = append(secrets, secret)
+	}
+	if len(secrets) == 0 {
+		return nil
+	}
+
+	identity, userID, err := a.newIdentityClient(ctx, cp, cluster)
+	if err != nil {
+		return err
+	}
+
+	existing, err := identity.ListApplicationCredentials(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("failed listing application credentials: %w", err)
+	}
+
+	for _, component := range components {
+		prefix := applicationCredentialNamePrefix(cp.Namespace, component)
+		for _, applicationCredential := range existing {
+			if !strings.HasPrefix(applicationCredential.Name, prefix) {
+				continue
+			}
+			if err := openstackclient.IgnoreNotFoundError(identity.DeleteApplicationCredential(ctx, userID, applicationCredential.ID)); err != nil {
+				return fmt.Errorf("failed deleting application credential %s of %s: %w", applicationCredential.ID, component, err)
+			}
+			log.Info("Deleted application credential", "component", component, "id", applicationCredential.ID)
+		}
+	}
+
+	for _, secret := range secrets {
+		if err := client.IgnoreNotFound(a.client.Delete(ctx, secret)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newIdentityClient returns a Keystone client authenticated with the credentials of the shoot together with the ID of
+// the user the application credentials are created for.
+func (a *actuator) newIdentityClient(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (openstackclient.Identity, string, error) {
+	credentials, err := a.getCredentials(ctx, cp, cluster)
+	if err != nil {
+		return nil, "", err
+	}
+
+	clientFactory, err := a.clientFactoryFactory.NewFactory(ctx, credentials)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create openstack client: %w", err)
+	}
+	identity, err := clientFactory.Identity(openstackclient.WithRegion(cp.Spec.Region))
+	if err != nil {
+		return nil, "", err
+	}
+	userID, err := identity.GetCurrentUserID(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to determine the user of the credentials: %w", err)
+	}
+	return identity, userID, nil
+}
+
+// getCredentials returns the credentials of the shoot. The Keystone URL is taken from the CloudProfile if the credentials
+// do not contain one.
+func (a *actuator) getCredentials(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) (*openstack.Credentials, error) {
+	credentials, err := openstack.GetCredentials(ctx, a.client, cp.Spec.SecretRef, false)
+	if err != nil {
+		return nil, fmt.Errorf("could not get credentials from secret '%s/%s': %w", cp.Spec.SecretRef.Namespace, cp.Spec.SecretRef.Name, err)
+	}
+	if len(strings.TrimSpace(credentials.AuthURL)) == 0 {
+		cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+		if err != nil {
+			return nil, err
+		}
+		if cloudProfileConfig != nil {
+			keyStoneURL, err := helper.FindKeyStoneURL(cloudProfileConfig.KeyStoneURLs, cloudProfileConfig.KeyStoneURL, cp.Spec.Region)
+			if err != nil {
+				return nil, err
+			}
+			credentials.AuthURL = keyStoneURL
+		}
+	}
+	return credentials, nil
+}
+
+// getApplicationCredentials returns the credentials of the components which use a dedicated application credential.
+func (vp *valuesProvider) getApplicationCredentials(
+	ctx context.Context,
+	cpConfig *api.ControlPlaneConfig,
+	cp *extensionsv1alpha1.ControlPlane,
+	credentials *openstack.Credentials,
+) (map[string]*openstack.Credentials, error) {
+	components := applicationCredentialComponents(cpConfig)
+	if len(components) == 0 || credentials == nil {
+		return nil, nil
+	}
+
+	applicationCredentials := make(map[string]*openstack.Credentials, len(components))
+	for _, component := range components {
+		c, err := applicationCredential(ctx, vp.client, cp.Namespace, credentials, component)
+		if err != nil {
+			return nil, err
+		}
+		applicationCredentials[component] = c
+	}
+	return applicationCredentials, nil
+}
+
+// applicationCredential returns the credentials of the given component with its dedicated application credential. They
+// are derived from the given credentials of the shoot, i.e. only the authentication differs.
+func applicationCredential(ctx context.Context, c client.Client, namespace string, credentials *openstack.Credentials, component string) (*openstack.Credentials, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: applicationCredentialSecretName(component)}, secret); err != nil {
+		return nil, fmt.Errorf("could not read application credential of %s: %w", component, err)
+	}
+
+	componentCredentials := *credentials
+	componentCredentials.Username = ""
+	componentCredentials.Password = ""
+	componentCredentials.ApplicationCredentialID = string(secret.Data[openstack.ApplicationCredentialID])
+	componentCredentials.ApplicationCredentialName = ""
+	componentCredentials.ApplicationCredentialSecret = string(secret.Data[openstack.ApplicationCredentialSecret])
+	return &componentCredentials, nil
+}
+
+// componentCredentials returns the application credential of the given component if there is one and the credentials
+// of the shoot otherwise.
+func componentCredentials(credentials *openstack.Credentials, applicationCredentials map[string]*openstack.Credentials, component string) *openstack.Credentials {
+	if c, ok := applicationCredentials[component]; ok {
+		return c
+	}
+	return credentials
+}
@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
+)
+
+var _ = Describe("ApplicationCredentials", func() {
+	const userID = "user-id"
+
+	var (
+		ctx    = context.TODO()
+		logger logr.Logger
+
+		ctrl                          *gomock.Controller
+		openstackClientFactoryFactory *mockopenstackclient.MockFactoryFactory
+		openstackClientFactory        *mockopenstackclient.MockFactory
+		identityClient                *mockopenstackclient.MockIdentity
+
+		c        client.Client
+		a        *actuator
+		cp       *extensionsv1alpha1.ControlPlane
+		cluster  *extensionscontroller.Cluster
+		cpConfig *api.ControlPlaneConfig
+
+		ccmPrefix = "gardener/" + namespace + "/cloud-controller-manager/"
+	)
+
+	BeforeEach(func() {
+		logger = log.Log.WithName("test")
+
+		ctrl = gomock.NewController(GinkgoT())
+		openstackClientFactoryFactory = mockopenstackclient.NewMockFactoryFactory(ctrl)
+		openstackClientFactory = mockopenstackclient.NewMockFactory(ctrl)
+		identityClient = mockopenstackclient.NewMockIdentity(ctrl)
+
+		c = fakeclient.NewClientBuilder().WithObjects(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+			Data: map[string][]byte{
+				openstack.DomainName: []byte("domain"),
+				openstack.TenantName: []byte("tenant"),
+				openstack.UserName:   []byte("user"),
+				openstack.Password:   []byte("password"),
+				openstack.AuthURL:    []byte("https://keystone"),
+			},
+		}).Build()
+		a = NewActuator(test.FakeManager{Client: c}, nil, openstackClientFactoryFactory, nil).(*actuator)
+
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+				Region:    "europe",
+			},
+		}
+		cluster = &extensionscontroller.Cluster{}
+		cpConfig = &api.ControlPlaneConfig{ApplicationCredentials: &api.ApplicationCredentials{}}
+	})
+
+	expectIdentityClient := func() {
+		openstackClientFactoryFactory.EXPECT().NewFactory(ctx, gomock.Any()).Return(openstackClientFactory, nil)
+		openstackClientFactory.EXPECT().Identity(gomock.Any()).Return(identityClient, nil)
+		identityClient.EXPECT().GetCurrentUserID(ctx).Return(userID, nil)
+	}
+
+	createSecret := func(component, id, previousID string) {
+		data := map[string][]byte{
+			openstack.ApplicationCredentialID:     []byte(id),
+			openstack.ApplicationCredentialSecret: []byte("secret"),
+		}
+		if previousID != "" {
+			data[previousApplicationCredentialID] = []byte(previousID)
+		}
+		Expect(c.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "application-credential-" + component, Namespace: namespace},
+			Data:       data,
+		})).To(Succeed())
+	}
+
+	Describe("#applicationCredentialComponents", func() {
+		It("should return no components if application credentials are disabled", func() {
+			Expect(applicationCredentialComponents(&api.ControlPlaneConfig{})).To(BeEmpty())
+		})
+
+		It("should only return the Manila CSI driver if it is enabled", func() {
+			Expect(applicationCredentialComponents(cpConfig)).To(ConsistOf("cloud-controller-manager", "csi-driver-cinder"))
+
+			cpConfig.Storage = &api.Storage{CSIManila: &api.CSIManila{Enabled: true}}
+			Expect(applicationCredentialComponents(cpConfig)).To(ConsistOf("cloud-controller-manager", "csi-driver-cinder", "csi-driver-manila"))
+		})
+
+		It("should always return the Barbican KMS plugin if KMS is configured", func() {
+			Expect(applicationCredentialComponents(&api.ControlPlaneConfig{KMS: &api.KMS{KeyID: "key"}})).To(ConsistOf("barbican-kms-plugin"))
+
+			cpConfig.KMS = &api.KMS{KeyID: "key"}
+			Expect(applicationCredentialComponents(cpConfig)).To(ConsistOf("cloud-controller-manager", "csi-driver-cinder", "barbican-kms-plugin"))
+		})
+	})
+
+	Describe("#reconcileApplicationCredentials", func() {
+		It("should create the application credential and delete stale ones", func() {
+			expectIdentityClient()
+			identityClient.EXPECT().ListApplicationCredentials(ctx, userID).Return([]applicationcredentials.ApplicationCredential{
+				{ID: "stale", Name: ccmPrefix + "1"},
+				{ID: "foreign", Name: "foreign"},
+			}, nil)
+			identityClient.EXPECT().CreateApplicationCredential(ctx, userID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, opts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+				Expect(opts.Name).To(HavePrefix(ccmPrefix))
+				Expect(opts.Unrestricted).To(BeFalse())
+				Expect(opts.AccessRules).To(ContainElement(applicationcredentials.AccessRule{Service: "load-balancer", Method: "POST", Path: "/**"}))
+				Expect(*opts.ExpiresAt).To(BeTemporally("~", time.Now().Add(60*24*time.Hour), time.Minute))
+				return &applicationcredentials.ApplicationCredential{ID: "new", Secret: "new-secret"}, nil
+			})
+			identityClient.EXPECT().DeleteApplicationCredential(ctx, userID, "stale")
+
+			Expect(a.reconcileApplicationCredentials(ctx, logger, cp, cluster, cpConfig, []string{"cloud-controller-manager"})).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "application-credential-cloud-controller-manager"}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				openstack.ApplicationCredentialID:     []byte("new"),
+				openstack.ApplicationCredentialSecret: []byte("new-secret"),
+			}))
+		})
+
+		It("should keep the current and the previous application credential if no rotation is due", func() {
+			createSecret("cloud-controller-manager", "current", "previous")
+
+			expectIdentityClient()
+			identityClient.EXPECT().ListApplicationCredentials(ctx, userID).Return([]applicationcredentials.ApplicationCredential{
+				{ID: "current", Name: ccmPrefix + "3", ExpiresAt: time.Now().Add(45 * 24 * time.Hour)},
+				{ID: "previous", Name: ccmPrefix + "2", ExpiresAt: time.Now().Add(15 * 24 * time.Hour)},
+				{ID: "stale", Name: ccmPrefix + "1"},
+			}, nil)
+			identityClient.EXPECT().DeleteApplicationCredential(ctx, userID, "stale")
+
+			Expect(a.reconcileApplicationCredentials(ctx, logger, cp, cluster, cpConfig, []string{"cloud-controller-manager"})).To(Succeed())
+		})
+
+		It("should rotate the application credential after the rotation period", func() {
+			createSecret("cloud-controller-manager", "current", "previous")
+			cpConfig.ApplicationCredentials.RotationPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+
+			expectIdentityClient()
+			identityClient.EXPECT().ListApplicationCredentials(ctx, userID).Return([]applicationcredentials.ApplicationCredential{
+				{ID: "current", Name: ccmPrefix + "2", ExpiresAt: time.Now().Add(12 * time.Hour)},
+				{ID: "previous", Name: ccmPrefix + "1", ExpiresAt: time.Now().Add(-12 * time.Hour)},
+			}, nil)
+			identityClient.EXPECT().CreateApplicationCredential(ctx, userID, gomock.Any()).Return(&applicationcredentials.ApplicationCredential{ID: "new", Secret: "new-secret"}, nil)
+			identityClient.EXPECT().DeleteApplicationCredential(ctx, userID, "previous")
+
+			Expect(a.reconcileApplicationCredentials(ctx, logger, cp, cluster, cpConfig, []string{"cloud-controller-manager"})).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "application-credential-cloud-controller-manager"}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{
+				openstack.ApplicationCredentialID:     []byte("new"),
+				openstack.ApplicationCredentialSecret: []byte("new-secret"),
+				previousApplicationCredentialID:       []byte("current"),
+			}))
+		})
+
+		It("should only allow the Barbican KMS plugin to read the key of the shoot", func() {
+			cpConfig = &api.ControlPlaneConfig{KMS: &api.KMS{KeyID: "key"}}
+
+			expectIdentityClient()
+			identityClient.EXPECT().ListApplicationCredentials(ctx, userID).Return(nil, nil)
+			identityClient.EXPECT().CreateApplicationCredential(ctx, userID, gomock.Any()).DoAndReturn(func(_ context.Context, _ string, opts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+				Expect(opts.Name).To(HavePrefix("gardener/" + namespace + "/barbican-kms-plugin/"))
+				Expect(opts.Unrestricted).To(BeFalse())
+				Expect(opts.AccessRules).To(ConsistOf(
+					applicationcredentials.AccessRule{Service: "key-manager", Method: "GET", Path: "/v1/secrets/key"},
+					applicationcredentials.AccessRule{Service: "key-manager", Method: "GET", Path: "/v1/secrets/key/payload"},
+				))
+				Expect(*opts.ExpiresAt).To(BeTemporally("~", time.Now().Add(60*24*time.Hour), time.Minute))
+				return &applicationcredentials.ApplicationCredential{ID: "new", Secret: "new-secret"}, nil
+			})
+
+			Expect(a.reconcileApplicationCredentials(ctx, logger, cp, cluster, cpConfig, []string{"barbican-kms-plugin"})).To(Succeed())
+		})
+	})
+
+	Describe("#deleteApplicationCredentials", func() {
+		It("should not call Keystone if no application credential exists", func() {
+			Expect(a.deleteApplicationCredentials(ctx, logger, cp, cluster, allApplicationCredentialComponents)).To(Succeed())
+		})
+
+		It("should revoke all application credentials of the components and delete their secrets", func() {
+			createSecret("cloud-controller-manager", "current", "previous")
+
+			expectIdentityClient()
+			identityClient.EXPECT().ListApplicationCredentials(ctx, userID).Return([]applicationcredentials.ApplicationCredential{
+				{ID: "current", Name: ccmPrefix + "2"},
+				{ID: "previous", Name: ccmPrefix + "1"},
+				{ID: "cinder", Name: "gardener/" + namespace + "/csi-driver-cinder/1"},
+				{ID: "foreign", Name: "foreign"},
+			}, nil)
+			identityClient.EXPECT().DeleteApplicationCredential(ctx, userID, "current")
+			identityClient.EXPECT().DeleteApplicationCredential(ctx, userID, "previous")
+			identityClient.EXPECT().DeleteApplicationCredential(ctx, userID, "cinder")
+
+			Expect(a.deleteApplicationCredentials(ctx, logger, cp, cluster, allApplicationCredentialComponents)).To(Succeed())
+
+			err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "application-credential-cloud-controller-manager"}, &corev1.Secret{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
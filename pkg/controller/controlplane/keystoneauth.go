@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+// KeystoneAuthConfiguredConditionType is the type of the controlplane condition which reports whether k8s-keystone-auth
+// is configured as authentication and authorization webhook of the kube-apiserver.
+const KeystoneAuthConfiguredConditionType gardencorev1beta1.ConditionType = "KeystoneAuthConfigured"
+
+// updateKeystoneAuthCondition reports whether the kube-apiserver uses k8s-keystone-auth. Its webhook kubeconfig is
+// created when the kube-apiserver is deployed, but it needs the CA of the controlplane. Hence, the kube-apiserver of a
+// new shoot only uses the webhooks after it was deployed again.
+func (a *actuator) updateKeystoneAuthCondition(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cpConfig *api.ControlPlaneConfig) error {
+	patch := client.MergeFrom(cp.DeepCopy())
+
+	if cpConfig.KeystoneAuth == nil {
+		if v1beta1helper.GetCondition(cp.Status.Conditions, KeystoneAuthConfiguredConditionType) == nil {
+			return nil
+		}
+		cp.Status.Conditions = v1beta1helper.RemoveConditions(cp.Status.Conditions, KeystoneAuthConfiguredConditionType)
+		return a.client.Status().Patch(ctx, cp, patch)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: cp.Namespace, Name: v1beta1constants.DeploymentNameKubeAPIServer}, deployment); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("failed reading kube-apiserver deployment: %w", err)
+	}
+
+	var (
+		realClock = clock.RealClock{}
+		condition = v1beta1helper.GetOrInitConditionWithClock(realClock, cp.Status.Conditions, KeystoneAuthConfiguredConditionType)
+	)
+	if usesKeystoneAuth(deployment) {
+		condition = v1beta1helper.UpdatedConditionWithClock(realClock, condition, gardencorev1beta1.ConditionTrue, "KeystoneAuthConfigured", "k8s-keystone-auth is configured as authentication and authorization webhook of the kube-apiserver.")
+	} else {
+		condition = v1beta1helper.UpdatedConditionWithClock(realClock, condition, gardencorev1beta1.ConditionFalse, "KubeAPIServerNotConfigured", "k8s-keystone-auth is not configured as webhook of the kube-apiserver yet. It is configured when the kube-apiserver is deployed the next time.")
+	}
+	cp.Status.Conditions = v1beta1helper.MergeConditions(cp.Status.Conditions, condition)
+	return a.client.Status().Patch(ctx, cp, patch)
+}
+
+// usesKeystoneAuth returns true if the given kube-apiserver deployment mounts the k8s-keystone-auth webhook kubeconfig.
+func usesKeystoneAuth(deployment *appsv1.Deployment) bool {
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Secret != nil && volume.Secret.SecretName == openstack.K8sKeystoneAuthWebhookKubeconfigName {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+)
+
+var _ = Describe("KeystoneAuth", func() {
+	var (
+		ctx = context.TODO()
+
+		c        client.Client
+		a        *actuator
+		cp       *extensionsv1alpha1.ControlPlane
+		cpConfig *api.ControlPlaneConfig
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(appsv1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(cp).WithStatusSubresource(cp).Build()
+		a = NewActuator(test.FakeManager{Client: c}, nil, nil, nil).(*actuator)
+		cpConfig = &api.ControlPlaneConfig{KeystoneAuth: &api.KeystoneAuth{}}
+	})
+
+	keystoneAuthCondition := func() *gardencorev1beta1.Condition {
+		for _, condition := range cp.Status.Conditions {
+			if condition.Type == KeystoneAuthConfiguredConditionType {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	It("should report that the kube-apiserver does not use k8s-keystone-auth yet", func() {
+		Expect(a.updateKeystoneAuthCondition(ctx, cp, cpConfig)).To(Succeed())
+
+		Expect(keystoneAuthCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionFalse),
+			"Reason": Equal("KubeAPIServerNotConfigured"),
+		})))
+	})
+
+	It("should report that the kube-apiserver uses k8s-keystone-auth", func() {
+		Expect(c.Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: namespace},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Volumes: []corev1.Volume{{
+							Name: "k8s-keystone-auth-webhook-kubeconfig",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: "k8s-keystone-auth-webhook-kubeconfig"},
+							},
+						}},
+					},
+				},
+			},
+		})).To(Succeed())
+
+		Expect(a.updateKeystoneAuthCondition(ctx, cp, cpConfig)).To(Succeed())
+
+		Expect(keystoneAuthCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Status": Equal(gardencorev1beta1.ConditionTrue),
+			"Reason": Equal("KeystoneAuthConfigured"),
+		})))
+	})
+
+	It("should remove the condition if k8s-keystone-auth is disabled", func() {
+		Expect(a.updateKeystoneAuthCondition(ctx, cp, cpConfig)).To(Succeed())
+		Expect(keystoneAuthCondition()).NotTo(BeNil())
+
+		Expect(a.updateKeystoneAuthCondition(ctx, cp, &api.ControlPlaneConfig{})).To(Succeed())
+		Expect(keystoneAuthCondition()).To(BeNil())
+	})
+})
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiserverconfigv1 "k8s.io/apiserver/pkg/apis/apiserver/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const kmsProviderName = "barbican"
+
+// reconcileKMS creates the config of the Barbican KMS plugin and the etcd encryption configuration of the kube-apiserver
+// with the plugin, which are referenced by the kube-apiserver webhook. The etcd encryption configuration is derived
+// from the one of gardenlet which is mounted by the kube-apiserver, hence the webhook wires the plugin when the
+// kube-apiserver is deployed after the first reconciliation of the controlplane.
+func (a *actuator) reconcileKMS(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster, cpConfig *api.ControlPlaneConfig) error {
+	if cpConfig.KMS == nil {
+		return nil
+	}
+
+	credentials, err := a.getCredentials(ctx, cp, cluster)
+	if err != nil {
+		return err
+	}
+	credentials, err = applicationCredential(ctx, a.client, cp.Namespace, credentials, applicationCredentialBarbicanKMSPlugin)
+	if err != nil {
+		return err
+	}
+
+	pluginConfig := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: openstack.BarbicanKMSPluginConfigName, Namespace: cp.Namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, a.client, pluginConfig, func() error {
+		pluginConfig.Type = corev1.SecretTypeOpaque
+		pluginConfig.Data = map[string][]byte{
+			openstack.CloudProviderConfigDataKey: kmsPluginConfig(credentials, cp.Spec.Region, cpConfig.KMS.KeyID),
+		}
+		if len(credentials.CACert) > 0 {
+			pluginConfig.Data[openstack.BarbicanKMSPluginCACertDataKey] = []byte(credentials.CACert)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed ensuring Barbican KMS plugin config: %w", err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: cp.Namespace, Name: v1beta1constants.DeploymentNameKubeAPIServer}, deployment); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed reading kube-apiserver deployment: %w", err)
+	}
+
+	var encryptionConfigSecretName string
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name == openstack.EtcdEncryptionConfigVolumeName && volume.Secret != nil {
+			encryptionConfigSecretName = volume.Secret.SecretName
+		}
+	}
+	if encryptionConfigSecretName == "" {
+		return fmt.Errorf("kube-apiserver deployment has no volume %q with the etcd encryption configuration", openstack.EtcdEncryptionConfigVolumeName)
+	}
+
+	encryptionConfigSecret := &corev1.Secret{}
+	if err := a.client.Get(ctx, client.ObjectKey{Namespace: cp.Namespace, Name: encryptionConfigSecretName}, encryptionConfigSecret); err != nil {
+		return fmt.Errorf("failed reading etcd encryption configuration: %w", err)
+	}
+	return a.ensureKMSEncryptionConfigSecret(ctx, encryptionConfigSecret)
+}
+
+// ensureKMSEncryptionConfigSecret creates an immutable copy of the etcd encryption configuration of gardenlet in which
+// the Barbican KMS plugin is prepended to the providers of all encrypted resources. The copy is labeled with the name
+// of the secret it is derived from, so that the kube-apiserver webhook can find it.
+func (a *actuator) ensureKMSEncryptionConfigSecret(ctx context.Context, encryptionConfigSecret *corev1.Secret) error {
+	encryptionConfig := &apiserverconfigv1.EncryptionConfiguration{}
+	if err := yaml.Unmarshal(encryptionConfigSecret.Data[openstack.EtcdEncryptionConfigDataKey], encryptionConfig); err != nil {
+		return fmt.Errorf("could not decode etcd encryption configuration: %w", err)
+	}
+
+	for i, r := range encryptionConfig.Resources {
+		// Resources whose first provider is identity are not encrypted anymore and are only being decrypted.
+		if len(r.Providers) > 0 && (r.Providers[0].Identity != nil || r.Providers[0].KMS != nil) {
+			continue
+		}
+		encryptionConfig.Resources[i].Providers = append([]apiserverconfigv1.ProviderConfiguration{{
+			KMS: &apiserverconfigv1.KMSConfiguration{
+				APIVersion: "v2",
+				Name:       kmsProviderName,
+				Endpoint:   "unix://" + filepath.Join(openstack.BarbicanKMSPluginSocketMountPath, openstack.BarbicanKMSPluginSocketName),
+			},
+		}}, r.Providers...)
+	}
+
+	data, err := yaml.Marshal(encryptionConfig)
+	if err != nil {
+		return err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      openstack.EtcdEncryptionConfigKMSSecretName,
+			Namespace: encryptionConfigSecret.Namespace,
+			Labels:    map[string]string{openstack.LabelEtcdEncryptionConfig: encryptionConfigSecret.Name},
+		},
+		Data: map[string][]byte{openstack.EtcdEncryptionConfigDataKey: data},
+	}
+	if err := kubernetesutils.MakeUnique(secret); err != nil {
+		return err
+	}
+
+	if err := a.client.Create(ctx, secret); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed creating etcd encryption configuration with Barbican KMS plugin: %w", err)
+	}
+	return nil
+}
+
+// kmsPluginConfig renders the cloud config of the Barbican KMS plugin in the same format as the config of the
+// cloud-controller-manager.
+func kmsPluginConfig(credentials *openstack.Credentials, region, keyID string) []byte {
+	var b strings.Builder
+
+	b.WriteString("[Global]\n")
+	fmt.Fprintf(&b, `auth-url="%s"`+"\n", credentials.AuthURL)
+	fmt.Fprintf(&b, `domain-name="%s"`+"\n", credentials.DomainName)
+	fmt.Fprintf(&b, `tenant-name="%s"`+"\n", credentials.TenantName)
+	fmt.Fprintf(&b, `username="%s"`+"\n", credentials.Username)
+	if credentials.Password != "" {
+		fmt.Fprintf(&b, `password="%s"`+"\n", credentials.Password)
+	}
+	if credentials.ApplicationCredentialSecret != "" {
+		fmt.Fprintf(&b, `application-credential-id="%s"`+"\n", credentials.ApplicationCredentialID)
+		fmt.Fprintf(&b, `application-credential-name="%s"`+"\n", credentials.ApplicationCredentialName)
+		fmt.Fprintf(&b, `application-credential-secret="%s"`+"\n", credentials.ApplicationCredentialSecret)
+	}
+	fmt.Fprintf(&b, `region="%s"`+"\n", region)
+	if credentials.Insecure {
+		b.WriteString("tls-insecure=true\n")
+	}
+	if len(credentials.CACert) > 0 {
+		fmt.Fprintf(&b, `ca-file="%s"`+"\n", filepath.Join(openstack.BarbicanKMSPluginConfigMountPath, openstack.BarbicanKMSPluginCACertDataKey))
+	}
+
+	b.WriteString("\n[KeyManager]\n")
+	fmt.Fprintf(&b, `key-id="%s"`+"\n", keyID)
+
+	return []byte(b.String())
+}
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+var _ = Describe("KMS", func() {
+	var (
+		ctx = context.TODO()
+
+		c        client.Client
+		a        *actuator
+		cp       *extensionsv1alpha1.ControlPlane
+		cluster  *extensionscontroller.Cluster
+		cpConfig *api.ControlPlaneConfig
+
+		encryptionConfigSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+				Data: map[string][]byte{
+					openstack.DomainName: []byte("domain"),
+					openstack.TenantName: []byte("tenant"),
+					openstack.UserName:   []byte("user"),
+					openstack.Password:   []byte("password"),
+					openstack.AuthURL:    []byte("https://keystone"),
+					openstack.CACert:     []byte("ca"),
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "application-credential-barbican-kms-plugin", Namespace: namespace},
+				Data: map[string][]byte{
+					openstack.ApplicationCredentialID:     []byte("app-id"),
+					openstack.ApplicationCredentialSecret: []byte("app-secret"),
+				},
+			},
+		).Build()
+		a = NewActuator(test.FakeManager{Client: c}, nil, nil, nil).(*actuator)
+
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace},
+			Spec: extensionsv1alpha1.ControlPlaneSpec{
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+				Region:    "eu-1",
+			},
+		}
+		cluster = &extensionscontroller.Cluster{}
+		cpConfig = &api.ControlPlaneConfig{KMS: &api.KMS{KeyID: "123e4567-e89b-12d3-a456-426614174000"}}
+
+		encryptionConfigSecret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "kube-apiserver-etcd-encryption-configuration-abcd1234"},
+			Data: map[string][]byte{"encryption-configuration.yaml": []byte(`apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - aescbc:
+      keys:
+      - name: key-1
+        secret: c2VjcmV0
+  - identity: {}
+  resources:
+  - secrets
+- providers:
+  - identity: {}
+  - aescbc:
+      keys:
+      - name: key-1
+        secret: c2VjcmV0
+  resources:
+  - configmaps
+`)},
+		}
+	})
+
+	It("should do nothing if KMS is not configured", func() {
+		Expect(a.reconcileKMS(ctx, cp, cluster, &api.ControlPlaneConfig{})).To(Succeed())
+
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "barbican-kms-plugin-config"}, &corev1.Secret{})).NotTo(Succeed())
+	})
+
+	It("should create the plugin config with the application credential of the plugin", func() {
+		Expect(a.reconcileKMS(ctx, cp, cluster, cpConfig)).To(Succeed())
+
+		pluginConfig := &corev1.Secret{}
+		Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "barbican-kms-plugin-config"}, pluginConfig)).To(Succeed())
+		Expect(pluginConfig.Data).To(Equal(map[string][]byte{
+			"cloudprovider.conf": []byte(`[Global]
+auth-url="https://keystone"
+domain-name="domain"
+tenant-name="tenant"
+username=""
+application-credential-id="app-id"
+application-credential-name=""
+application-credential-secret="app-secret"
+region="eu-1"
+ca-file="/etc/kubernetes/cloudprovider/keystone-ca.crt"
+
+[KeyManager]
+key-id="123e4567-e89b-12d3-a456-426614174000"
+`),
+			"keystone-ca.crt": []byte("ca"),
+		}))
+	})
+
+	It("should fail if the application credential of the plugin does not exist", func() {
+		Expect(c.Delete(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "application-credential-barbican-kms-plugin", Namespace: namespace}})).To(Succeed())
+
+		Expect(a.reconcileKMS(ctx, cp, cluster, cpConfig)).To(MatchError(ContainSubstring("could not read application credential of barbican-kms-plugin")))
+	})
+
+	It("should derive the etcd encryption configuration with the plugin from the one of the kube-apiserver", func() {
+		Expect(c.Create(ctx, encryptionConfigSecret)).To(Succeed())
+		Expect(c.Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "kube-apiserver"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Volumes: []corev1.Volume{{
+							Name: "etcd-encryption-secret",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: encryptionConfigSecret.Name},
+							},
+						}},
+					},
+				},
+			},
+		})).To(Succeed())
+
+		Expect(a.reconcileKMS(ctx, cp, cluster, cpConfig)).To(Succeed())
+
+		secrets := &corev1.SecretList{}
+		Expect(c.List(ctx, secrets, client.InNamespace(namespace), client.MatchingLabels{
+			"openstack.provider.extensions.gardener.cloud/etcd-encryption-configuration": encryptionConfigSecret.Name,
+		})).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(1))
+		Expect(secrets.Items[0].Name).To(HavePrefix("kube-apiserver-etcd-encryption-configuration-kms-"))
+		Expect(secrets.Items[0].Immutable).To(Equal(ptr.To(true)))
+		Expect(string(secrets.Items[0].Data["encryption-configuration.yaml"])).To(Equal(`apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/barbican-kms-plugin/kms.sock
+      name: barbican
+  - aescbc:
+      keys:
+      - name: key-1
+        secret: c2VjcmV0
+  - identity: {}
+  resources:
+  - secrets
+- providers:
+  - identity: {}
+  - aescbc:
+      keys:
+      - name: key-1
+        secret: c2VjcmV0
+  resources:
+  - configmaps
+`))
+
+		// A second reconciliation must not create another copy.
+		Expect(a.reconcileKMS(ctx, cp, cluster, cpConfig)).To(Succeed())
+		Expect(c.List(ctx, secrets, client.InNamespace(namespace), client.MatchingLabels{
+			"openstack.provider.extensions.gardener.cloud/etcd-encryption-configuration": encryptionConfigSecret.Name,
+		})).To(Succeed())
+		Expect(secrets.Items).To(HaveLen(1))
+	})
+})
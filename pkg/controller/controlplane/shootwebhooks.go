@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/controlplane/genericactuator"
+	"github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsshootwebhook "github.com/gardener/gardener/extensions/pkg/webhook/shoot"
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	"k8s.io/utils/ptr"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/webhook/service"
+)
+
+// reconcileShootWebhooks deploys the webhooks of the extension into the shoot instead of the generic actuator, as they
+// are only needed for shoots with load balancer classes or a load balancer policy. They fail closed if a load balancer
+// policy is configured, so that it cannot be bypassed while the webhook server is not available.
+func (a *actuator) reconcileShootWebhooks(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster, cpConfig *api.ControlPlaneConfig) error {
+	if !a.hasShootWebhooks(cluster) {
+		return nil
+	}
+
+	required, err := service.HasLoadBalancerClassesOrPolicy(cluster)
+	if err != nil {
+		return err
+	}
+	if !required {
+		return a.deleteShootWebhooks(ctx, cp, cluster)
+	}
+
+	webhookConfigs, ok := a.shootWebhookConfig.Load().(*webhook.Configs)
+	if !ok {
+		return fmt.Errorf("expected *webhook.Configs, got %T", a.shootWebhookConfig.Load())
+	}
+	webhookConfigs = webhookConfigs.DeepCopy()
+	if cpConfig.LoadBalancerPolicy == nil {
+		setShootWebhooksFailurePolicy(webhookConfigs, admissionregistrationv1.Ignore)
+	}
+
+	if err := extensionsshootwebhook.ReconcileWebhookConfig(ctx, a.client, cp.Namespace, genericactuator.ShootWebhooksResourceName, *webhookConfigs, cluster, true); err != nil {
+		return fmt.Errorf("could not reconcile shoot webhooks: %w", err)
+	}
+	// The gardener-resource-manager of hibernated shoots is scaled down, hence the managed resource does not become
+	// healthy during the hibernation.
+	if !extensionscontroller.IsHibernationEnabled(cluster) {
+		if err := managedresources.WaitUntilHealthyAndNotProgressing(ctx, a.client, cp.Namespace, genericactuator.ShootWebhooksResourceName); err != nil {
+			return fmt.Errorf("could not wait for shoot webhooks to be healthy: %w", err)
+		}
+	}
+	return nil
+}
+
+// deleteShootWebhooks deletes the webhooks of the extension from the shoot.
+func (a *actuator) deleteShootWebhooks(ctx context.Context, cp *extensionsv1alpha1.ControlPlane, cluster *extensionscontroller.Cluster) error {
+	if !a.hasShootWebhooks(cluster) {
+		return nil
+	}
+
+	if err := managedresources.Delete(ctx, a.client, cp.Namespace, genericactuator.ShootWebhooksResourceName, false); err != nil {
+		return fmt.Errorf("could not delete managed resource containing shoot webhooks: %w", err)
+	}
+	if v1beta1helper.ShootNeedsForceDeletion(cluster.Shoot) {
+		return nil
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	if err := managedresources.WaitUntilDeleted(timeoutCtx, a.client, cp.Namespace, genericactuator.ShootWebhooksResourceName); err != nil {
+		return fmt.Errorf("error while waiting for managed resource containing shoot webhooks to be deleted: %w", err)
+	}
+	return nil
+}
+
+func (a *actuator) hasShootWebhooks(cluster *extensionscontroller.Cluster) bool {
+	return a.shootWebhookConfig != nil && !v1beta1helper.IsShootSelfHosted(cluster.Shoot.Spec.Provider.Workers)
+}
+
+// setShootWebhooksFailurePolicy sets the failure policy of all given webhooks. All shoot webhooks of the extension are
+// service webhooks.
+func setShootWebhooksFailurePolicy(webhookConfigs *webhook.Configs, failurePolicy admissionregistrationv1.FailurePolicyType) {
+	if webhookConfigs.MutatingWebhookConfig != nil {
+		for i := range webhookConfigs.MutatingWebhookConfig.Webhooks {
+			webhookConfigs.MutatingWebhookConfig.Webhooks[i].FailurePolicy = ptr.To(failurePolicy)
+		}
+	}
+	if webhookConfigs.ValidatingWebhookConfig != nil {
+		for i := range webhookConfigs.ValidatingWebhookConfig.Webhooks {
+			webhookConfigs.ValidatingWebhookConfig.Webhooks[i].FailurePolicy = ptr.To(failurePolicy)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"sync/atomic"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	openstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
+)
+
+var _ = Describe("ShootWebhooks", func() {
+	var (
+		ctx = context.TODO()
+
+		c        client.Client
+		a        *actuator
+		cp       *extensionsv1alpha1.ControlPlane
+		cluster  *extensionscontroller.Cluster
+		cpConfig *api.ControlPlaneConfig
+
+		setControlPlaneConfig func(*openstackv1alpha1.ControlPlaneConfig)
+		webhookConfigs        func() *admissionregistrationv1.ValidatingWebhookConfiguration
+	)
+
+	BeforeEach(func() {
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(admissionregistrationv1.AddToScheme(scheme)).To(Succeed())
+		Expect(resourcesv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		shootWebhookConfig := &atomic.Value{}
+		shootWebhookConfig.Store(&webhook.Configs{
+			ValidatingWebhookConfig: &admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: "gardener-extension-provider-openstack-shoot"},
+				Webhooks: []admissionregistrationv1.ValidatingWebhook{{
+					Name:          "service-validator.openstack.extensions.gardener.cloud",
+					FailurePolicy: ptr.To(admissionregistrationv1.Fail),
+				}},
+			},
+		})
+
+		cp = &extensionsv1alpha1.ControlPlane{
+			ObjectMeta: metav1.ObjectMeta{Name: "control-plane", Namespace: namespace},
+		}
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).Build()
+		a = NewActuator(test.FakeManager{Client: c}, nil, nil, shootWebhookConfig).(*actuator)
+		cpConfig = &api.ControlPlaneConfig{}
+		cluster = &extensionscontroller.Cluster{
+			Shoot: &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Hibernation: &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)},
+					Provider: gardencorev1beta1.Provider{
+						InfrastructureConfig: &runtime.RawExtension{
+							Raw: encode(&openstackv1alpha1.InfrastructureConfig{
+								TypeMeta: metav1.TypeMeta{
+									APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+									Kind:       "InfrastructureConfig",
+								},
+								Networks: openstackv1alpha1.Networks{Workers: "10.200.0.0/19"},
+							}),
+						},
+					},
+				},
+			},
+		}
+
+		setControlPlaneConfig = func(config *openstackv1alpha1.ControlPlaneConfig) {
+			config.TypeMeta = metav1.TypeMeta{
+				APIVersion: openstackv1alpha1.SchemeGroupVersion.String(),
+				Kind:       "ControlPlaneConfig",
+			}
+			cluster.Shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: encode(config)}
+		}
+		webhookConfigs = func() *admissionregistrationv1.ValidatingWebhookConfiguration {
+			objects, err := managedresources.GetObjects(ctx, c, namespace, "extension-controlplane-shoot-webhooks")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(HaveLen(1))
+			Expect(objects[0]).To(BeAssignableToTypeOf(&admissionregistrationv1.ValidatingWebhookConfiguration{}))
+			return objects[0].(*admissionregistrationv1.ValidatingWebhookConfiguration)
+		}
+	})
+
+	It("should not register the webhooks for shoots without load balancer classes and policy", func() {
+		Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "extension-controlplane-shoot-webhooks"},
+		})).To(Succeed())
+
+		Expect(a.reconcileShootWebhooks(ctx, cp, cluster, cpConfig)).To(Succeed())
+
+		err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "extension-controlplane-shoot-webhooks"}, &resourcesv1alpha1.ManagedResource{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should register the webhooks with failure policy Ignore for shoots with load balancer classes", func() {
+		setControlPlaneConfig(&openstackv1alpha1.ControlPlaneConfig{
+			LoadBalancerClasses: []openstackv1alpha1.LoadBalancerClass{{Name: "default"}},
+		})
+
+		Expect(a.reconcileShootWebhooks(ctx, cp, cluster, cpConfig)).To(Succeed())
+
+		Expect(webhookConfigs().Webhooks).To(ConsistOf(HaveField("FailurePolicy", Equal(ptr.To(admissionregistrationv1.Ignore)))))
+	})
+
+	It("should register the webhooks with failure policy Fail for shoots with a load balancer policy", func() {
+		setControlPlaneConfig(&openstackv1alpha1.ControlPlaneConfig{
+			LoadBalancerPolicy: &openstackv1alpha1.LoadBalancerPolicy{},
+		})
+		cpConfig.LoadBalancerPolicy = &api.LoadBalancerPolicy{}
+
+		Expect(a.reconcileShootWebhooks(ctx, cp, cluster, cpConfig)).To(Succeed())
+
+		Expect(webhookConfigs().Webhooks).To(ConsistOf(HaveField("FailurePolicy", Equal(ptr.To(admissionregistrationv1.Fail)))))
+	})
+
+	It("should delete the webhooks with the control plane", func() {
+		setControlPlaneConfig(&openstackv1alpha1.ControlPlaneConfig{
+			LoadBalancerPolicy: &openstackv1alpha1.LoadBalancerPolicy{},
+		})
+		Expect(a.reconcileShootWebhooks(ctx, cp, cluster, cpConfig)).To(Succeed())
+
+		Expect(a.deleteShootWebhooks(ctx, cp, cluster)).To(Succeed())
+
+		err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "extension-controlplane-shoot-webhooks"}, &resourcesv1alpha1.ManagedResource{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
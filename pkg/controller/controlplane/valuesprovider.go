@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -42,6 +43,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/util/sets"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -54,8 +56,9 @@ import (
 )
 
 const (
-	caNameControlPlane               = "ca-" + openstack.Name + "-controlplane"
+	caNameControlPlane               = openstack.CAControlPlaneName
 	cloudControllerManagerServerName = openstack.CloudControllerManagerName + "-server"
+	k8sKeystoneAuthServerName        = openstack.K8sKeystoneAuthName + "-server"
 )
 
 func secretConfigsFunc(namespace string) []extensionssecretmanager.SecretConfigWithOptions {
@@ -78,6 +81,16 @@ func secretConfigsFunc(namespace string) []extensionssecretmanager.SecretConfigW
 			},
 			Options: []secretsmanager.GenerateOption{secretsmanager.SignedByCA(caNameControlPlane)},
 		},
+		{
+			Config: &secretutils.CertificateSecretConfig{
+				Name:                        k8sKeystoneAuthServerName,
+				CommonName:                  openstack.K8sKeystoneAuthName,
+				DNSNames:                    kutil.DNSNamesForService(openstack.K8sKeystoneAuthName, namespace),
+				CertType:                    secretutils.ServerCert,
+				SkipPublishingCACertificate: true,
+			},
+			Options: []secretsmanager.GenerateOption{secretsmanager.SignedByCA(caNameControlPlane)},
+		},
 	}
 }
 
@@ -150,6 +163,7 @@ var (
 				Images: []string{
 					openstack.CSIDriverManilaImageName,
 					openstack.CSIDriverNFSImageName,
+					openstack.CSIDriverCephFSImageName,
 					openstack.CSIProvisionerImageName,
 					openstack.CSISnapshotterImageName,
 					openstack.CSIResizerImageName,
@@ -161,6 +175,18 @@ var (
 					{Type: &vpaautoscalingv1.VerticalPodAutoscaler{}, Name: openstack.CSIDriverManilaController},
 				},
 			},
+			{
+				Name:   openstack.K8sKeystoneAuthName,
+				Images: []string{openstack.K8sKeystoneAuthImageName},
+				Objects: []*chart.Object{
+					{Type: &corev1.ConfigMap{}, Name: openstack.K8sKeystoneAuthName + "-config"},
+					{Type: &corev1.Secret{}, Name: openstack.K8sKeystoneAuthWebhookKubeconfigName},
+					{Type: &corev1.Service{}, Name: openstack.K8sKeystoneAuthName},
+					{Type: &appsv1.Deployment{}, Name: openstack.K8sKeystoneAuthName},
+					{Type: &policyv1.PodDisruptionBudget{}, Name: openstack.K8sKeystoneAuthName},
+					{Type: &vpaautoscalingv1.VerticalPodAutoscaler{}, Name: openstack.K8sKeystoneAuthName + "-vpa"},
+				},
+			},
 		},
 	}
 
@@ -223,6 +249,7 @@ var (
 				Images: []string{
 					openstack.CSIDriverManilaImageName,
 					openstack.CSIDriverNFSImageName,
+					openstack.CSIDriverCephFSImageName,
 					openstack.CSINodeDriverRegistrarImageName,
 					openstack.CSILivenessProbeImageName,
 				},
@@ -234,6 +261,11 @@ var (
 						Group:   "snapshot.storage.k8s.io",
 						Version: "v1",
 						Kind:    "VolumeSnapshotClass"}), Name: openstack.CSIManilaNFS},
+					{Type: &storagev1.CSIDriver{}, Name: openstack.CSIManilaStorageProvisionerCephFS},
+					{Type: makeUnstructured(schema.GroupVersionKind{
+						Group:   "snapshot.storage.k8s.io",
+						Version: "v1",
+						Kind:    "VolumeSnapshotClass"}), Name: openstack.CSIManilaCephFS},
 					// csi-provisioner/csi-snapshotter/csi-resizer share service account with CSI cinder driver
 					{Type: &rbacv1.Role{}, Name: openstack.UsernamePrefix + openstack.CSIManilaSecret},
 					{Type: &rbacv1.RoleBinding{}, Name: openstack.UsernamePrefix + openstack.CSIManilaSecret},
@@ -242,6 +274,9 @@ var (
 					{Type: &corev1.ServiceAccount{}, Name: openstack.CSIManilaNodeName},
 					{Type: &rbacv1.ClusterRole{}, Name: openstack.UsernamePrefix + openstack.CSIManilaNodeName},
 					{Type: &rbacv1.ClusterRoleBinding{}, Name: openstack.UsernamePrefix + openstack.CSIManilaNodeName},
+					// csi-driver-manila-cephfs-node
+					{Type: &appsv1.DaemonSet{}, Name: openstack.CSIManilaCephFSNodeName},
+					{Type: &corev1.ConfigMap{}, Name: openstack.CSIManilaCephFSNodeName},
 				},
 			},
 			{
@@ -325,11 +360,16 @@ func (vp *valuesProvider) GetConfigChartValues(
 		return nil, fmt.Errorf("could not get service account from secret '%s/%s': %w", cp.Spec.SecretRef.Namespace, cp.Spec.SecretRef.Name, err)
 	}
 
+	applicationCredentials, err := vp.getApplicationCredentials(ctx, cpConfig, cp, credentials)
+	if err != nil {
+		return nil, err
+	}
+
 	overlayEnabled, err := vp.isOverlayEnabled(cluster.Shoot.Spec.Networking)
 	if err != nil {
 		return nil, fmt.Errorf("could not determine overlay status: %v", err)
 	}
-	return getConfigChartValues(cpConfig, infraStatus, cloudProfileConfig, overlayEnabled, cp, credentials)
+	return getConfigChartValues(cpConfig, infraStatus, cloudProfileConfig, overlayEnabled, cp, credentials, applicationCredentials)
 }
 
 func (vp *valuesProvider) getInfrastructureStatus(cp *extensionsv1alpha1.ControlPlane) (*api.InfrastructureStatus, error) {
@@ -381,7 +421,12 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 	credentials, _ := vp.getCredentials(ctx, cp) // ignore missing credentials
 	userAgentHeaders = vp.getUserAgentHeaders(credentials, cluster)
 
-	return vp.getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, userAgentHeaders, checksums, scaledDown, credentials)
+	applicationCredentials, err := vp.getApplicationCredentials(ctx, cpConfig, cp, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	return vp.getControlPlaneChartValues(cpConfig, cp, cluster, secretsReader, userAgentHeaders, checksums, scaledDown, credentials, applicationCredentials)
 }
 
 // GetControlPlaneShootChartValues returns the values for the control plane shoot chart applied by the generic actuator.
@@ -419,9 +464,16 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(controlPlane), err)
 		}
 	}
-	values := make(map[string]interface{})
+	cpConfig := &api.ControlPlaneConfig{}
+	if controlPlane.Spec.ProviderConfig != nil {
+		if _, _, err := vp.decoder.Decode(controlPlane.Spec.ProviderConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode providerConfig of controlplane '%s': %w", k8sclient.ObjectKeyFromObject(controlPlane), err)
+		}
+	}
+
+	var storageclasses []map[string]interface{}
 	if len(providerConfig.StorageClasses) != 0 {
-		allSc := make([]map[string]interface{}, len(providerConfig.StorageClasses))
+		storageclasses = make([]map[string]interface{}, len(providerConfig.StorageClasses))
 		for i, sc := range providerConfig.StorageClasses {
 			var storageClassValues = map[string]interface{}{
 				"name": sc.Name,
@@ -454,28 +506,89 @@ func (vp *valuesProvider) GetStorageClassesChartValues(
 				storageClassValues["volumeBindingMode"] = sc.VolumeBindingMode
 			}
 
-			allSc[i] = storageClassValues
+			storageclasses[i] = storageClassValues
 		}
-		values["storageclasses"] = allSc
-		return values, nil
+	} else {
+		storageclasses = []map[string]interface{}{
+			{
+				"name":              "default",
+				"default":           true,
+				"provisioner":       openstack.CSIStorageProvisioner,
+				"volumeBindingMode": storagev1.VolumeBindingWaitForFirstConsumer,
+			},
+			{
+				"name":              "default-class",
+				"provisioner":       openstack.CSIStorageProvisioner,
+				"volumeBindingMode": storagev1.VolumeBindingWaitForFirstConsumer,
+			}}
 	}
 
-	storageclasses := []map[string]interface{}{
-		{
-			"name":              "default",
-			"default":           true,
-			"provisioner":       openstack.CSIStorageProvisioner,
-			"volumeBindingMode": storagev1.VolumeBindingWaitForFirstConsumer,
-		},
-		{
-			"name":              "default-class",
-			"provisioner":       openstack.CSIStorageProvisioner,
-			"volumeBindingMode": storagev1.VolumeBindingWaitForFirstConsumer,
-		}}
+	return map[string]interface{}{
+		"storageclasses": mergeShootStorageClasses(storageclasses, cpConfig.Storage, providerConfig.VolumeAZMappings, cluster.Shoot.Spec.Region),
+	}, nil
+}
 
-	values["storageclasses"] = storageclasses
+// mergeShootStorageClasses adds the Cinder storage classes defined for the shoot to the given storage classes or
+// replaces the ones with the same name. If a storage class of the shoot is the default storage class, no other storage
+// class is marked as default. The availability zones of the shoot are translated to the mapped Cinder availability zones.
+func mergeShootStorageClasses(storageclasses []map[string]interface{}, storage *api.Storage, volumeAZMappings []api.VolumeAZMapping, region string) []map[string]interface{} {
+	if storage == nil {
+		return storageclasses
+	}
 
-	return values, nil
+	for _, sc := range storage.StorageClasses {
+		if ptr.Deref(sc.Default, false) {
+			for _, storageClassValues := range storageclasses {
+				delete(storageClassValues, "default")
+			}
+		}
+	}
+
+	for _, sc := range storage.StorageClasses {
+		if ptr.Deref(sc.Backend, api.StorageBackendCinder) != api.StorageBackendCinder {
+			continue
+		}
+
+		parameters := map[string]string{}
+		if sc.VolumeType != nil {
+			parameters["type"] = *sc.VolumeType
+		}
+		if sc.AvailabilityZone != nil {
+			parameters["availability"] = helper.FindVolumeZone(volumeAZMappings, region, *sc.AvailabilityZone)
+		}
+		if sc.FSType != nil {
+			parameters["csi.storage.k8s.io/fstype"] = *sc.FSType
+		}
+		storageClassValues := shootStorageClassValues(sc, openstack.CSIStorageProvisioner, parameters)
+
+		index := slices.IndexFunc(storageclasses, func(values map[string]interface{}) bool { return values["name"] == sc.Name })
+		if index >= 0 {
+			storageclasses[index] = storageClassValues
+		} else {
+			storageclasses = append(storageclasses, storageClassValues)
+		}
+	}
+
+	return storageclasses
+}
+
+// shootStorageClassValues returns the chart values for a storage class defined for the shoot.
+func shootStorageClassValues(sc api.StorageClass, provisioner string, parameters map[string]string) map[string]interface{} {
+	storageClassValues := map[string]interface{}{
+		"name":              sc.Name,
+		"provisioner":       provisioner,
+		"volumeBindingMode": ptr.Deref(sc.VolumeBindingMode, string(storagev1.VolumeBindingWaitForFirstConsumer)),
+	}
+	if ptr.Deref(sc.Default, false) {
+		storageClassValues["default"] = true
+	}
+	if len(parameters) != 0 {
+		storageClassValues["parameters"] = parameters
+	}
+	if sc.ReclaimPolicy != nil {
+		storageClassValues["reclaimPolicy"] = *sc.ReclaimPolicy
+	}
+	return storageClassValues
 }
 
 func (vp *valuesProvider) getCredentials(ctx context.Context, cp *extensionsv1alpha1.ControlPlane) (*openstack.Credentials, error) {
@@ -514,6 +627,7 @@ func getConfigChartValues(
 	isUsingOverlay bool,
 	cp *extensionsv1alpha1.ControlPlane,
 	c *openstack.Credentials,
+	applicationCredentials map[string]*openstack.Credentials,
 ) (map[string]interface{}, error) {
 	subnet, err := helper.FindSubnetByPurpose(infraStatus.Networks.Subnets, api.PurposeNodes)
 	if err != nil {
@@ -554,6 +668,20 @@ func getConfigChartValues(
 		values["caCert"] = c.CACert
 	}
 
+	if len(applicationCredentials) > 0 {
+		applicationCredentialValues := map[string]interface{}{}
+		for component, applicationCredential := range applicationCredentials {
+			applicationCredentialValues[component] = map[string]interface{}{
+				"username":                    applicationCredential.Username,
+				"password":                    applicationCredential.Password,
+				"applicationCredentialID":     applicationCredential.ApplicationCredentialID,
+				"applicationCredentialName":   applicationCredential.ApplicationCredentialName,
+				"applicationCredentialSecret": applicationCredential.ApplicationCredentialSecret,
+			}
+		}
+		values["applicationCredentials"] = applicationCredentialValues
+	}
+
 	loadBalancerClassesFromCloudProfile := []api.LoadBalancerClass{}
 	if floatingPool, err := helper.FindFloatingPool(cloudProfileConfig.Constraints.FloatingPools, infraStatus.Networks.FloatingPool.Name, cp.Spec.Region, nil); err == nil {
 		loadBalancerClassesFromCloudProfile = floatingPool.LoadBalancerClasses
@@ -575,13 +703,21 @@ func getConfigChartValues(
 
 	// If a default LoadBalancerClass is provided then set its configuration for
 	// the global loadbalancer configuration in the cloudprovider config.
+	loadBalancerSettings := cpConfig.LoadBalancer
 	if defaultLoadBalancerClass := lookupLoadBalancerClass(loadBalancerClasses, api.DefaultLoadBalancerClass); defaultLoadBalancerClass != nil {
 		utils.SetStringValue(values, "floatingNetworkID", defaultLoadBalancerClass.FloatingNetworkID)
 		utils.SetStringValue(values, "floatingSubnetID", defaultLoadBalancerClass.FloatingSubnetID)
 		utils.SetStringValue(values, "floatingSubnetName", defaultLoadBalancerClass.FloatingSubnetName)
 		utils.SetStringValue(values, "floatingSubnetTags", defaultLoadBalancerClass.FloatingSubnetTags)
 		utils.SetStringValue(values, "subnetID", defaultLoadBalancerClass.SubnetID)
+		loadBalancerSettings = mergeLoadBalancerSettings(loadBalancerSettings, defaultLoadBalancerClass.Settings)
+	}
+
+	// The ovn provider only supports the SOURCE_IP_PORT method.
+	if cpConfig.LoadBalancerProvider == "ovn" {
+		values["lbMethod"] = api.LoadBalancerMethodSourceIPPort
 	}
+	setLoadBalancerSettingsValues(values, loadBalancerSettings)
 
 	// Check if there is a dedicated vpn LoadBalancerClass in the CloudProfile and
 	// add it to the list of available LoadBalancerClasses.
@@ -596,6 +732,91 @@ func getConfigChartValues(
 	return values, nil
 }
 
+// mergeLoadBalancerSettings returns the given load balancer settings, in which the fields set in the overrides take
+// precedence.
+func mergeLoadBalancerSettings(settings, overrides *api.LoadBalancerSettings) *api.LoadBalancerSettings {
+	if overrides == nil {
+		return settings
+	}
+	if settings == nil {
+		return overrides
+	}
+
+	merged := settings.DeepCopy()
+	if overrides.FlavorID != nil {
+		merged.FlavorID = overrides.FlavorID
+	}
+	if overrides.AvailabilityZone != nil {
+		merged.AvailabilityZone = overrides.AvailabilityZone
+	}
+	if overrides.Method != nil {
+		merged.Method = overrides.Method
+	}
+	if overrides.EnableIngressHostname != nil {
+		merged.EnableIngressHostname = overrides.EnableIngressHostname
+	}
+	if overrides.MaxSharedLB != nil {
+		merged.MaxSharedLB = overrides.MaxSharedLB
+	}
+	if monitor := overrides.Monitor; monitor != nil {
+		if merged.Monitor == nil {
+			merged.Monitor = &api.LoadBalancerMonitor{}
+		}
+		if monitor.Enabled != nil {
+			merged.Monitor.Enabled = monitor.Enabled
+		}
+		if monitor.Delay != nil {
+			merged.Monitor.Delay = monitor.Delay
+		}
+		if monitor.Timeout != nil {
+			merged.Monitor.Timeout = monitor.Timeout
+		}
+		if monitor.MaxRetries != nil {
+			merged.Monitor.MaxRetries = monitor.MaxRetries
+		}
+		if monitor.MaxRetriesDown != nil {
+			merged.Monitor.MaxRetriesDown = monitor.MaxRetriesDown
+		}
+	}
+	return merged
+}
+
+// setLoadBalancerSettingsValues sets the values of the global load balancer configuration of the cloud-controller-manager
+// for the given settings. Settings which are not set keep the defaults of the chart.
+func setLoadBalancerSettingsValues(values map[string]interface{}, settings *api.LoadBalancerSettings) {
+	if settings == nil {
+		return
+	}
+
+	utils.SetStringValue(values, "flavorID", settings.FlavorID)
+	utils.SetStringValue(values, "availabilityZone", settings.AvailabilityZone)
+	utils.SetStringValue(values, "lbMethod", settings.Method)
+	if settings.EnableIngressHostname != nil {
+		values["enableIngressHostname"] = *settings.EnableIngressHostname
+	}
+	if settings.MaxSharedLB != nil {
+		values["maxSharedLB"] = *settings.MaxSharedLB
+	}
+
+	if monitor := settings.Monitor; monitor != nil {
+		if monitor.Enabled != nil {
+			values["createMonitor"] = *monitor.Enabled
+		}
+		if monitor.Delay != nil {
+			values["monitorDelay"] = monitor.Delay.Duration.String()
+		}
+		if monitor.Timeout != nil {
+			values["monitorTimeout"] = monitor.Timeout.Duration.String()
+		}
+		if monitor.MaxRetries != nil {
+			values["monitorMaxRetries"] = *monitor.MaxRetries
+		}
+		if monitor.MaxRetriesDown != nil {
+			values["monitorMaxRetriesDown"] = *monitor.MaxRetriesDown
+		}
+	}
+}
+
 func generateLoadBalancerClassValues(lbClasses []api.LoadBalancerClass, infrastructureStatus *api.InfrastructureStatus) []map[string]interface{} {
 	loadBalancerClassValues := []map[string]interface{}{}
 
@@ -614,6 +835,40 @@ func generateLoadBalancerClassValues(lbClasses []api.LoadBalancerClass, infrastr
 		loadBalancerClassValues = append(loadBalancerClassValues, values)
 	}
 
+	// The cloud-controller-manager does not select the subnet of the VIP by the IP family of a service, hence
+	// dual-stack shoots get additional load balancer classes for IPv6 load balancers. Their members are always
+	// reached in the IPv6 node subnet.
+	ipv6Subnet, err := helper.FindSubnetByPurpose(infrastructureStatus.Networks.Subnets, api.PurposeNodesIPv6)
+	if err != nil {
+		return loadBalancerClassValues
+	}
+
+	hasIPv6Class := false
+	for _, lbClass := range lbClasses {
+		if lbClass.Name == api.IPv6LoadBalancerClass {
+			hasIPv6Class = true
+		}
+
+		name, ok := helper.IPv6LoadBalancerClassName(lbClass)
+		if !ok {
+			continue
+		}
+		loadBalancerClassValues = append(loadBalancerClassValues, map[string]interface{}{
+			"name":           name,
+			"subnetID":       ptr.Deref(lbClass.IPv6FloatingSubnetID, ptr.Deref(lbClass.IPv6SubnetID, "")),
+			"memberSubnetID": ipv6Subnet.ID,
+		})
+	}
+
+	// A load balancer class with the same name overrides the one for load balancers in the IPv6 node subnet.
+	if !hasIPv6Class {
+		loadBalancerClassValues = append(loadBalancerClassValues, map[string]interface{}{
+			"name":           api.IPv6LoadBalancerClass,
+			"subnetID":       ipv6Subnet.ID,
+			"memberSubnetID": ipv6Subnet.ID,
+		})
+	}
+
 	return loadBalancerClassValues
 }
 
@@ -663,6 +918,7 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 	checksums map[string]string,
 	scaledDown bool,
 	credentials *openstack.Credentials,
+	applicationCredentials map[string]*openstack.Credentials,
 ) (
 	map[string]interface{},
 	error,
@@ -674,7 +930,12 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 
 	csiCinder := getCSIControllerChartValues(cluster, userAgentHeaders, checksums, scaledDown)
 
-	csiManila, err := vp.getCSIManilaControllerChartValues(cpConfig, cp, cluster, userAgentHeaders, checksums, scaledDown, credentials)
+	csiManila, err := vp.getCSIManilaControllerChartValues(cpConfig, cp, cluster, userAgentHeaders, checksums, scaledDown, componentCredentials(credentials, applicationCredentials, applicationCredentialCSIDriverManila))
+	if err != nil {
+		return nil, err
+	}
+
+	keystoneAuth, err := getK8sKeystoneAuthChartValues(cpConfig, cluster, secretsReader, checksums, scaledDown, credentials)
 	if err != nil {
 		return nil, err
 	}
@@ -686,6 +947,7 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 		openstack.CloudControllerManagerName: ccm,
 		openstack.CSIControllerName:          csiCinder,
 		openstack.CSIManilaControllerName:    csiManila,
+		openstack.K8sKeystoneAuthName:        keystoneAuth,
 	}, nil
 }
 
@@ -784,6 +1046,60 @@ func (vp *valuesProvider) getCSIManilaControllerChartValues(
 		if err := vp.addCSIManilaValues(values, cp, cluster, credentials); err != nil {
 			return nil, err
 		}
+		values["cephfs"] = map[string]interface{}{
+			"enabled": cpConfig.Storage.CSIManila.CephFS != nil,
+		}
+		if storageclasses := manilaStorageClassValues(cpConfig.Storage); len(storageclasses) > 0 {
+			values["storageclasses"] = storageclasses
+		}
+	}
+
+	return values, nil
+}
+
+// getK8sKeystoneAuthChartValues collects and returns the k8s-keystone-auth chart values.
+func getK8sKeystoneAuthChartValues(
+	cpConfig *api.ControlPlaneConfig,
+	cluster *extensionscontroller.Cluster,
+	secretsReader secretsmanager.Reader,
+	checksums map[string]string,
+	scaledDown bool,
+	credentials *openstack.Credentials,
+) (map[string]interface{}, error) {
+	keystoneAuth := cpConfig.KeystoneAuth
+	values := map[string]interface{}{
+		"enabled": keystoneAuth != nil,
+	}
+	if keystoneAuth == nil {
+		return values, nil
+	}
+
+	if credentials == nil {
+		return nil, fmt.Errorf("credentials are required for the Keystone authentication")
+	}
+	serverSecret, found := secretsReader.Get(k8sKeystoneAuthServerName)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", k8sKeystoneAuthServerName)
+	}
+
+	roleMappings := make([]map[string]interface{}, 0, len(keystoneAuth.RoleMappings))
+	for _, roleMapping := range keystoneAuth.RoleMappings {
+		roleMappings = append(roleMappings, map[string]interface{}{
+			"keystoneRole": roleMapping.KeystoneRole,
+			"groups":       roleMapping.Groups,
+		})
+	}
+
+	values["replicas"] = extensionscontroller.GetControlPlaneReplicas(cluster, scaledDown, 1)
+	values["podAnnotations"] = map[string]interface{}{
+		"checksum/secret-" + openstack.CloudProviderConfigName: checksums[openstack.CloudProviderConfigName],
+	}
+	values["keystoneURL"] = credentials.AuthURL
+	values["keystoneCACert"] = len(credentials.CACert) > 0
+	values["roleMappings"] = roleMappings
+	values["authorizationPolicy"] = ptr.Deref(keystoneAuth.AuthorizationPolicy, "[]")
+	values["secrets"] = map[string]interface{}{
+		"server": serverSecret.Name,
 	}
 
 	return values, nil
@@ -818,8 +1134,17 @@ func (vp *valuesProvider) getControlPlaneShootChartValues(
 	if userAgentHeader != nil {
 		csiNodeDriverValues["userAgentHeaders"] = userAgentHeader
 	}
+	if volumeZones := helper.MappedVolumeZones(cloudProfileConfig.VolumeAZMappings, cluster.Shoot.Spec.Region); len(volumeZones) > 0 {
+		csiNodeDriverValues["volumeZones"] = volumeZones
+		csiNodeDriverValues["computeZones"] = helper.MappedComputeZones(cloudProfileConfig.VolumeAZMappings, cluster.Shoot.Spec.Region)
+	}
 
-	csiDriverManilaValues, err := vp.getControlPlaneShootChartCSIManilaValues(cpConfig, cp, cluster, credentials)
+	applicationCredentials, err := vp.getApplicationCredentials(ctx, cpConfig, cp, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	csiDriverManilaValues, err := vp.getControlPlaneShootChartCSIManilaValues(cpConfig, cp, cluster, componentCredentials(credentials, applicationCredentials, applicationCredentialCSIDriverManila))
 	if err != nil {
 		return nil, err
 	}
@@ -954,6 +1279,12 @@ func (vp *valuesProvider) getControlPlaneShootChartCSIManilaValues(
 		if err := vp.addCSIManilaValues(values, cp, cluster, credentials); err != nil {
 			return nil, err
 		}
+		values["cephfs"] = map[string]interface{}{
+			"enabled": cpConfig.Storage.CSIManila.CephFS != nil,
+		}
+		if storageclasses := manilaStorageClassValues(cpConfig.Storage); len(storageclasses) > 0 {
+			values["storageclasses"] = storageclasses
+		}
 	}
 
 	return values, nil
@@ -1028,6 +1359,42 @@ func (vp *valuesProvider) addCSIManilaValues(
 	return nil
 }
 
+// manilaStorageClassValues returns the chart values for the Manila storage classes defined for the shoot.
+func manilaStorageClassValues(storage *api.Storage) []map[string]interface{} {
+	var storageclasses []map[string]interface{}
+	if storage == nil {
+		return storageclasses
+	}
+
+	for _, sc := range storage.StorageClasses {
+		if ptr.Deref(sc.Backend, api.StorageBackendCinder) != api.StorageBackendManila {
+			continue
+		}
+
+		provisioner := openstack.CSIManilaStorageProvisionerNFS
+		parameters := map[string]string{"type": ptr.Deref(sc.VolumeType, "default")}
+		if sc.AvailabilityZone != nil {
+			parameters["availability"] = *sc.AvailabilityZone
+		}
+		if ptr.Deref(sc.ShareProtocol, api.ShareProtocolNFS) == api.ShareProtocolCephFS {
+			provisioner = openstack.CSIManilaStorageProvisionerCephFS
+			var mounter *string
+			if storage.CSIManila != nil && storage.CSIManila.CephFS != nil {
+				mounter = storage.CSIManila.CephFS.Mounter
+			}
+			parameters["cephfs-mounter"] = ptr.Deref(mounter, api.CephFSMounterKernel)
+		}
+
+		storageClassValues := shootStorageClassValues(sc, provisioner, parameters)
+		if sc.ShareNetworkID != nil {
+			storageClassValues["shareNetworkID"] = *sc.ShareNetworkID
+		}
+		storageclasses = append(storageclasses, storageClassValues)
+	}
+
+	return storageclasses
+}
+
 func (vp *valuesProvider) getAllWorkerPoolsZones(cluster *extensionscontroller.Cluster) []string {
 	zones := sets.NewString()
 	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
@@ -20,9 +20,11 @@ import (
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
@@ -465,6 +467,143 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values).To(Equal(expectedValues))
 		})
 
+		It("should return correct config chart values with load balancer classes for IPv6 load balancers", func() {
+			var (
+				floatingNetworkID = "fip1"
+				cp                = controlPlane(
+					floatingNetworkID,
+					&api.ControlPlaneConfig{
+						LoadBalancerProvider: "load-balancer-provider",
+						LoadBalancerClasses: []api.LoadBalancerClass{
+							{
+								Name:                 "public",
+								FloatingSubnetID:     ptr.To("fip-subnet-1"),
+								IPv6FloatingSubnetID: ptr.To("fip-subnet-ipv6"),
+							},
+							{
+								Name:         "internal",
+								SubnetID:     ptr.To("subnet-1"),
+								IPv6SubnetID: ptr.To("subnet-ipv6"),
+							},
+						},
+						CloudControllerManager: &api.CloudControllerManagerConfig{
+							FeatureGates: map[string]bool{
+								"SomeKubernetesFeature": true,
+							},
+						},
+					},
+					nil,
+				)
+
+				expectedValues = utils.MergeMaps(configChartValues, map[string]interface{}{
+					"floatingNetworkID": floatingNetworkID,
+					"floatingSubnetID":  "fip-subnet-1",
+					"floatingClasses": []map[string]interface{}{
+						{
+							"name":             "public",
+							"floatingSubnetID": "fip-subnet-1",
+						},
+						{
+							"name":     "internal",
+							"subnetID": "subnet-1",
+						},
+						{
+							"name":           "public-ipv6",
+							"subnetID":       "fip-subnet-ipv6",
+							"memberSubnetID": "subnet-nodes-ipv6",
+						},
+						{
+							"name":           "internal-ipv6",
+							"subnetID":       "subnet-ipv6",
+							"memberSubnetID": "subnet-nodes-ipv6",
+						},
+						{
+							"name":           "ipv6",
+							"subnetID":       "subnet-nodes-ipv6",
+							"memberSubnetID": "subnet-nodes-ipv6",
+						},
+					},
+				})
+			)
+
+			infraStatus := &api.InfrastructureStatus{}
+			Expect(json.Unmarshal(cp.Spec.InfrastructureProviderStatus.Raw, infraStatus)).To(Succeed())
+			infraStatus.Networks.Subnets = append(infraStatus.Networks.Subnets, api.Subnet{ID: "subnet-nodes-ipv6", Purpose: api.PurposeNodesIPv6, CIDR: "2001:db8::/64"})
+			cp.Spec.InfrastructureProviderStatus.Raw = encode(infraStatus)
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(expectedValues))
+		})
+
+		It("should return correct config chart values with load balancer settings", func() {
+			var (
+				floatingNetworkID = "fip1"
+				cp                = controlPlane(
+					floatingNetworkID,
+					&api.ControlPlaneConfig{
+						LoadBalancerProvider: "ovn",
+						LoadBalancer: &api.LoadBalancerSettings{
+							FlavorID:              ptr.To("flavor-1"),
+							AvailabilityZone:      ptr.To("az1"),
+							EnableIngressHostname: ptr.To(true),
+							MaxSharedLB:           ptr.To[int32](5),
+							Monitor: &api.LoadBalancerMonitor{
+								Enabled:    ptr.To(false),
+								Delay:      &metav1.Duration{Duration: 10 * time.Second},
+								MaxRetries: ptr.To[int32](3),
+							},
+						},
+						LoadBalancerClasses: []api.LoadBalancerClass{
+							{
+								Name:             "real-default",
+								FloatingSubnetID: ptr.To("fip-subnet-1"),
+								Purpose:          ptr.To("default"),
+								Settings: &api.LoadBalancerSettings{
+									FlavorID: ptr.To("flavor-2"),
+									Monitor: &api.LoadBalancerMonitor{
+										Enabled:        ptr.To(true),
+										MaxRetriesDown: ptr.To[int32](4),
+									},
+								},
+							},
+						},
+						CloudControllerManager: &api.CloudControllerManagerConfig{
+							FeatureGates: map[string]bool{
+								"SomeKubernetesFeature": true,
+							},
+						},
+					},
+					nil,
+				)
+
+				expectedValues = utils.MergeMaps(configChartValues, map[string]interface{}{
+					"lbProvider":            "ovn",
+					"lbMethod":              "SOURCE_IP_PORT",
+					"floatingNetworkID":     floatingNetworkID,
+					"floatingSubnetID":      "fip-subnet-1",
+					"flavorID":              "flavor-2",
+					"availabilityZone":      "az1",
+					"enableIngressHostname": true,
+					"maxSharedLB":           int32(5),
+					"createMonitor":         true,
+					"monitorDelay":          "10s",
+					"monitorMaxRetries":     int32(3),
+					"monitorMaxRetriesDown": int32(4),
+					"floatingClasses": []map[string]interface{}{
+						{
+							"name":             "real-default",
+							"floatingSubnetID": "fip-subnet-1",
+						},
+					},
+				})
+			)
+
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(expectedValues))
+		})
+
 		It("should return correct config chart values with application credentials", func() {
 			secret2 := cpSecret.DeepCopy()
 			secret2.Data = map[string][]byte{
@@ -509,6 +648,61 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(values).To(Equal(expectedValues))
 		})
+
+		It("should return correct config chart values with dedicated application credentials", func() {
+			cp := controlPlane(
+				"floating-network-id",
+				&api.ControlPlaneConfig{
+					LoadBalancerProvider:   "load-balancer-provider",
+					ApplicationCredentials: &api.ApplicationCredentials{},
+				},
+				nil,
+			)
+			for _, component := range []string{"cloud-controller-manager", "csi-driver-cinder"} {
+				Expect(c.Create(ctx, &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "application-credential-" + component, Namespace: namespace},
+					Data: map[string][]byte{
+						"applicationCredentialID":     []byte(component + "-id"),
+						"applicationCredentialSecret": []byte(component + "-secret"),
+					},
+				})).To(Succeed())
+			}
+
+			expectedValues := utils.MergeMaps(configChartValues, map[string]interface{}{
+				"applicationCredentials": map[string]interface{}{
+					"cloud-controller-manager": map[string]interface{}{
+						"username":                    "",
+						"password":                    "",
+						"applicationCredentialID":     "cloud-controller-manager-id",
+						"applicationCredentialName":   "",
+						"applicationCredentialSecret": "cloud-controller-manager-secret",
+					},
+					"csi-driver-cinder": map[string]interface{}{
+						"username":                    "",
+						"password":                    "",
+						"applicationCredentialID":     "csi-driver-cinder-id",
+						"applicationCredentialName":   "",
+						"applicationCredentialSecret": "csi-driver-cinder-secret",
+					},
+				},
+			})
+			values, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(expectedValues))
+		})
+
+		It("should fail if the dedicated application credentials do not exist yet", func() {
+			cp := controlPlane(
+				"floating-network-id",
+				&api.ControlPlaneConfig{
+					LoadBalancerProvider:   "load-balancer-provider",
+					ApplicationCredentials: &api.ApplicationCredentials{},
+				},
+				nil,
+			)
+			_, err := vp.GetConfigChartValues(ctx, cp, cluster)
+			Expect(err).To(MatchError(ContainSubstring("could not read application credential of cloud-controller-manager")))
+		})
 	})
 
 	Describe("#GetControlPlaneChartValues", func() {
@@ -570,6 +764,7 @@ var _ = Describe("ValuesProvider", func() {
 					},
 				}),
 				openstack.CSIManilaControllerName: enabledFalse,
+				openstack.K8sKeystoneAuthName:     enabledFalse,
 			}))
 		})
 
@@ -604,6 +799,9 @@ var _ = Describe("ValuesProvider", func() {
 					"csimanila": map[string]interface{}{
 						"clusterID": "test",
 					},
+					"cephfs": map[string]interface{}{
+						"enabled": false,
+					},
 					"openstack": map[string]interface{}{
 						"projectName":                 "tenant-name",
 						"userName":                    "username",
@@ -621,6 +819,43 @@ var _ = Describe("ValuesProvider", func() {
 						"caCert":                      "",
 					},
 				}),
+				openstack.K8sKeystoneAuthName: enabledFalse,
+			}))
+		})
+
+		It("should return correct control plane chart values if the Keystone authentication is enabled", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "k8s-keystone-auth-server", Namespace: namespace}})).To(Succeed())
+
+			cpKeystoneAuth := controlPlane(
+				"floating-network-id",
+				&api.ControlPlaneConfig{
+					LoadBalancerProvider: "load-balancer-provider",
+					KeystoneAuth: &api.KeystoneAuth{
+						RoleMappings: []api.KeystoneRoleMapping{
+							{KeystoneRole: "member", Groups: []string{"developers"}},
+						},
+					},
+				},
+				nil,
+			)
+
+			values, err := vp.GetControlPlaneChartValues(ctx, cpKeystoneAuth, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(HaveKeyWithValue(openstack.K8sKeystoneAuthName, map[string]interface{}{
+				"enabled":  true,
+				"replicas": 1,
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-" + openstack.CloudProviderConfigName: checksums[openstack.CloudProviderConfigName],
+				},
+				"keystoneURL":    authURL,
+				"keystoneCACert": false,
+				"roleMappings": []map[string]interface{}{
+					{"keystoneRole": "member", "groups": []string{"developers"}},
+				},
+				"authorizationPolicy": "[]",
+				"secrets": map[string]interface{}{
+					"server": "k8s-keystone-auth-server",
+				},
 			}))
 		})
 
@@ -688,6 +923,29 @@ var _ = Describe("ValuesProvider", func() {
 				}))
 			})
 
+			It("should return the mapped volume and compute zones of the shoot region", func() {
+				cloudProfileConfigWithMappings := cloudProfileConfig.DeepCopy()
+				cloudProfileConfigWithMappings.IgnoreVolumeAZ = nil
+				cloudProfileConfigWithMappings.VolumeAZMappings = []api.VolumeAZMapping{
+					{Region: "europe", ComputeZone: "zone1", VolumeZone: "cinder-b"},
+					{Region: "europe", ComputeZone: "zone2", VolumeZone: "cinder-a"},
+					{Region: "asia", ComputeZone: "zone1", VolumeZone: "cinder-c"},
+				}
+				clusterWithMappings := &extensionscontroller.Cluster{
+					ObjectMeta:   cluster.ObjectMeta,
+					CloudProfile: cluster.CloudProfile.DeepCopy(),
+					Seed:         cluster.Seed,
+					Shoot:        cluster.Shoot.DeepCopy(),
+				}
+				clusterWithMappings.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfigWithMappings)}
+				clusterWithMappings.Shoot.Spec.Region = "europe"
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cp, clusterWithMappings, fakeSecretsManager, map[string]string{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[openstack.CSINodeName]).To(HaveKeyWithValue("volumeZones", []string{"cinder-a", "cinder-b"}))
+				Expect(values[openstack.CSINodeName]).To(HaveKeyWithValue("computeZones", []string{"zone1", "zone2"}))
+			})
+
 			It("should return correct shoot control plane chart if CSI Manila is enabled", func() {
 				cpManila := defaultControlPlaneWithManila(true)
 				values, err := vp.GetControlPlaneShootChartValues(ctx, cpManila, cluster, fakeSecretsManager, map[string]string{})
@@ -703,6 +961,9 @@ var _ = Describe("ValuesProvider", func() {
 						"csimanila": map[string]interface{}{
 							"clusterID": "test",
 						},
+						"cephfs": map[string]interface{}{
+							"enabled": false,
+						},
 						"openstack": map[string]interface{}{
 							"projectName":                 "tenant-name",
 							"userName":                    "username",
@@ -723,6 +984,102 @@ var _ = Describe("ValuesProvider", func() {
 					"calico-mutating-admission-policy": enabledFalse,
 				}))
 			})
+
+			It("should pass the dedicated application credential to the Manila CSI driver", func() {
+				cpManila := controlPlane("floating-network-id", &api.ControlPlaneConfig{
+					LoadBalancerProvider:   "load-balancer-provider",
+					Storage:                &api.Storage{CSIManila: &api.CSIManila{Enabled: true}},
+					ApplicationCredentials: &api.ApplicationCredentials{},
+				}, &api.ShareNetworkStatus{ID: "1111-2222-3333-4444", Name: "sharenetwork"})
+				for _, component := range []string{"cloud-controller-manager", "csi-driver-cinder", "csi-driver-manila"} {
+					Expect(c.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: "application-credential-" + component, Namespace: namespace},
+						Data: map[string][]byte{
+							"applicationCredentialID":     []byte(component + "-id"),
+							"applicationCredentialSecret": []byte(component + "-secret"),
+						},
+					})).To(Succeed())
+				}
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cpManila, cluster, fakeSecretsManager, map[string]string{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[openstack.CSIDriverManila]).To(HaveKeyWithValue("openstack", MatchKeys(IgnoreExtras, Keys{
+					"userName":                    Equal(""),
+					"password":                    Equal(""),
+					"applicationCredentialID":     Equal("csi-driver-manila-id"),
+					"applicationCredentialName":   Equal(""),
+					"applicationCredentialSecret": Equal("csi-driver-manila-secret"),
+					"domainName":                  Equal("domain-name"),
+					"projectName":                 Equal("tenant-name"),
+				})))
+			})
+
+			It("should return the Manila storage classes defined for the shoot", func() {
+				cpManila := controlPlane("floating-network-id", &api.ControlPlaneConfig{
+					LoadBalancerProvider: "load-balancer-provider",
+					Storage: &api.Storage{
+						CSIManila: &api.CSIManila{Enabled: true},
+						StorageClasses: []api.StorageClass{
+							{
+								Name:       "encrypted",
+								VolumeType: ptr.To("luks"),
+							},
+							{
+								Name:             "shares",
+								Backend:          ptr.To("manila"),
+								VolumeType:       ptr.To("fast"),
+								AvailabilityZone: ptr.To("zone1"),
+								ReclaimPolicy:    ptr.To("Retain"),
+							},
+						},
+					},
+				}, &api.ShareNetworkStatus{ID: "1111-2222-3333-4444", Name: "sharenetwork"})
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cpManila, cluster, fakeSecretsManager, map[string]string{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[openstack.CSIDriverManila]).To(HaveKeyWithValue("storageclasses", []map[string]interface{}{
+					{
+						"name":              "shares",
+						"provisioner":       openstack.CSIManilaStorageProvisionerNFS,
+						"volumeBindingMode": "WaitForFirstConsumer",
+						"reclaimPolicy":     "Retain",
+						"parameters":        map[string]string{"type": "fast", "availability": "zone1"},
+					},
+				}))
+			})
+
+			It("should return the CephFS storage classes defined for the shoot", func() {
+				cpManila := controlPlane("floating-network-id", &api.ControlPlaneConfig{
+					LoadBalancerProvider: "load-balancer-provider",
+					Storage: &api.Storage{
+						CSIManila: &api.CSIManila{
+							Enabled: true,
+							CephFS:  &api.CSIManilaCephFS{Mounter: ptr.To("fuse")},
+						},
+						StorageClasses: []api.StorageClass{
+							{
+								Name:          "cephfs",
+								Backend:       ptr.To("manila"),
+								VolumeType:    ptr.To("cephfsnative"),
+								ShareProtocol: ptr.To("CEPHFS"),
+							},
+						},
+					},
+				}, &api.ShareNetworkStatus{ID: "1111-2222-3333-4444", Name: "sharenetwork"})
+
+				values, err := vp.GetControlPlaneShootChartValues(ctx, cpManila, cluster, fakeSecretsManager, map[string]string{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(values[openstack.CSIDriverManila]).To(HaveKeyWithValue("cephfs", map[string]interface{}{"enabled": true}))
+				Expect(values[openstack.CSIDriverManila]).To(HaveKeyWithValue("storageclasses", []map[string]interface{}{
+					{
+						"name":              "cephfs",
+						"provisioner":       openstack.CSIManilaStorageProvisionerCephFS,
+						"volumeBindingMode": "WaitForFirstConsumer",
+						"parameters":        map[string]string{"type": "cephfsnative", "cephfs-mounter": "fuse"},
+					},
+				}))
+			})
+
 			It("should fall back to Workers CIDR from infra config when nodes subnet CIDR is empty in infra status", func() {
 				// Simulate a shoot whose infrastructure status was written before the CIDR field
 				// was populated in the subnet status (pre-subnet-pool feature).
@@ -854,6 +1211,93 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values["storageclasses"].([]map[string]interface{})[0]["provisioner"]).To(Equal(openstack.CSIStorageProvisioner))
 			Expect(values["storageclasses"].([]map[string]interface{})[1]["provisioner"]).To(Equal(openstack.CSIStorageProvisioner))
 		})
+
+		It("should add and override storage classes defined for the shoot", func() {
+			cpStorage := controlPlane("floating-network-id", &api.ControlPlaneConfig{
+				Storage: &api.Storage{
+					CSIManila: &api.CSIManila{Enabled: true},
+					StorageClasses: []api.StorageClass{
+						{
+							Name:          "default-class",
+							VolumeType:    ptr.To("ssd"),
+							ReclaimPolicy: ptr.To("Retain"),
+						},
+						{
+							Name:              "encrypted",
+							Default:           ptr.To(true),
+							VolumeType:        ptr.To("luks"),
+							Encrypted:         ptr.To(true),
+							AvailabilityZone:  ptr.To("zone1"),
+							FSType:            ptr.To("xfs"),
+							VolumeBindingMode: ptr.To("Immediate"),
+						},
+						{
+							Name:       "shares",
+							Backend:    ptr.To("manila"),
+							VolumeType: ptr.To("fast"),
+						},
+					},
+				},
+			}, nil)
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cpStorage, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["storageclasses"]).To(Equal([]map[string]interface{}{
+				{
+					"name":              "default",
+					"provisioner":       openstack.CSIStorageProvisioner,
+					"volumeBindingMode": storagev1.VolumeBindingWaitForFirstConsumer,
+				},
+				{
+					"name":              "default-class",
+					"provisioner":       openstack.CSIStorageProvisioner,
+					"volumeBindingMode": "WaitForFirstConsumer",
+					"reclaimPolicy":     "Retain",
+					"parameters":        map[string]string{"type": "ssd"},
+				},
+				{
+					"name":              "encrypted",
+					"default":           true,
+					"provisioner":       openstack.CSIStorageProvisioner,
+					"volumeBindingMode": "Immediate",
+					"parameters": map[string]string{
+						"type":                      "luks",
+						"availability":              "zone1",
+						"csi.storage.k8s.io/fstype": "xfs",
+					},
+				},
+			}))
+		})
+
+		It("should use the mapped volume zone for the availability zone of shoot storage classes", func() {
+			cloudProfileConfigWithMappings := cloudProfileConfig.DeepCopy()
+			cloudProfileConfigWithMappings.VolumeAZMappings = []api.VolumeAZMapping{
+				{Region: "europe", ComputeZone: "zone1", VolumeZone: "cinder-1"},
+			}
+			clusterWithMappings := &extensionscontroller.Cluster{
+				ObjectMeta:   cluster.ObjectMeta,
+				CloudProfile: cluster.CloudProfile.DeepCopy(),
+				Seed:         cluster.Seed,
+				Shoot:        cluster.Shoot.DeepCopy(),
+			}
+			clusterWithMappings.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfigWithMappings)}
+			clusterWithMappings.Shoot.Spec.Region = "europe"
+			cpStorage := controlPlane("floating-network-id", &api.ControlPlaneConfig{
+				Storage: &api.Storage{
+					StorageClasses: []api.StorageClass{
+						{Name: "zone1", VolumeType: ptr.To("ssd"), AvailabilityZone: ptr.To("zone1")},
+						{Name: "zone2", VolumeType: ptr.To("ssd"), AvailabilityZone: ptr.To("zone2")},
+					},
+				},
+			}, nil)
+
+			values, err := vp.GetStorageClassesChartValues(ctx, cpStorage, clusterWithMappings)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["storageclasses"]).To(ContainElements(
+				HaveKeyWithValue("parameters", map[string]string{"type": "ssd", "availability": "cinder-1"}),
+				HaveKeyWithValue("parameters", map[string]string{"type": "ssd", "availability": "zone2"}),
+			))
+		})
 	})
 
 	Describe("#isMutatingAdmissionPolicyEnabled", func() {
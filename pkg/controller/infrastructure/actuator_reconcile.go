@@ -64,13 +64,19 @@ func (a *actuator) reconcile(ctx context.Context, log logr.Logger, infra *extens
 		return err
 	}
 
+	volumeTypeReferences, err := volumeTypeReferencesFromCluster(cluster)
+	if err != nil {
+		return err
+	}
+
 	fctx, err := infraflow.NewFlowContext(infraflow.Opts{
-		Client:         a.client,
-		ClientFactory:  clientFactory,
-		Cluster:        cluster,
-		Infrastructure: infra,
-		Log:            log,
-		State:          infraState,
+		Client:               a.client,
+		ClientFactory:        clientFactory,
+		Cluster:              cluster,
+		Infrastructure:       infra,
+		Log:                  log,
+		State:                infraState,
+		VolumeTypeReferences: encodeVolumeTypeReferences(volumeTypeReferences),
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create flow context: %w", err)
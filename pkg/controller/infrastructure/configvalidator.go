@@ -8,19 +8,34 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"strings"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 	openstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
 )
 
+const (
+	// volumeTypeAvailabilityZonesExtraSpec is the extra spec which restricts a volume type to availability zones.
+	volumeTypeAvailabilityZonesExtraSpec = "RESKEY:availability_zones"
+	// noVolumeTypeReferences is recorded in the infrastructure state if the shoot does not reference any volume types.
+	noVolumeTypeReferences = "none"
+)
+
 // configValidator implements ConfigValidator for openstack infrastructure resources.
 type configValidator struct {
 	client               client.Client
@@ -71,6 +86,42 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 	logger.Info("Validating infrastructure configuration")
 	allErrs = append(allErrs, c.validateFloatingPoolName(ctx, networkingClient, config.FloatingPoolName, field.NewPath("floatingPoolName"))...)
 
+	// Validate the Cinder volume types which are referenced by the shoot
+	cluster, err := extensionscontroller.GetCluster(ctx, c.client, infra.Namespace)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(nil, fmt.Errorf("could not get cluster: %w", err)))
+		return allErrs
+	}
+	references, err := volumeTypeReferencesFromCluster(cluster)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(nil, err))
+		return allErrs
+	}
+	if len(references) > 0 {
+		blockStorageClient, err := clientFactory.BlockStorage(openstackclient.WithRegion(infra.Spec.Region))
+		if err != nil {
+			logger.Info("Skipping validation of volume types as the block storage service is not available", "error", err.Error())
+			return allErrs
+		}
+
+		// References which are unchanged since the last successful reconciliation are only checked for information, so
+		// that existing shoots are not broken by changes of the volume types in Cinder or of the validation.
+		unchangedPaths, err := unchangedVolumeTypeReferencePaths(infra, references)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(nil, err))
+			return allErrs
+		}
+
+		logger.Info("Validating volume types")
+		for _, err := range c.validateVolumeTypes(ctx, logger, blockStorageClient, references) {
+			if unchangedPaths.Has(err.Field) {
+				logger.Info("Volume type reference is invalid", "error", err.Error())
+				continue
+			}
+			allErrs = append(allErrs, err)
+		}
+	}
+
 	return allErrs
 }
 
@@ -91,3 +142,239 @@ func (c *configValidator) validateFloatingPoolName(ctx context.Context, networki
 
 	return allErrs
 }
+
+// volumeTypeReference is a reference to a Cinder volume type by a worker volume or a storage class.
+type volumeTypeReference struct {
+	// volumeType is the name or ID of the volume type.
+	volumeType string
+	// zones are the availability zones in which volumes of the volume type are created. They are empty if the
+	// availability zones of volumes are ignored.
+	zones []string
+	// encrypted specifies whether the volumes must be encrypted.
+	encrypted bool
+	// fldPath is the path of the volume type reference.
+	fldPath *field.Path
+	// zonePath is the path of the availability zone of storage classes. It is nil for worker volumes, whose zones are
+	// derived from the compute availability zones of the worker pool.
+	zonePath *field.Path
+}
+
+// volumeTypeReferencesFromCluster collects the Cinder volume types referenced by the worker volumes, the storage
+// classes of the CloudProfile and the storage classes of the shoot.
+func volumeTypeReferencesFromCluster(cluster *extensionscontroller.Cluster) ([]volumeTypeReference, error) {
+	var references []volumeTypeReference
+	if cluster.Shoot == nil {
+		return references, nil
+	}
+
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+	// The availability zones of volumes are not checked if they are ignored when scheduling pods.
+	ignoreVolumeAZ := cloudProfileConfig != nil && ptr.Deref(cloudProfileConfig.IgnoreVolumeAZ, false)
+	// Volumes are created in the Cinder availability zones which are mapped to the compute availability zones of the shoot.
+	volumeZones := func(zones ...string) []string {
+		if ignoreVolumeAZ {
+			return nil
+		}
+		if cloudProfileConfig == nil {
+			return zones
+		}
+		mappedZones := make([]string, 0, len(zones))
+		for _, zone := range zones {
+			mappedZones = append(mappedZones, helper.FindVolumeZone(cloudProfileConfig.VolumeAZMappings, cluster.Shoot.Spec.Region, zone))
+		}
+		return mappedZones
+	}
+
+	workersPath := field.NewPath("workers")
+	for i, worker := range cluster.Shoot.Spec.Provider.Workers {
+		workerPath := workersPath.Index(i)
+		if worker.Volume != nil && worker.Volume.Type != nil {
+			references = append(references, volumeTypeReference{
+				volumeType: *worker.Volume.Type,
+				zones:      volumeZones(worker.Zones...),
+				encrypted:  ptr.Deref(worker.Volume.Encrypted, false),
+				fldPath:    workerPath.Child("volume", "type"),
+			})
+		}
+	}
+
+	if cloudProfileConfig != nil {
+		storageClassesPath := field.NewPath("cloudProfileConfig", "storageClasses")
+		for i, storageClass := range cloudProfileConfig.StorageClasses {
+			volumeType, ok := storageClass.Parameters["type"]
+			if !ok || ptr.Deref(storageClass.Provisioner, openstack.CSIStorageProvisioner) != openstack.CSIStorageProvisioner {
+				continue
+			}
+			reference := volumeTypeReference{
+				volumeType: volumeType,
+				fldPath:    storageClassesPath.Index(i).Child("parameters", "type"),
+			}
+			if zone, ok := storageClass.Parameters["availability"]; ok && !ignoreVolumeAZ {
+				reference.zones = []string{zone}
+				reference.zonePath = storageClassesPath.Index(i).Child("parameters", "availability")
+			}
+			references = append(references, reference)
+		}
+	}
+
+	cpConfig, err := helper.ControlPlaneConfigFromRawExtension(cluster.Shoot.Spec.Provider.ControlPlaneConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode control plane config: %w", err)
+	}
+	if cpConfig.Storage != nil {
+		storageClassesPath := field.NewPath("controlPlaneConfig", "storage", "storageClasses")
+		for i, storageClass := range cpConfig.Storage.StorageClasses {
+			if storageClass.VolumeType == nil || ptr.Deref(storageClass.Backend, api.StorageBackendCinder) != api.StorageBackendCinder {
+				continue
+			}
+			reference := volumeTypeReference{
+				volumeType: *storageClass.VolumeType,
+				encrypted:  ptr.Deref(storageClass.Encrypted, false),
+				fldPath:    storageClassesPath.Index(i).Child("volumeType"),
+			}
+			if storageClass.AvailabilityZone != nil && !ignoreVolumeAZ {
+				reference.zones = volumeZones(*storageClass.AvailabilityZone)
+				reference.zonePath = storageClassesPath.Index(i).Child("availabilityZone")
+			}
+			references = append(references, reference)
+		}
+	}
+
+	return references, nil
+}
+
+// fingerprint returns a fingerprint of the volume type, the zones and the encryption of the reference. It does not
+// depend on the path of the reference, so that a reference is not considered changed if e.g. worker pools are reordered.
+func (r volumeTypeReference) fingerprint() string {
+	return utils.ComputeSHA256Hex([]byte(fmt.Sprintf("%s|%s|%t", r.volumeType, strings.Join(r.zones, ","), r.encrypted)))[:16]
+}
+
+// encodeVolumeTypeReferences encodes the fingerprints of the given references, so that they can be recorded in the
+// infrastructure state.
+func encodeVolumeTypeReferences(references []volumeTypeReference) string {
+	if len(references) == 0 {
+		return noVolumeTypeReferences
+	}
+	fingerprints := sets.New[string]()
+	for _, reference := range references {
+		fingerprints.Insert(reference.fingerprint())
+	}
+	return strings.Join(sets.List(fingerprints), ",")
+}
+
+// unchangedVolumeTypeReferencePaths returns the paths of the references which were already referenced at the last
+// successful reconciliation of the infrastructure. All references of infrastructures which were reconciled before
+// the references were recorded are considered unchanged.
+func unchangedVolumeTypeReferencePaths(infra *extensionsv1alpha1.Infrastructure, references []volumeTypeReference) (sets.Set[string], error) {
+	paths := sets.New[string]()
+	if infra.Status.ProviderStatus == nil {
+		return paths, nil
+	}
+
+	var recorded string
+	if infra.Status.State != nil {
+		state, err := helper.InfrastructureStateFromRaw(infra.Status.State)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode infrastructure state: %w", err)
+		}
+		recorded = state.Data[infraflow.VolumeTypeReferences]
+	}
+	fingerprints := sets.New(strings.Split(recorded, ",")...)
+
+	for _, reference := range references {
+		if recorded != "" && !fingerprints.Has(reference.fingerprint()) {
+			continue
+		}
+		paths.Insert(reference.fldPath.String())
+		if reference.zonePath != nil {
+			paths.Insert(reference.zonePath.String())
+		}
+	}
+	return paths, nil
+}
+
+func (c *configValidator) validateVolumeTypes(ctx context.Context, logger logr.Logger, blockStorageClient openstackclient.BlockStorage, references []volumeTypeReference) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Cinder only returns the volume types which are public or which the project has access to. The validation is
+	// skipped if they cannot be listed, as the volumes are validated by Cinder anyway when they are created.
+	volumeTypes, err := blockStorageClient.ListVolumeTypes(ctx, volumetypes.ListOpts{})
+	if err != nil {
+		logger.Info("Skipping validation of volume types as they could not be listed", "error", err.Error())
+		return allErrs
+	}
+	var availableZones []string
+	if slices.ContainsFunc(references, func(reference volumeTypeReference) bool { return reference.zonePath != nil }) {
+		availabilityZones, err := blockStorageClient.ListAvailabilityZones(ctx)
+		if err != nil {
+			logger.Info("Skipping validation of volume types as the block storage availability zones could not be listed", "error", err.Error())
+			return allErrs
+		}
+		for _, zone := range availabilityZones {
+			if zone.ZoneState.Available {
+				availableZones = append(availableZones, zone.ZoneName)
+			}
+		}
+	}
+
+	encryptedVolumeTypes := map[string]bool{}
+	for _, reference := range references {
+		index := slices.IndexFunc(volumeTypes, func(volumeType volumetypes.VolumeType) bool {
+			return volumeType.Name == reference.volumeType || volumeType.ID == reference.volumeType
+		})
+		if index < 0 {
+			allErrs = append(allErrs, field.NotFound(reference.fldPath, reference.volumeType))
+			continue
+		}
+		volumeType := volumeTypes[index]
+
+		if reference.zonePath != nil {
+			for _, zone := range reference.zones {
+				if !slices.Contains(availableZones, zone) {
+					allErrs = append(allErrs, field.NotSupported(reference.zonePath, zone, availableZones))
+				}
+			}
+		}
+
+		// Volume types can be restricted to availability zones, which is visible to users via this extra spec.
+		if restrictedZones, ok := volumeType.ExtraSpecs[volumeTypeAvailabilityZonesExtraSpec]; ok {
+			allowedZones := strings.Split(restrictedZones, ",")
+			for i := range allowedZones {
+				allowedZones[i] = strings.TrimSpace(allowedZones[i])
+			}
+			for _, zone := range reference.zones {
+				if !slices.Contains(allowedZones, zone) {
+					allErrs = append(allErrs, field.Invalid(reference.fldPath, reference.volumeType, fmt.Sprintf("volume type is not available in zone %q", zone)))
+				}
+			}
+		}
+
+		if !reference.encrypted {
+			continue
+		}
+		encrypted, ok := encryptedVolumeTypes[volumeType.ID]
+		if !ok {
+			encryption, err := blockStorageClient.GetVolumeTypeEncryption(ctx, volumeType.ID)
+			if err != nil {
+				// The encryption of volume types is only visible to administrators in some clouds.
+				if openstackclient.IsForbiddenError(err) {
+					logger.Info("Skipping encryption check as the encryption of the volume type is not visible", "volumeType", volumeType.Name)
+				} else {
+					logger.Info("Skipping encryption check as the encryption of the volume type could not be read", "volumeType", volumeType.Name, "error", err.Error())
+				}
+				encryptedVolumeTypes[volumeType.ID] = true
+				continue
+			}
+			encrypted = encryption.EncryptionID != ""
+			encryptedVolumeTypes[volumeType.ID] = encrypted
+		}
+		if !encrypted {
+			allErrs = append(allErrs, field.Invalid(reference.fldPath, reference.volumeType, "volume type does not encrypt volumes"))
+		}
+	}
+
+	return allErrs
+}
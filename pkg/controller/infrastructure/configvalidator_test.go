@@ -8,12 +8,19 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"net/http"
+	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/availabilityzones"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -22,11 +29,15 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	apisopenstack "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	apisopenstackv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
 	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 	mockopenstackclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
 )
@@ -49,6 +60,10 @@ var _ = Describe("ConfigValidator", func() {
 		openstackClientFactoryFactory *mockopenstackclient.MockFactoryFactory
 		openstackClientFactory        *mockopenstackclient.MockFactory
 		networkingClient              *mockopenstackclient.MockNetworking
+		blockStorageClient            *mockopenstackclient.MockBlockStorage
+		c                             client.Client
+		cluster                       *extensionsv1alpha1.Cluster
+		shoot                         *gardencorev1beta1.Shoot
 		ctx                           context.Context
 		logger                        logr.Logger
 		cv                            infrastructure.ConfigValidator
@@ -63,12 +78,14 @@ var _ = Describe("ConfigValidator", func() {
 		openstackClientFactoryFactory = mockopenstackclient.NewMockFactoryFactory(ctrl)
 		openstackClientFactory = mockopenstackclient.NewMockFactory(ctrl)
 		networkingClient = mockopenstackclient.NewMockNetworking(ctrl)
+		blockStorageClient = mockopenstackclient.NewMockBlockStorage(ctrl)
 
 		ctx = context.TODO()
 		logger = log.Log.WithName("test")
 
 		scheme := runtime.NewScheme()
 		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
 
 		secret = &corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
@@ -85,7 +102,22 @@ var _ = Describe("ConfigValidator", func() {
 			},
 		}
 
-		c := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build()
+		shoot = &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+				Kind:       "Shoot",
+			},
+		}
+		cluster = &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namespace,
+			},
+			Spec: extensionsv1alpha1.ClusterSpec{
+				Shoot: runtime.RawExtension{Raw: encode(shoot)},
+			},
+		}
+
+		c = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(secret, cluster).Build()
 		mgr := test.FakeManager{Client: c}
 		cv = NewConfigValidator(mgr, openstackClientFactoryFactory, logger)
 
@@ -170,6 +202,217 @@ var _ = Describe("ConfigValidator", func() {
 				"Detail": Equal("could not get external network names: test"),
 			}))
 		})
+
+		Context("volume types", func() {
+			BeforeEach(func() {
+				networkingClient.EXPECT().GetExternalNetworkNames(ctx).Return([]string{floatingPoolName}, nil)
+
+				shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+					{
+						Name:  "worker",
+						Zones: []string{"zone1"},
+						Volume: &gardencorev1beta1.Volume{
+							Type:       ptr.To("standard"),
+							VolumeSize: "20Gi",
+						},
+					},
+					{
+						Name:  "encrypted-worker",
+						Zones: []string{"zone1"},
+						Volume: &gardencorev1beta1.Volume{
+							Type:       ptr.To("encrypted"),
+							VolumeSize: "50Gi",
+							Encrypted:  ptr.To(true),
+						},
+					},
+				}
+				shoot.Spec.Provider.ControlPlaneConfig = &runtime.RawExtension{Raw: encode(&apisopenstackv1alpha1.ControlPlaneConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apisopenstackv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "ControlPlaneConfig",
+					},
+					Storage: &apisopenstackv1alpha1.Storage{
+						StorageClasses: []apisopenstackv1alpha1.StorageClass{
+							{
+								Name:             "fast",
+								VolumeType:       ptr.To("ssd-id"),
+								AvailabilityZone: ptr.To("zone2"),
+							},
+						},
+					},
+				})}
+				cluster.Spec.Shoot = runtime.RawExtension{Raw: encode(shoot)}
+				Expect(c.Update(ctx, cluster)).To(Succeed())
+
+				openstackClientFactory.EXPECT().BlockStorage(gomock.Any()).Return(blockStorageClient, nil)
+				blockStorageClient.EXPECT().ListAvailabilityZones(ctx).Return([]availabilityzones.AvailabilityZone{
+					{ZoneName: "zone1", ZoneState: availabilityzones.ZoneState{Available: true}},
+					{ZoneName: "zone2", ZoneState: availabilityzones.ZoneState{Available: true}},
+					{ZoneName: "zone3", ZoneState: availabilityzones.ZoneState{Available: false}},
+				}, nil).AnyTimes()
+			})
+
+			It("should allow volume types that exist, are encrypted and are available in the zones", func() {
+				blockStorageClient.EXPECT().ListVolumeTypes(ctx, volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: "standard-id", Name: "standard"},
+					{ID: "encrypted-id", Name: "encrypted", ExtraSpecs: map[string]string{"RESKEY:availability_zones": "zone1, zone2"}},
+					{ID: "ssd-id", Name: "ssd"},
+				}, nil)
+				blockStorageClient.EXPECT().GetVolumeTypeEncryption(ctx, "encrypted-id").Return(&volumetypes.GetEncryptionType{EncryptionID: "encryption-id"}, nil)
+
+				Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+			})
+
+			It("should forbid volume types that don't exist, are not encrypted or are not available in the zones", func() {
+				blockStorageClient.EXPECT().ListVolumeTypes(ctx, volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: "encrypted-id", Name: "encrypted", ExtraSpecs: map[string]string{"RESKEY:availability_zones": "zone2"}},
+					{ID: "ssd-id", Name: "ssd"},
+				}, nil)
+				blockStorageClient.EXPECT().GetVolumeTypeEncryption(ctx, "encrypted-id").Return(&volumetypes.GetEncryptionType{}, nil)
+
+				Expect(cv.Validate(ctx, infra)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotFound),
+						"Field": Equal("workers[0].volume.type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("workers[1].volume.type"),
+						"Detail": Equal(`volume type is not available in zone "zone1"`),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("workers[1].volume.type"),
+						"Detail": Equal("volume type does not encrypt volumes"),
+					})),
+				))
+			})
+
+			It("should check the volume types in the mapped volume availability zones", func() {
+				shoot.Spec.Region = "region"
+				cluster.Spec.Shoot = runtime.RawExtension{Raw: encode(shoot)}
+				cluster.Spec.CloudProfile = runtime.RawExtension{Raw: encode(&gardencorev1beta1.CloudProfile{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+						Kind:       "CloudProfile",
+					},
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: encode(&apisopenstackv1alpha1.CloudProfileConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisopenstackv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "CloudProfileConfig",
+							},
+							VolumeAZMappings: []apisopenstackv1alpha1.VolumeAZMapping{
+								{Region: "region", ComputeZone: "zone1", VolumeZone: "zone3"},
+								{Region: "region", ComputeZone: "zone2", VolumeZone: "zone4"},
+							},
+						})},
+					},
+				})}
+				Expect(c.Update(ctx, cluster)).To(Succeed())
+
+				blockStorageClient.EXPECT().ListVolumeTypes(ctx, volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: "standard-id", Name: "standard"},
+					{ID: "encrypted-id", Name: "encrypted", ExtraSpecs: map[string]string{"RESKEY:availability_zones": "zone3"}},
+					{ID: "ssd-id", Name: "ssd"},
+				}, nil)
+				blockStorageClient.EXPECT().GetVolumeTypeEncryption(ctx, "encrypted-id").Return(&volumetypes.GetEncryptionType{EncryptionID: "encryption-id"}, nil)
+
+				Expect(cv.Validate(ctx, infra)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":     Equal(field.ErrorTypeNotSupported),
+						"Field":    Equal("controlPlaneConfig.storage.storageClasses[0].availabilityZone"),
+						"BadValue": Equal("zone4"),
+					})),
+				))
+			})
+
+			It("should skip the encryption check if the encryption of the volume type is not visible", func() {
+				blockStorageClient.EXPECT().ListVolumeTypes(ctx, volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: "standard-id", Name: "standard"},
+					{ID: "encrypted-id", Name: "encrypted"},
+					{ID: "ssd-id", Name: "ssd"},
+				}, nil)
+				blockStorageClient.EXPECT().GetVolumeTypeEncryption(ctx, "encrypted-id").Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusForbidden})
+
+				Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+			})
+
+			It("should not fail if the volume types cannot be listed", func() {
+				blockStorageClient.EXPECT().ListVolumeTypes(ctx, volumetypes.ListOpts{}).Return(nil, errors.New("test"))
+
+				Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+			})
+
+			It("should not fail for the volume types of infrastructures which were reconciled before the references were recorded", func() {
+				infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte("{}")}
+				blockStorageClient.EXPECT().ListVolumeTypes(ctx, volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: "encrypted-id", Name: "encrypted"},
+				}, nil)
+				blockStorageClient.EXPECT().GetVolumeTypeEncryption(ctx, "encrypted-id").Return(&volumetypes.GetEncryptionType{}, nil)
+
+				Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+			})
+
+			It("should only fail for the new or changed volume type references of existing infrastructures", func() {
+				infra.Status.ProviderStatus = &runtime.RawExtension{Raw: []byte("{}")}
+				infra.Status.State = &runtime.RawExtension{Raw: encode(&apisopenstackv1alpha1.InfrastructureState{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apisopenstackv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "InfrastructureState",
+					},
+					Data: map[string]string{
+						infraflow.VolumeTypeReferences: strings.Join([]string{
+							utils.ComputeSHA256Hex([]byte("encrypted|zone1|true"))[:16],
+							utils.ComputeSHA256Hex([]byte("ssd-id|zone1|false"))[:16],
+						}, ","),
+					},
+				})}
+				blockStorageClient.EXPECT().ListVolumeTypes(ctx, volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: "encrypted-id", Name: "encrypted"},
+				}, nil)
+				blockStorageClient.EXPECT().GetVolumeTypeEncryption(ctx, "encrypted-id").Return(&volumetypes.GetEncryptionType{}, nil)
+
+				Expect(cv.Validate(ctx, infra)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotFound),
+						"Field": Equal("workers[0].volume.type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotFound),
+						"Field": Equal("controlPlaneConfig.storage.storageClasses[0].volumeType"),
+					})),
+				))
+			})
+
+			It("should skip the zone checks if the volume availability zones are ignored", func() {
+				cluster.Spec.CloudProfile = runtime.RawExtension{Raw: encode(&gardencorev1beta1.CloudProfile{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+						Kind:       "CloudProfile",
+					},
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						ProviderConfig: &runtime.RawExtension{Raw: encode(&apisopenstackv1alpha1.CloudProfileConfig{
+							TypeMeta: metav1.TypeMeta{
+								APIVersion: apisopenstackv1alpha1.SchemeGroupVersion.String(),
+								Kind:       "CloudProfileConfig",
+							},
+							IgnoreVolumeAZ: ptr.To(true),
+						})},
+					},
+				})}
+				Expect(c.Update(ctx, cluster)).To(Succeed())
+
+				blockStorageClient.EXPECT().ListVolumeTypes(ctx, volumetypes.ListOpts{}).Return([]volumetypes.VolumeType{
+					{ID: "standard-id", Name: "standard", ExtraSpecs: map[string]string{"RESKEY:availability_zones": "zone2"}},
+					{ID: "encrypted-id", Name: "encrypted", ExtraSpecs: map[string]string{"RESKEY:availability_zones": "zone3"}},
+					{ID: "ssd-id", Name: "ssd"},
+				}, nil)
+				blockStorageClient.EXPECT().GetVolumeTypeEncryption(ctx, "encrypted-id").Return(&volumetypes.GetEncryptionType{EncryptionID: "encryption-id"}, nil)
+
+				Expect(cv.Validate(ctx, infra)).To(BeEmpty())
+			})
+		})
 	})
 })
 
@@ -7,21 +7,27 @@ package access
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud/v2"
+	fwpolicies "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/policies"
+	fwrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	qosrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	"gopkg.in/godo.v2/glob"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
@@ -38,6 +44,7 @@ type NetworkingAccess interface {
 	AddRouterInterfaceAndWait(ctx context.Context, routerID, subnetID string) error
 	GetRouterInterfacePortID(ctx context.Context, routerID, subnetID string) (portID *string, err error)
 	RemoveRouterInterfaceAndWait(ctx context.Context, routerID, subnetID, portID string) error
+	UpdateRouterRoutes(ctx context.Context, routerID string, desired, obsolete []routers.Route) (modified bool, err error)
 
 	// Networks
 	CreateNetwork(ctx context.Context, desired *Network) (*Network, error)
@@ -56,6 +63,12 @@ type NetworkingAccess interface {
 	GetSecurityGroupByID(ctx context.Context, id string) (*groups.SecGroup, error)
 	GetSecurityGroupByName(ctx context.Context, name string) ([]*groups.SecGroup, error)
 	UpdateSecurityGroupRules(ctx context.Context, group *groups.SecGroup, desiredRules []rules.SecGroupRule, allowDelete func(rule *rules.SecGroupRule) bool) (modified bool, err error)
+
+	// QoS policies
+	UpdateQoSPolicyRules(ctx context.Context, policyID string, desired *QoSPolicyRules) (modified bool, err error)
+
+	// Firewall policies
+	UpdateFirewallPolicyRules(ctx context.Context, policy *fwpolicies.Policy, desired []fwrules.Rule) (modified bool, err error)
 }
 
 // Router is a simplified router resource
@@ -65,9 +78,12 @@ type Router struct {
 	ExternalNetworkID string
 	EnableSNAT        *bool
 	ExternalSubnetIDs []string
+	// AvailabilityZoneHints are only applied on creation
+	AvailabilityZoneHints []string
 
 	Status           string                    // only output
 	ExternalFixedIPs []routers.ExternalFixedIP // only output
+	Routes           []routers.Route           // only output
 }
 
 // Network is a simplified network resource
@@ -75,10 +91,19 @@ type Network struct {
 	ID           string
 	Name         string
 	AdminStateUp bool
+	// AvailabilityZoneHints are only applied on creation
+	AvailabilityZoneHints []string
 
 	Status string
 }
 
+// QoSPolicyRules is a simplified set of rules of a QoS policy
+type QoSPolicyRules struct {
+	BandwidthLimitRules   []qosrules.BandwidthLimitRule
+	MinimumBandwidthRules []qosrules.MinimumBandwidthRule
+	DSCPMarkingRules      []qosrules.DSCPMarkingRule
+}
+
 const (
 	// SecurityGroupIDSelf special placeholder for self secgroup ID
 	SecurityGroupIDSelf = "self"
@@ -127,6 +152,7 @@ func (a *networkingAccess) tryCreateRouter(ctx context.Context, desired *Router,
 			NetworkID:  desired.ExternalNetworkID,
 			EnableSNAT: desired.EnableSNAT,
 		},
+		AvailabilityZoneHints: desired.AvailabilityZoneHints,
 	}
 	if subnetID != nil {
 		options.GatewayInfo.ExternalFixedIPs = []routers.ExternalFixedIP{{SubnetID: *subnetID}}
@@ -171,6 +197,9 @@ func (a *networkingAccess) toRouter(raw *routers.Router) *Router {
 		EnableSNAT:        raw.GatewayInfo.EnableSNAT,
 		Status:            raw.Status,
 		ExternalFixedIPs:  raw.GatewayInfo.ExternalFixedIPs,
+		Routes:            raw.Routes,
+
+		AvailabilityZoneHints: raw.AvailabilityZoneHints,
 	}
 	return router
 }
@@ -281,6 +310,47 @@ func (a *networkingAccess) RemoveRouterInterfaceAndWait(ctx context.Context, rou
 	}
 }
 
+// UpdateRouterRoutes adds all desired routes which are missing on the router and removes the obsolete routes which are
+// still present on the router and not desired anymore. Other routes of the router, e.g. the ones managed by the
+// cloud-controller-manager, are left untouched.
+func (a *networkingAccess) UpdateRouterRoutes(ctx context.Context, routerID string, desired, obsolete []routers.Route) (modified bool, err error) {
+	router, err := a.GetRouterByID(ctx, routerID)
+	if err != nil {
+		return false, err
+	}
+	if router == nil {
+		return false, fmt.Errorf("router %s was not found", routerID)
+	}
+
+	var toRemove, toAdd []routers.Route
+	for _, route := range obsolete {
+		if slices.Contains(router.Routes, route) && !slices.Contains(desired, route) && !slices.Contains(toRemove, route) {
+			toRemove = append(toRemove, route)
+		}
+	}
+	for _, route := range desired {
+		if !slices.Contains(router.Routes, route) && !slices.Contains(toAdd, route) {
+			toAdd = append(toAdd, route)
+		}
+	}
+
+	if len(toRemove) > 0 {
+		a.log.Info("removing router routes", "router", routerID, "routes", toRemove)
+		if _, err := a.networking.RemoveRoutesFromRouter(ctx, toRemove, routerID); err != nil {
+			return false, err
+		}
+		modified = true
+	}
+	if len(toAdd) > 0 {
+		a.log.Info("adding router routes", "router", routerID, "routes", toAdd)
+		if _, err := a.networking.AddRoutesToRouter(ctx, toAdd, routerID); err != nil {
+			return modified, err
+		}
+		modified = true
+	}
+	return modified, nil
+}
+
 // LookupFloatingPoolSubnetIDs returns a list of subnet ids matching the given regex of the subnet name
 func (a *networkingAccess) LookupFloatingPoolSubnetIDs(ctx context.Context, networkID, floatingPoolSubnetNameRegex string) ([]string, error) {
 	allSubnets, err := a.networking.ListSubnets(ctx, subnets.ListOpts{
@@ -356,8 +426,9 @@ func subnetNameMatcher(pat string) (subnetMatcher, error) {
 // CreateNetwork creates a private network
 func (a *networkingAccess) CreateNetwork(ctx context.Context, desired *Network) (*Network, error) {
 	raw, err := a.networking.CreateNetwork(ctx, networks.CreateOpts{
-		AdminStateUp: &desired.AdminStateUp,
-		Name:         desired.Name,
+		AdminStateUp:          &desired.AdminStateUp,
+		Name:                  desired.Name,
+		AvailabilityZoneHints: desired.AvailabilityZoneHints,
 	})
 	if err != nil {
 		return nil, err
@@ -413,6 +484,8 @@ func (a *networkingAccess) toNetwork(raw *networks.Network) *Network {
 		Name:         raw.Name,
 		AdminStateUp: raw.AdminStateUp,
 		Status:       raw.Status,
+
+		AvailabilityZoneHints: raw.AvailabilityZoneHints,
 	}
 }
 
@@ -427,6 +500,8 @@ func (a *networkingAccess) CreateSubnet(ctx context.Context, desired *subnets.Su
 		Prefixlen:       ptr.Deref(prefixlen, 0),
 		IPv6RAMode:      desired.IPv6RAMode,
 		IPv6AddressMode: desired.IPv6AddressMode,
+		HostRoutes:      desired.HostRoutes,
+		AllocationPools: desired.AllocationPools,
 	}
 
 	raw, err := a.networking.CreateSubnet(ctx, opts)
@@ -470,6 +545,19 @@ func (a *networkingAccess) UpdateSubnet(ctx context.Context, desired, current *s
 		modified = true
 		updateOpts.DNSNameservers = &desired.DNSNameservers
 	}
+	if (len(desired.HostRoutes) > 0 || len(current.HostRoutes) > 0) && !reflect.DeepEqual(desired.HostRoutes, current.HostRoutes) {
+		modified = true
+		hostRoutes := desired.HostRoutes
+		if hostRoutes == nil {
+			hostRoutes = []subnets.HostRoute{}
+		}
+		updateOpts.HostRoutes = &hostRoutes
+	}
+	// allocation pools are only updated if they are specified, as the subnet cannot be left without pools
+	if len(desired.AllocationPools) > 0 && !sets.New(desired.AllocationPools...).Equal(sets.New(current.AllocationPools...)) {
+		modified = true
+		updateOpts.AllocationPools = desired.AllocationPools
+	}
 	if modified {
 		_, err = a.networking.UpdateSubnet(ctx, current.ID, updateOpts)
 	}
@@ -559,6 +647,204 @@ func (a *networkingAccess) UpdateSecurityGroupRules(
 	return
 }
 
+// UpdateQoSPolicyRules updates the rules of a QoS policy to match the desired rules.
+// Obsolete rules are deleted before the missing ones are created, as Neutron allows only one rule of a type per direction.
+func (a *networkingAccess) UpdateQoSPolicyRules(ctx context.Context, policyID string, desired *QoSPolicyRules) (modified bool, err error) {
+	currentBandwidthLimitRules, err := a.networking.ListBandwidthLimitRules(ctx, policyID)
+	if err != nil {
+		return false, err
+	}
+	m, err := updateQoSRules(currentBandwidthLimitRules, desired.BandwidthLimitRules,
+		func(current, desired qosrules.BandwidthLimitRule) bool {
+			return current.MaxKBps == desired.MaxKBps && current.MaxBurstKBps == desired.MaxBurstKBps && current.Direction == desired.Direction
+		},
+		func(rule qosrules.BandwidthLimitRule) error {
+			return a.networking.DeleteBandwidthLimitRule(ctx, policyID, rule.ID)
+		},
+		func(rule qosrules.BandwidthLimitRule) error {
+			_, err := a.networking.CreateBandwidthLimitRule(ctx, policyID, qosrules.CreateBandwidthLimitRuleOpts{
+				MaxKBps:      rule.MaxKBps,
+				MaxBurstKBps: rule.MaxBurstKBps,
+				Direction:    rule.Direction,
+			})
+			return err
+		})
+	if err != nil {
+		return modified, fmt.Errorf("error updating bandwidth limit rules of QoS policy %s: %w", policyID, err)
+	}
+	modified = modified || m
+
+	currentMinimumBandwidthRules, err := a.networking.ListMinimumBandwidthRules(ctx, policyID)
+	if err != nil {
+		return modified, err
+	}
+	m, err = updateQoSRules(currentMinimumBandwidthRules, desired.MinimumBandwidthRules,
+		func(current, desired qosrules.MinimumBandwidthRule) bool {
+			return current.MinKBps == desired.MinKBps && current.Direction == desired.Direction
+		},
+		func(rule qosrules.MinimumBandwidthRule) error {
+			return a.networking.DeleteMinimumBandwidthRule(ctx, policyID, rule.ID)
+		},
+		func(rule qosrules.MinimumBandwidthRule) error {
+			_, err := a.networking.CreateMinimumBandwidthRule(ctx, policyID, qosrules.CreateMinimumBandwidthRuleOpts{
+				MinKBps:   rule.MinKBps,
+				Direction: rule.Direction,
+			})
+			return err
+		})
+	if err != nil {
+		return modified, fmt.Errorf("error updating minimum bandwidth rules of QoS policy %s: %w", policyID, err)
+	}
+	modified = modified || m
+
+	currentDSCPMarkingRules, err := a.networking.ListDSCPMarkingRules(ctx, policyID)
+	if err != nil {
+		return modified, err
+	}
+	m, err = updateQoSRules(currentDSCPMarkingRules, desired.DSCPMarkingRules,
+		func(current, desired qosrules.DSCPMarkingRule) bool {
+			return current.DSCPMark == desired.DSCPMark
+		},
+		func(rule qosrules.DSCPMarkingRule) error {
+			return a.networking.DeleteDSCPMarkingRule(ctx, policyID, rule.ID)
+		},
+		func(rule qosrules.DSCPMarkingRule) error {
+			_, err := a.networking.CreateDSCPMarkingRule(ctx, policyID, qosrules.CreateDSCPMarkingRuleOpts{
+				DSCPMark: rule.DSCPMark,
+			})
+			return err
+		})
+	if err != nil {
+		return modified, fmt.Errorf("error updating DSCP marking rules of QoS policy %s: %w", policyID, err)
+	}
+	return modified || m, nil
+}
+
+func updateQoSRules[T any](current, desired []T, equal func(current, desired T) bool, deleteFn, createFn func(rule T) error) (modified bool, err error) {
+	found := make([]bool, len(desired))
+	for _, rule := range current {
+		match := -1
+		for i := range desired {
+			if !found[i] && equal(rule, desired[i]) {
+				match = i
+				break
+			}
+		}
+		if match >= 0 {
+			found[match] = true
+			continue
+		}
+		if err := deleteFn(rule); client.IgnoreNotFoundError(err) != nil {
+			return modified, err
+		}
+		modified = true
+	}
+	for i, rule := range desired {
+		if found[i] {
+			continue
+		}
+		if err := createFn(rule); err != nil {
+			return modified, err
+		}
+		modified = true
+	}
+	return modified, nil
+}
+
+// UpdateFirewallPolicyRules updates the rules of a firewall policy to match the desired rules in the given order.
+// Rules are only deleted after they have been removed from the policy, as Neutron refuses to delete rules in use.
+// Rules which were created by this call are deleted again if they could not be added to the policy, as only the rules
+// of the policy are found again by the next call.
+func (a *networkingAccess) UpdateFirewallPolicyRules(ctx context.Context, policy *fwpolicies.Policy, desired []fwrules.Rule) (modified bool, err error) {
+	current, err := a.networking.ListFirewallRules(ctx, fwrules.ListOpts{FirewallPolicyID: policy.ID})
+	if err != nil {
+		return false, err
+	}
+
+	var createdRuleIDs []string
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, id := range createdRuleIDs {
+			if deleteErr := a.networking.DeleteFirewallRule(ctx, id); client.IgnoreNotFoundError(deleteErr) != nil {
+				err = errors.Join(err, fmt.Errorf("error deleting unused rule %s of firewall policy %s: %w", id, policy.ID, deleteErr))
+			}
+		}
+	}()
+
+	used := make([]bool, len(current))
+	ruleIDs := make([]string, 0, len(desired))
+	for i, rule := range desired {
+		match := -1
+		for j := range current {
+			if !used[j] && firewallRuleEqual(current[j], rule) {
+				match = j
+				break
+			}
+		}
+		if match >= 0 {
+			used[match] = true
+			ruleIDs = append(ruleIDs, current[match].ID)
+			continue
+		}
+		created, err := a.networking.CreateFirewallRule(ctx, fwrules.CreateOpts{
+			Name:                 policy.Name,
+			Protocol:             fwrules.Protocol(firewallRuleProtocol(rule)),
+			Action:               fwrules.Action(rule.Action),
+			IPVersion:            gophercloud.IPVersion(rule.IPVersion),
+			SourceIPAddress:      rule.SourceIPAddress,
+			DestinationIPAddress: rule.DestinationIPAddress,
+			SourcePort:           rule.SourcePort,
+			DestinationPort:      rule.DestinationPort,
+			ProjectID:            policy.ProjectID,
+		})
+		if err != nil {
+			return modified, fmt.Errorf("error creating rule %d for firewall policy %s: %w", i, policy.ID, err)
+		}
+		createdRuleIDs = append(createdRuleIDs, created.ID)
+		ruleIDs = append(ruleIDs, created.ID)
+		modified = true
+	}
+
+	if !slices.Equal(ruleIDs, policy.Rules) {
+		if _, err := a.networking.UpdateFirewallPolicy(ctx, policy.ID, fwpolicies.UpdateOpts{FirewallRules: &ruleIDs}); err != nil {
+			return modified, fmt.Errorf("error updating rules of firewall policy %s: %w", policy.ID, err)
+		}
+		modified = true
+	}
+	createdRuleIDs = nil
+
+	for i, rule := range current {
+		if used[i] {
+			continue
+		}
+		if err := a.networking.DeleteFirewallRule(ctx, rule.ID); client.IgnoreNotFoundError(err) != nil {
+			return modified, fmt.Errorf("error deleting rule %s of firewall policy %s: %w", rule.ID, policy.ID, err)
+		}
+		modified = true
+	}
+	return modified, nil
+}
+
+// firewallRuleProtocol returns the protocol of the rule. Neutron reports rules matching any protocol without protocol.
+func firewallRuleProtocol(rule fwrules.Rule) string {
+	if rule.Protocol == "" {
+		return string(fwrules.ProtocolAny)
+	}
+	return rule.Protocol
+}
+
+func firewallRuleEqual(current, desired fwrules.Rule) bool {
+	return firewallRuleProtocol(current) == firewallRuleProtocol(desired) &&
+		current.Action == desired.Action &&
+		current.IPVersion == desired.IPVersion &&
+		current.SourceIPAddress == desired.SourceIPAddress &&
+		current.DestinationIPAddress == desired.DestinationIPAddress &&
+		current.SourcePort == desired.SourcePort &&
+		current.DestinationPort == desired.DestinationPort
+}
+
 func (a *networkingAccess) findMatchingRule(rule *rules.SecGroupRule, desiredRules []rules.SecGroupRule) (*rules.SecGroupRule, bool) {
 	for i := range desiredRules {
 		desired := &desiredRules[i]
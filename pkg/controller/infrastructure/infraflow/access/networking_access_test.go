@@ -6,9 +6,15 @@ package access_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/go-logr/logr"
+	fwpolicies "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/policies"
+	fwrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	qosrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -21,6 +27,123 @@ type fakeNetworking struct {
 	client.Networking
 
 	listSubnetsFn func(ctx context.Context, opts subnets.ListOpts) ([]subnets.Subnet, error)
+	listRoutersFn func(ctx context.Context, opts routers.ListOpts) ([]routers.Router, error)
+
+	addedRoutes   []routers.Route
+	removedRoutes []routers.Route
+	subnetUpdates []subnets.UpdateOpts
+
+	bandwidthLimitRules   []qosrules.BandwidthLimitRule
+	minimumBandwidthRules []qosrules.MinimumBandwidthRule
+	dscpMarkingRules      []qosrules.DSCPMarkingRule
+	createdQoSRuleCount   int
+	deletedQoSRuleIDs     []string
+
+	firewallRules          []fwrules.Rule
+	createdFirewallRules   []fwrules.CreateOpts
+	deletedFirewallRuleIDs []string
+	firewallPolicyUpdates  []fwpolicies.UpdateOpts
+	firewallPolicyErr      error
+}
+
+func (f *fakeNetworking) ListFirewallRules(_ context.Context, _ fwrules.ListOpts) ([]fwrules.Rule, error) {
+	return f.firewallRules, nil
+}
+
+func (f *fakeNetworking) CreateFirewallRule(_ context.Context, opts fwrules.CreateOpts) (*fwrules.Rule, error) {
+	f.createdFirewallRules = append(f.createdFirewallRules, opts)
+	return &fwrules.Rule{ID: fmt.Sprintf("new-%d", len(f.createdFirewallRules))}, nil
+}
+
+func (f *fakeNetworking) DeleteFirewallRule(_ context.Context, id string) error {
+	f.deletedFirewallRuleIDs = append(f.deletedFirewallRuleIDs, id)
+	return nil
+}
+
+func (f *fakeNetworking) UpdateFirewallPolicy(_ context.Context, id string, opts fwpolicies.UpdateOpts) (*fwpolicies.Policy, error) {
+	if f.firewallPolicyErr != nil {
+		return nil, f.firewallPolicyErr
+	}
+	f.firewallPolicyUpdates = append(f.firewallPolicyUpdates, opts)
+	return &fwpolicies.Policy{ID: id, Rules: *opts.FirewallRules}, nil
+}
+
+func (f *fakeNetworking) ListBandwidthLimitRules(_ context.Context, _ string) ([]qosrules.BandwidthLimitRule, error) {
+	return f.bandwidthLimitRules, nil
+}
+
+func (f *fakeNetworking) CreateBandwidthLimitRule(_ context.Context, _ string, opts qosrules.CreateBandwidthLimitRuleOpts) (*qosrules.BandwidthLimitRule, error) {
+	f.createdQoSRuleCount++
+	rule := qosrules.BandwidthLimitRule{MaxKBps: opts.MaxKBps, MaxBurstKBps: opts.MaxBurstKBps, Direction: opts.Direction}
+	f.bandwidthLimitRules = append(f.bandwidthLimitRules, rule)
+	return &rule, nil
+}
+
+func (f *fakeNetworking) DeleteBandwidthLimitRule(_ context.Context, _, ruleID string) error {
+	f.deletedQoSRuleIDs = append(f.deletedQoSRuleIDs, ruleID)
+	return nil
+}
+
+func (f *fakeNetworking) ListMinimumBandwidthRules(_ context.Context, _ string) ([]qosrules.MinimumBandwidthRule, error) {
+	return f.minimumBandwidthRules, nil
+}
+
+func (f *fakeNetworking) CreateMinimumBandwidthRule(_ context.Context, _ string, opts qosrules.CreateMinimumBandwidthRuleOpts) (*qosrules.MinimumBandwidthRule, error) {
+	f.createdQoSRuleCount++
+	rule := qosrules.MinimumBandwidthRule{MinKBps: opts.MinKBps, Direction: opts.Direction}
+	f.minimumBandwidthRules = append(f.minimumBandwidthRules, rule)
+	return &rule, nil
+}
+
+func (f *fakeNetworking) DeleteMinimumBandwidthRule(_ context.Context, _, ruleID string) error {
+	f.deletedQoSRuleIDs = append(f.deletedQoSRuleIDs, ruleID)
+	return nil
+}
+
+func (f *fakeNetworking) ListDSCPMarkingRules(_ context.Context, _ string) ([]qosrules.DSCPMarkingRule, error) {
+	return f.dscpMarkingRules, nil
+}
+
+func (f *fakeNetworking) CreateDSCPMarkingRule(_ context.Context, _ string, opts qosrules.CreateDSCPMarkingRuleOpts) (*qosrules.DSCPMarkingRule, error) {
+	f.createdQoSRuleCount++
+	rule := qosrules.DSCPMarkingRule{DSCPMark: opts.DSCPMark}
+	f.dscpMarkingRules = append(f.dscpMarkingRules, rule)
+	return &rule, nil
+}
+
+func (f *fakeNetworking) DeleteDSCPMarkingRule(_ context.Context, _, ruleID string) error {
+	f.deletedQoSRuleIDs = append(f.deletedQoSRuleIDs, ruleID)
+	return nil
+}
+
+func (f *fakeNetworking) UpdateSubnet(_ context.Context, _ string, opts subnets.UpdateOpts) (*subnets.Subnet, error) {
+	f.subnetUpdates = append(f.subnetUpdates, opts)
+	return &subnets.Subnet{}, nil
+}
+
+func (f *fakeNetworking) CreateRouter(_ context.Context, opts routers.CreateOpts) (*routers.Router, error) {
+	return &routers.Router{ID: "r-1", Name: opts.Name, AvailabilityZoneHints: opts.AvailabilityZoneHints}, nil
+}
+
+func (f *fakeNetworking) CreateNetwork(_ context.Context, opts networks.CreateOpts) (*networks.Network, error) {
+	return &networks.Network{ID: "n-1", Name: opts.Name, AvailabilityZoneHints: opts.AvailabilityZoneHints}, nil
+}
+
+func (f *fakeNetworking) ListRouters(ctx context.Context, opts routers.ListOpts) ([]routers.Router, error) {
+	if f.listRoutersFn == nil {
+		return nil, fmt.Errorf("listRoutersFn not set")
+	}
+	return f.listRoutersFn(ctx, opts)
+}
+
+func (f *fakeNetworking) AddRoutesToRouter(_ context.Context, routes []routers.Route, _ string) (*routers.Router, error) {
+	f.addedRoutes = append(f.addedRoutes, routes...)
+	return &routers.Router{}, nil
+}
+
+func (f *fakeNetworking) RemoveRoutesFromRouter(_ context.Context, routes []routers.Route, _ string) (*routers.Router, error) {
+	f.removedRoutes = append(f.removedRoutes, routes...)
+	return &routers.Router{}, nil
 }
 
 func (f *fakeNetworking) ListSubnets(ctx context.Context, opts subnets.ListOpts) ([]subnets.Subnet, error) {
@@ -150,3 +273,301 @@ var _ = Describe("LookupFloatingPoolSubnetIDs", func() {
 		Expect(err).To(MatchError(ContainSubstring(pat)))
 	})
 })
+
+var _ = Describe("UpdateRouterRoutes", func() {
+	var (
+		ctx        context.Context
+		networking *fakeNetworking
+		a          access.NetworkingAccess
+
+		ccmRoute    = routers.Route{DestinationCIDR: "100.96.0.0/24", NextHop: "10.250.0.4"}
+		staticRoute = routers.Route{DestinationCIDR: "192.168.0.0/16", NextHop: "10.250.0.10"}
+		oldRoute    = routers.Route{DestinationCIDR: "172.16.0.0/12", NextHop: "10.250.0.10"}
+	)
+
+	newAccessWithRouterRoutes := func(routes ...routers.Route) {
+		networking = &fakeNetworking{
+			listRoutersFn: func(_ context.Context, opts routers.ListOpts) ([]routers.Router, error) {
+				Expect(opts.ID).To(Equal("router-1"))
+				return []routers.Router{{ID: "router-1", Routes: routes}}, nil
+			},
+		}
+		var err error
+		a, err = access.NewNetworkingAccess(networking, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	It("adds missing routes without touching foreign routes", func() {
+		newAccessWithRouterRoutes(ccmRoute)
+
+		modified, err := a.UpdateRouterRoutes(ctx, "router-1", []routers.Route{staticRoute}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(networking.addedRoutes).To(ConsistOf(staticRoute))
+		Expect(networking.removedRoutes).To(BeEmpty())
+	})
+
+	It("does nothing if all desired routes are present", func() {
+		newAccessWithRouterRoutes(ccmRoute, staticRoute)
+
+		modified, err := a.UpdateRouterRoutes(ctx, "router-1", []routers.Route{staticRoute}, []routers.Route{staticRoute})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeFalse())
+		Expect(networking.addedRoutes).To(BeEmpty())
+		Expect(networking.removedRoutes).To(BeEmpty())
+	})
+
+	It("removes obsolete routes which are present on the router only", func() {
+		newAccessWithRouterRoutes(ccmRoute, staticRoute, oldRoute)
+
+		modified, err := a.UpdateRouterRoutes(ctx, "router-1", []routers.Route{staticRoute}, []routers.Route{staticRoute, oldRoute, ccmRoute, {DestinationCIDR: "10.0.0.0/8", NextHop: "10.250.0.10"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(networking.addedRoutes).To(BeEmpty())
+		Expect(networking.removedRoutes).To(ConsistOf(oldRoute, ccmRoute))
+	})
+
+	It("returns an error if the router does not exist", func() {
+		networking = &fakeNetworking{
+			listRoutersFn: func(_ context.Context, _ routers.ListOpts) ([]routers.Router, error) {
+				return nil, nil
+			},
+		}
+		a, err := access.NewNetworkingAccess(networking, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = a.UpdateRouterRoutes(ctx, "router-1", []routers.Route{staticRoute}, nil)
+		Expect(err).To(MatchError(ContainSubstring("router router-1 was not found")))
+	})
+})
+
+var _ = Describe("UpdateSubnet", func() {
+	var (
+		ctx        context.Context
+		networking *fakeNetworking
+		a          access.NetworkingAccess
+
+		hostRoute = subnets.HostRoute{DestinationCIDR: "192.168.0.0/16", NextHop: "10.250.0.10"}
+		pool      = subnets.AllocationPool{Start: "10.250.0.100", End: "10.250.0.200"}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		networking = &fakeNetworking{}
+		var err error
+		a, err = access.NewNetworkingAccess(networking, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("does not update an unchanged subnet", func() {
+		current := &subnets.Subnet{ID: "s-1", Name: "foo", DNSNameservers: []string{"1.1.1.1"}, HostRoutes: []subnets.HostRoute{}}
+		desired := &subnets.Subnet{Name: "foo", DNSNameservers: []string{"1.1.1.1"}}
+
+		modified, err := a.UpdateSubnet(ctx, desired, current)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeFalse())
+		Expect(networking.subnetUpdates).To(BeEmpty())
+	})
+
+	It("updates host routes and allocation pools", func() {
+		current := &subnets.Subnet{ID: "s-1", Name: "foo"}
+		desired := &subnets.Subnet{Name: "foo", HostRoutes: []subnets.HostRoute{hostRoute}, AllocationPools: []subnets.AllocationPool{pool}}
+
+		modified, err := a.UpdateSubnet(ctx, desired, current)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(networking.subnetUpdates).To(HaveLen(1))
+		Expect(*networking.subnetUpdates[0].HostRoutes).To(ConsistOf(hostRoute))
+		Expect(networking.subnetUpdates[0].AllocationPools).To(ConsistOf(pool))
+	})
+
+	It("does not update allocation pools which only differ in their order", func() {
+		otherPool := subnets.AllocationPool{Start: "10.250.0.10", End: "10.250.0.20"}
+		current := &subnets.Subnet{ID: "s-1", Name: "foo", AllocationPools: []subnets.AllocationPool{pool, otherPool}}
+		desired := &subnets.Subnet{Name: "foo", AllocationPools: []subnets.AllocationPool{otherPool, pool}}
+
+		modified, err := a.UpdateSubnet(ctx, desired, current)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeFalse())
+		Expect(networking.subnetUpdates).To(BeEmpty())
+	})
+
+	It("clears removed host routes but keeps the allocation pools", func() {
+		current := &subnets.Subnet{ID: "s-1", Name: "foo", HostRoutes: []subnets.HostRoute{hostRoute}, AllocationPools: []subnets.AllocationPool{pool}}
+		desired := &subnets.Subnet{Name: "foo"}
+
+		modified, err := a.UpdateSubnet(ctx, desired, current)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(networking.subnetUpdates).To(HaveLen(1))
+		Expect(*networking.subnetUpdates[0].HostRoutes).To(BeEmpty())
+		Expect(networking.subnetUpdates[0].AllocationPools).To(BeNil())
+	})
+})
+
+var _ = Describe("AvailabilityZoneHints", func() {
+	var (
+		ctx context.Context
+		a   access.NetworkingAccess
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		a, err = access.NewNetworkingAccess(&fakeNetworking{}, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("applies the hints when creating a router", func() {
+		router, err := a.CreateRouter(ctx, &access.Router{Name: "foo", AvailabilityZoneHints: []string{"zone-a"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(router.AvailabilityZoneHints).To(ConsistOf("zone-a"))
+	})
+
+	It("applies the hints when creating a network", func() {
+		network, err := a.CreateNetwork(ctx, &access.Network{Name: "foo", AvailabilityZoneHints: []string{"zone-a", "zone-b"}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(network.AvailabilityZoneHints).To(ConsistOf("zone-a", "zone-b"))
+	})
+})
+
+var _ = Describe("UpdateQoSPolicyRules", func() {
+	var (
+		ctx        context.Context
+		networking *fakeNetworking
+		a          access.NetworkingAccess
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		networking = &fakeNetworking{}
+		var err error
+		a, err = access.NewNetworkingAccess(networking, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("creates missing rules", func() {
+		modified, err := a.UpdateQoSPolicyRules(ctx, "p-1", &access.QoSPolicyRules{
+			BandwidthLimitRules:   []qosrules.BandwidthLimitRule{{MaxKBps: 1000, Direction: "egress"}},
+			MinimumBandwidthRules: []qosrules.MinimumBandwidthRule{{MinKBps: 100, Direction: "egress"}},
+			DSCPMarkingRules:      []qosrules.DSCPMarkingRule{{DSCPMark: 26}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(networking.createdQoSRuleCount).To(Equal(3))
+		Expect(networking.deletedQoSRuleIDs).To(BeEmpty())
+	})
+
+	It("keeps matching rules and replaces changed ones", func() {
+		networking.bandwidthLimitRules = []qosrules.BandwidthLimitRule{
+			{ID: "bw-1", MaxKBps: 1000, Direction: "egress"},
+			{ID: "bw-2", MaxKBps: 1000, Direction: "ingress"},
+		}
+		networking.dscpMarkingRules = []qosrules.DSCPMarkingRule{{ID: "dscp-1", DSCPMark: 26}}
+
+		modified, err := a.UpdateQoSPolicyRules(ctx, "p-1", &access.QoSPolicyRules{
+			BandwidthLimitRules: []qosrules.BandwidthLimitRule{
+				{MaxKBps: 1000, Direction: "egress"},
+				{MaxKBps: 2000, Direction: "ingress"},
+			},
+			DSCPMarkingRules: []qosrules.DSCPMarkingRule{{DSCPMark: 26}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(networking.deletedQoSRuleIDs).To(ConsistOf("bw-2"))
+		Expect(networking.createdQoSRuleCount).To(Equal(1))
+	})
+
+	It("does nothing if the rules are up to date", func() {
+		networking.minimumBandwidthRules = []qosrules.MinimumBandwidthRule{{ID: "min-1", MinKBps: 100, Direction: "egress"}}
+
+		modified, err := a.UpdateQoSPolicyRules(ctx, "p-1", &access.QoSPolicyRules{
+			MinimumBandwidthRules: []qosrules.MinimumBandwidthRule{{MinKBps: 100, Direction: "egress"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeFalse())
+		Expect(networking.createdQoSRuleCount).To(BeZero())
+		Expect(networking.deletedQoSRuleIDs).To(BeEmpty())
+	})
+})
+
+var _ = Describe("UpdateFirewallPolicyRules", func() {
+	var (
+		ctx        context.Context
+		networking *fakeNetworking
+		a          access.NetworkingAccess
+		policy     *fwpolicies.Policy
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		networking = &fakeNetworking{}
+		var err error
+		a, err = access.NewNetworkingAccess(networking, logr.Discard())
+		Expect(err).NotTo(HaveOccurred())
+		policy = &fwpolicies.Policy{ID: "p-1", Name: "shoot--foo--bar-egress"}
+	})
+
+	It("creates missing rules and sets them in order", func() {
+		networking.firewallRules = []fwrules.Rule{{ID: "r-1", Action: "allow", IPVersion: 4, DestinationIPAddress: "10.250.0.0/16"}}
+		policy.Rules = []string{"r-1"}
+
+		modified, err := a.UpdateFirewallPolicyRules(ctx, policy, []fwrules.Rule{
+			{Protocol: "tcp", Action: "deny", IPVersion: 4, DestinationPort: "22"},
+			{Protocol: "any", Action: "allow", IPVersion: 4, DestinationIPAddress: "10.250.0.0/16"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(networking.createdFirewallRules).To(HaveLen(1))
+		Expect(networking.createdFirewallRules[0].Protocol).To(Equal(fwrules.ProtocolTCP))
+		Expect(networking.createdFirewallRules[0].Name).To(Equal("shoot--foo--bar-egress"))
+		Expect(networking.firewallPolicyUpdates).To(HaveLen(1))
+		Expect(*networking.firewallPolicyUpdates[0].FirewallRules).To(Equal([]string{"new-1", "r-1"}))
+		Expect(networking.deletedFirewallRuleIDs).To(BeEmpty())
+	})
+
+	It("deletes obsolete rules after updating the policy", func() {
+		networking.firewallRules = []fwrules.Rule{
+			{ID: "r-1", Action: "allow", IPVersion: 4},
+			{ID: "r-2", Protocol: "udp", Action: "reject", IPVersion: 4, DestinationPort: "53"},
+		}
+		policy.Rules = []string{"r-1", "r-2"}
+
+		modified, err := a.UpdateFirewallPolicyRules(ctx, policy, []fwrules.Rule{{Protocol: "any", Action: "allow", IPVersion: 4}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(networking.createdFirewallRules).To(BeEmpty())
+		Expect(*networking.firewallPolicyUpdates[0].FirewallRules).To(Equal([]string{"r-1"}))
+		Expect(networking.deletedFirewallRuleIDs).To(ConsistOf("r-2"))
+	})
+
+	It("deletes the created rules again if the policy cannot be updated", func() {
+		networking.firewallRules = []fwrules.Rule{{ID: "r-1", Action: "allow", IPVersion: 4}}
+		networking.firewallPolicyErr = errors.New("conflict")
+		policy.Rules = []string{"r-1"}
+
+		_, err := a.UpdateFirewallPolicyRules(ctx, policy, []fwrules.Rule{
+			{Protocol: "tcp", Action: "deny", IPVersion: 4, DestinationPort: "22"},
+			{Protocol: "udp", Action: "deny", IPVersion: 4, DestinationPort: "53"},
+			{Protocol: "any", Action: "allow", IPVersion: 4},
+		})
+		Expect(err).To(MatchError(ContainSubstring("conflict")))
+		Expect(networking.createdFirewallRules).To(HaveLen(2))
+		Expect(networking.deletedFirewallRuleIDs).To(ConsistOf("new-1", "new-2"))
+	})
+
+	It("does nothing if the rules are up to date", func() {
+		networking.firewallRules = []fwrules.Rule{{ID: "r-1", Action: "allow", IPVersion: 6}}
+		policy.Rules = []string{"r-1"}
+
+		modified, err := a.UpdateFirewallPolicyRules(ctx, policy, []fwrules.Rule{{Protocol: "any", Action: "allow", IPVersion: 6}})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(modified).To(BeFalse())
+		Expect(networking.firewallPolicyUpdates).To(BeEmpty())
+		Expect(networking.deletedFirewallRuleIDs).To(BeEmpty())
+	})
+})
@@ -56,6 +56,14 @@ const (
 	IdentifierServiceSubnetIPv6CIDR = "ServiceSubnetIPv6CIDR"
 	// IdentifierWorkersCIDR is the key for the workers subnet CIDR allocated from a subnet pool.
 	IdentifierWorkersCIDR = "WorkersCIDR"
+	// IdentifierQoSPolicy is the key for the id of the QoS policy created for the shoot
+	IdentifierQoSPolicy = "QoSPolicy"
+	// IdentifierFirewallGroup is the key for the id of the firewall group created for the shoot
+	IdentifierFirewallGroup = "FirewallGroup"
+	// IdentifierFirewallEgressPolicy is the key for the id of the firewall policy for the shoot's egress traffic
+	IdentifierFirewallEgressPolicy = "FirewallEgressPolicy"
+	// IdentifierFirewallIngressPolicy is the key for the id of the firewall policy for the shoot's ingress traffic
+	IdentifierFirewallIngressPolicy = "FirewallIngressPolicy"
 
 	// NameFloatingNetwork is the key for the floating network name
 	NameFloatingNetwork = "FloatingNetworkName"
@@ -72,10 +80,23 @@ const (
 
 	// RouterIP is the key for the router IP address
 	RouterIP = "RouterIP"
+	// RouterRoutes is the key for the static routes added to the router as configured in the InfrastructureConfig
+	RouterRoutes = "RouterRoutes"
+	// RouterAvailabilityZones is the key for the availability zones the router is scheduled in
+	RouterAvailabilityZones = "RouterAvailabilityZones"
+	// NetworkAvailabilityZones is the key for the availability zones the network is scheduled in
+	NetworkAvailabilityZones = "NetworkAvailabilityZones"
 
 	// ObjectSecGroup is the key for the cached security group
 	ObjectSecGroup = "SecurityGroup"
 
+	// AllocationPoolsManaged is the key for the marker that the allocation pools of the node subnet were set from the
+	// InfrastructureConfig
+	AllocationPoolsManaged = "AllocationPoolsManaged"
+	// VolumeTypeReferences is the key for the fingerprints of the volume type references of the shoot at the last
+	// successful reconciliation
+	VolumeTypeReferences = "VolumeTypeReferences"
+
 	// CreatedResourcesExistKey marks that there are infrastructure resources created by Gardener.
 	CreatedResourcesExistKey = "resource_exist"
 )
@@ -88,6 +109,9 @@ type Opts struct {
 	Cluster        *extensionscontroller.Cluster
 	State          *openstackapi.InfrastructureState
 	Client         client.Client
+	// VolumeTypeReferences are the fingerprints of the volume type references of the shoot. They are recorded in the
+	// state after a successful reconciliation.
+	VolumeTypeReferences string
 }
 
 // FlowContext contains the logic to reconcile or delete the infrastructure.
@@ -104,6 +128,8 @@ type FlowContext struct {
 	access                 access.NetworkingAccess
 	compute                osclient.Compute
 	shootNetworking        *gardencorev1beta1.Networking
+	volumeTypeReferences   string
+	workerZones            []string
 
 	*shared.BasicFlowContext
 }
@@ -153,6 +179,8 @@ func NewFlowContext(opts Opts) (*FlowContext, error) {
 		client:                 opts.Client,
 		openstackClientFactory: opts.ClientFactory,
 		shootNetworking:        opts.Cluster.Shoot.Spec.Networking,
+		volumeTypeReferences:   opts.VolumeTypeReferences,
+		workerZones:            workerZones(opts.Cluster.Shoot.Spec.Provider.Workers),
 	}
 	return flowContext, nil
 }
@@ -253,7 +281,10 @@ func (fctx *FlowContext) computeInfrastructureStatus() *openstackv1alpha1.Infras
 	status.Networks.ID = ptr.Deref(fctx.state.Get(IdentifierNetwork), "")
 	status.Networks.Name = ptr.Deref(fctx.state.Get(NameNetwork), "")
 
+	status.Networks.AvailabilityZones = splitAvailabilityZones(fctx.state.Get(NetworkAvailabilityZones))
+
 	status.Networks.Router.ID = ptr.Deref(fctx.state.Get(IdentifierRouter), "")
+	status.Networks.Router.AvailabilityZones = splitAvailabilityZones(fctx.state.Get(RouterAvailabilityZones))
 	status.Networks.Router.ExternalFixedIPs = fctx.state.GetObject(IdentifierEgressCIDRs).([]string)
 	// backwards compatibility change for the deprecated field
 	if len(status.Networks.Router.ExternalFixedIPs) > 0 {
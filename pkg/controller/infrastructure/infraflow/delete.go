@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
+	fwgroups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/sharenetworks"
 	"k8s.io/utils/ptr"
 
@@ -35,7 +36,7 @@ func (fctx *FlowContext) buildDeleteGraph() *flow.Graph {
 	g := flow.NewGraph("Openstack infrastructure destruction")
 
 	needToDeleteNetwork := fctx.config.Networks.ID == nil
-	needToDeleteRouter := fctx.config.Networks.Router == nil
+	needToDeleteRouter := !fctx.hasConfiguredRouter()
 
 	_ = fctx.AddTask(g, "delete ssh key pair",
 		fctx.deleteSSHKeyPair,
@@ -68,6 +69,11 @@ func (fctx *FlowContext) buildDeleteGraph() *flow.Graph {
 		shared.Timeout(defaultTimeout),
 		shared.Dependencies(recoverIDs),
 	)
+	routerRoutes := fctx.AddTask(g, "delete router routes",
+		fctx.deleteRouterRoutes,
+		shared.Timeout(defaultTimeout),
+		shared.Dependencies(recoverIDs, k8sRoutes),
+	)
 	k8sLoadBalancers := fctx.AddTask(g, "delete kubernetes loadbalancers",
 		func(ctx context.Context) error {
 			subnetID := fctx.state.Get(IdentifierSubnet)
@@ -95,12 +101,15 @@ func (fctx *FlowContext) buildDeleteGraph() *flow.Graph {
 	_ = fctx.AddTask(g, "delete share network",
 		fctx.deleteShareNetwork,
 		shared.Timeout(defaultTimeout), shared.Dependencies(recoverIDs))
+	deleteFirewall := fctx.AddTask(g, "delete firewall",
+		fctx.deleteFirewall,
+		shared.Timeout(defaultTimeout), shared.Dependencies(recoverIDs))
 	deleteRouterInterface := fctx.AddTask(g, "delete router interface",
 		fctx.deleteRouterInterface,
-		shared.Timeout(defaultTimeout), shared.Dependencies(recoverIDs, k8sRoutes))
+		shared.Timeout(defaultTimeout), shared.Dependencies(recoverIDs, k8sRoutes, routerRoutes, deleteFirewall))
 	deleteRouterInterfaceIPv6 := fctx.AddTask(g, "delete IPv6 router interface",
 		fctx.deleteRouterInterfaceIPv6,
-		shared.Timeout(defaultTimeout), shared.Dependencies(recoverIDs, k8sRoutes))
+		shared.Timeout(defaultTimeout), shared.Dependencies(recoverIDs, k8sRoutes, routerRoutes, deleteFirewall))
 
 	// subnet deletion only needed if network is given by spec
 	_ = fctx.AddTask(g, "delete subnet",
@@ -109,12 +118,15 @@ func (fctx *FlowContext) buildDeleteGraph() *flow.Graph {
 	_ = fctx.AddTask(g, "delete IPv6 subnet",
 		fctx.deleteSubnetIPv6,
 		shared.DoIf(!needToDeleteNetwork), shared.Timeout(defaultTimeout), shared.Dependencies(deleteRouterInterfaceIPv6, k8sLoadBalancersIPv6))
-	_ = fctx.AddTask(g, "delete network",
+	deleteNetwork := fctx.AddTask(g, "delete network",
 		fctx.deleteNetwork,
 		shared.DoIf(needToDeleteNetwork), shared.Timeout(defaultTimeout), shared.Dependencies(deleteRouterInterface, deleteRouterInterfaceIPv6))
 	_ = fctx.AddTask(g, "delete router",
 		fctx.deleteRouter,
 		shared.DoIf(needToDeleteRouter), shared.Timeout(defaultTimeout), shared.Dependencies(deleteRouterInterface, deleteRouterInterfaceIPv6))
+	_ = fctx.AddTask(g, "delete QoS policy",
+		fctx.deleteQoSPolicy,
+		shared.DoIf(needToDeleteNetwork), shared.Timeout(defaultTimeout), shared.Dependencies(deleteNetwork))
 	_ = fctx.AddTask(g, "cleanup marker",
 		func(_ context.Context) error {
 			fctx.state.Set(CreatedResourcesExistKey, "")
@@ -155,6 +167,77 @@ func (fctx *FlowContext) deleteNetwork(ctx context.Context) error {
 	return nil
 }
 
+func (fctx *FlowContext) deleteQoSPolicy(ctx context.Context) error {
+	// avoid looking up QoS policies by name if none was ever created, as the QoS extension may not be available
+	if fctx.state.Get(IdentifierQoSPolicy) == nil && !fctx.hasQoSPolicyRules() {
+		return nil
+	}
+
+	current, err := fctx.findExistingQoSPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		shared.LogFromContext(ctx).Info("deleting...", "qosPolicy", current.ID)
+		if err := fctx.networking.DeleteQoSPolicy(ctx, current.ID); client.IgnoreNotFoundError(err) != nil {
+			return err
+		}
+	}
+	fctx.state.Set(IdentifierQoSPolicy, "")
+	return nil
+}
+
+func (fctx *FlowContext) deleteFirewall(ctx context.Context) error {
+	// avoid looking up firewall resources by name if none was ever created, as the FWaaS extension may not be available
+	if fctx.config.Networks.Firewall == nil && fctx.state.Get(IdentifierFirewallGroup) == nil &&
+		fctx.state.Get(IdentifierFirewallEgressPolicy) == nil && fctx.state.Get(IdentifierFirewallIngressPolicy) == nil {
+		return nil
+	}
+
+	log := shared.LogFromContext(ctx)
+	group, err := fctx.findExistingFirewallGroup(ctx)
+	if err != nil {
+		return err
+	}
+	if group != nil {
+		log.Info("deleting...", "firewallGroup", group.ID)
+		if len(group.Ports) > 0 {
+			// ports have to be detached before the firewall group can be deleted
+			if _, err := fctx.networking.UpdateFirewallGroup(ctx, group.ID, fwgroups.UpdateOpts{Ports: &[]string{}}); client.IgnoreNotFoundError(err) != nil {
+				return err
+			}
+		}
+		if err := fctx.networking.DeleteFirewallGroup(ctx, group.ID); client.IgnoreNotFoundError(err) != nil {
+			return err
+		}
+	}
+	fctx.state.Set(IdentifierFirewallGroup, "")
+
+	for identifier, name := range map[string]string{
+		IdentifierFirewallEgressPolicy:  fctx.defaultFirewallPolicyName("egress"),
+		IdentifierFirewallIngressPolicy: fctx.defaultFirewallPolicyName("ingress"),
+	} {
+		policy, err := fctx.findExistingFirewallPolicy(ctx, identifier, name)
+		if err != nil {
+			return err
+		}
+		if policy != nil {
+			log.Info("deleting...", "firewallPolicy", policy.ID)
+			if err := fctx.networking.DeleteFirewallPolicy(ctx, policy.ID); client.IgnoreNotFoundError(err) != nil {
+				return err
+			}
+			// rules can only be deleted after they have been removed from the policy
+			for _, ruleID := range policy.Rules {
+				if err := fctx.networking.DeleteFirewallRule(ctx, ruleID); client.IgnoreNotFoundError(err) != nil {
+					return err
+				}
+			}
+		}
+		fctx.state.Set(identifier, "")
+	}
+	return nil
+}
+
 func (fctx *FlowContext) deleteSubnet(ctx context.Context) error {
 	subnetID := fctx.state.Get(IdentifierSubnet)
 	if subnetID == nil {
@@ -185,8 +268,27 @@ func (fctx *FlowContext) deleteSubnetIPv6(ctx context.Context) error {
 	return nil
 }
 
+func (fctx *FlowContext) deleteRouterRoutes(ctx context.Context) error {
+	routerID := fctx.state.Get(IdentifierRouter)
+	if routerID == nil {
+		return nil
+	}
+
+	obsolete := append(routesFromString(ptr.Deref(fctx.state.Get(RouterRoutes), "")), fctx.desiredRouterRoutes()...)
+	if len(obsolete) == 0 {
+		return nil
+	}
+
+	shared.LogFromContext(ctx).Info("deleting...", "router", *routerID)
+	if _, err := fctx.access.UpdateRouterRoutes(ctx, *routerID, nil, obsolete); err != nil {
+		return err
+	}
+	fctx.state.Set(RouterRoutes, "")
+	return nil
+}
+
 func (fctx *FlowContext) recoverRouterID(ctx context.Context) error {
-	if fctx.config.Networks.Router != nil {
+	if fctx.hasConfiguredRouter() {
 		fctx.state.Set(IdentifierRouter, fctx.config.Networks.Router.ID)
 		return nil
 	}
@@ -15,10 +15,15 @@ import (
 	gardenv1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	fwgroups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/groups"
+	fwpolicies "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/policies"
+	fwrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	"github.com/gophercloud/gophercloud/v2/openstack/sharedfilesystems/v2/sharenetworks"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
@@ -42,6 +47,7 @@ func (fctx *FlowContext) Reconcile(ctx context.Context) error {
 		return errors.Join(flow.Causes(err), fctx.persistState(ctx))
 	}
 
+	fctx.state.Set(VolumeTypeReferences, fctx.volumeTypeReferences)
 	state := fctx.computeInfrastructureState()
 	status := fctx.computeInfrastructureStatus()
 	networkingStatus := fctx.computeInfrastructureNetworkingStatus()
@@ -69,6 +75,14 @@ func (fctx *FlowContext) buildReconcileGraph() *flow.Graph {
 		fctx.ensureNetwork,
 		shared.Timeout(defaultTimeout), shared.Dependencies(prehook))
 
+	ensureQoSPolicy := fctx.AddTask(g, "ensure QoS policy",
+		fctx.ensureQoSPolicy,
+		shared.Timeout(defaultTimeout), shared.Dependencies(prehook))
+
+	_ = fctx.AddTask(g, "ensure network QoS policy",
+		fctx.ensureNetworkQoSPolicy,
+		shared.Timeout(defaultTimeout), shared.Dependencies(ensureNetwork, ensureQoSPolicy), shared.DoIf(fctx.config.Networks.ID == nil))
+
 	ensureSubnet := fctx.AddTask(g, "ensure subnet",
 		fctx.ensureSubnet,
 		shared.Timeout(defaultTimeout), shared.Dependencies(ensureNetwork))
@@ -77,20 +91,32 @@ func (fctx *FlowContext) buildReconcileGraph() *flow.Graph {
 		fctx.ensureSubnetIPv6,
 		shared.Timeout(defaultTimeout), shared.Dependencies(ensureNetwork), shared.DoIf(fctx.isDualStack()))
 
-	_ = fctx.AddTask(g, "ensure router interface",
+	ensureRouterInterface := fctx.AddTask(g, "ensure router interface",
 		fctx.ensureRouterInterface,
 		shared.Timeout(defaultTimeout), shared.Dependencies(ensureRouter, ensureSubnet))
 
-	_ = fctx.AddTask(g, "ensure IPv6 router interface",
+	ensureRouterInterfaceIPv6 := fctx.AddTask(g, "ensure IPv6 router interface",
 		fctx.ensureRouterInterfaceIPv6,
 		shared.Timeout(defaultTimeout), shared.Dependencies(ensureRouter, ensureSubnetIPv6), shared.DoIf(fctx.isDualStack()))
 
-	_ = fctx.AddTask(g, "ensure IPv6 CIDR services", fctx.ensureIPv6CIDRs,
+	_ = fctx.AddTask(g, "ensure router routes",
+		fctx.ensureRouterRoutes,
+		shared.Timeout(defaultTimeout), shared.Dependencies(ensureRouterInterface, ensureRouterInterfaceIPv6))
+
+	_ = fctx.AddTask(g, "ensure availability zones",
+		fctx.ensureAvailabilityZones,
+		shared.Timeout(defaultTimeout), shared.Dependencies(ensureRouterInterface, ensureSubnet))
+
+	ensureIPv6CIDRs := fctx.AddTask(g, "ensure IPv6 CIDR services", fctx.ensureIPv6CIDRs,
 		shared.Timeout(defaultTimeout),
 		shared.Dependencies(ensureSubnetIPv6),
 		shared.DoIf(fctx.isDualStack()),
 	)
 
+	_ = fctx.AddTask(g, "ensure firewall",
+		fctx.ensureFirewall,
+		shared.Timeout(defaultTimeout), shared.Dependencies(ensureRouterInterface, ensureRouterInterfaceIPv6, ensureIPv6CIDRs))
+
 	ensureSecGroup := fctx.AddTask(g, "ensure security group",
 		fctx.ensureSecGroup,
 		shared.Timeout(defaultTimeout), shared.Dependencies(ensureRouter))
@@ -130,7 +156,7 @@ func (fctx *FlowContext) ensureRouter(ctx context.Context) error {
 		return fmt.Errorf("missing external network ID")
 	}
 
-	if fctx.config.Networks.Router != nil {
+	if fctx.hasConfiguredRouter() {
 		return fctx.ensureConfiguredRouter(ctx)
 	}
 	return fctx.ensureNewRouter(ctx, *externalNetworkID)
@@ -159,9 +185,10 @@ func (fctx *FlowContext) ensureNewRouter(ctx context.Context, externalNetworkID
 	log := shared.LogFromContext(ctx)
 
 	desired := &access.Router{
-		Name:              fctx.defaultRouterName(),
-		ExternalNetworkID: externalNetworkID,
-		EnableSNAT:        fctx.cloudProfileConfig.UseSNAT,
+		Name:                  fctx.defaultRouterName(),
+		ExternalNetworkID:     externalNetworkID,
+		EnableSNAT:            fctx.cloudProfileConfig.UseSNAT,
+		AvailabilityZoneHints: fctx.availabilityZoneHints(),
 	}
 	current, err := fctx.findExistingRouter(ctx)
 	if err != nil {
@@ -245,8 +272,9 @@ func (fctx *FlowContext) ensureNewNetwork(ctx context.Context) error {
 	log := shared.LogFromContext(ctx)
 
 	desired := &access.Network{
-		Name:         fctx.defaultNetworkName(),
-		AdminStateUp: true,
+		Name:                  fctx.defaultNetworkName(),
+		AdminStateUp:          true,
+		AvailabilityZoneHints: fctx.availabilityZoneHints(),
 	}
 	current, err := fctx.findExistingNetwork(ctx)
 	if err != nil {
@@ -275,6 +303,90 @@ func (fctx *FlowContext) findExistingNetwork(ctx context.Context) (*access.Netwo
 	return findExisting(ctx, fctx.state.Get(IdentifierNetwork), fctx.defaultNetworkName(), fctx.access.GetNetworkByID, fctx.access.GetNetworkByName)
 }
 
+func (fctx *FlowContext) ensureQoSPolicy(ctx context.Context) error {
+	if !fctx.hasQoSPolicyRules() {
+		// an obsolete QoS policy is deleted after it has been detached from the network
+		return nil
+	}
+
+	log := shared.LogFromContext(ctx)
+	current, err := fctx.findExistingQoSPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		log.Info("creating...")
+		current, err = fctx.networking.CreateQoSPolicy(ctx, policies.CreateOpts{
+			Name: fctx.defaultQoSPolicyName(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	fctx.state.Set(IdentifierQoSPolicy, current.ID)
+
+	if _, err := fctx.access.UpdateQoSPolicyRules(ctx, current.ID, fctx.desiredQoSPolicyRules()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (fctx *FlowContext) findExistingQoSPolicy(ctx context.Context) (*policies.Policy, error) {
+	return findExisting(ctx, fctx.state.Get(IdentifierQoSPolicy), fctx.defaultQoSPolicyName(), fctx.networking.GetQoSPolicy,
+		func(ctx context.Context, name string) ([]*policies.Policy, error) {
+			list, err := fctx.networking.ListQoSPolicies(ctx, policies.ListOpts{Name: name})
+			if err != nil {
+				return nil, err
+			}
+			return sliceToPtr(list), nil
+		})
+}
+
+func (fctx *FlowContext) ensureNetworkQoSPolicy(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	networkID := fctx.state.Get(IdentifierNetwork)
+	if networkID == nil {
+		return fmt.Errorf("missing cluster network ID")
+	}
+
+	var desired string
+	if qos := fctx.config.Networks.QoSPolicy; qos != nil && qos.ID != nil {
+		policy, err := fctx.networking.GetQoSPolicy(ctx, *qos.ID)
+		if err != nil {
+			return err
+		}
+		if policy == nil {
+			return gardenv1beta1helper.NewErrorWithCodes(
+				fmt.Errorf("QoS policy with ID '%s' was not found", *qos.ID),
+				gardencorev1beta1.ErrorInfraDependencies,
+			)
+		}
+		desired = policy.ID
+	} else if qos != nil {
+		desired = ptr.Deref(fctx.state.Get(IdentifierQoSPolicy), "")
+		if desired == "" {
+			return fmt.Errorf("missing QoS policy ID")
+		}
+	}
+
+	current, err := fctx.networking.GetNetworkQoSPolicyID(ctx, *networkID)
+	if err != nil {
+		return err
+	}
+	if current != desired {
+		log.Info("updating...", "qosPolicy", desired)
+		if err := fctx.networking.UpdateNetworkQoSPolicyID(ctx, *networkID, desired); err != nil {
+			return err
+		}
+	}
+
+	if !fctx.hasQoSPolicyRules() {
+		return fctx.deleteQoSPolicy(ctx)
+	}
+	return nil
+}
+
 func (fctx *FlowContext) getNetworkID(ctx context.Context) (*string, error) {
 	if fctx.config.Networks.ID != nil {
 		return fctx.config.Networks.ID, nil
@@ -303,10 +415,12 @@ func (fctx *FlowContext) ensureSubnet(ctx context.Context) error {
 	networkID := ptr.Deref(fctx.state.Get(IdentifierNetwork), "")
 
 	desired := &subnets.Subnet{
-		Name:           fctx.defaultSubnetName(),
-		NetworkID:      networkID,
-		IPVersion:      4,
-		DNSNameservers: filterDNSServersByIPFamily(fctx.cloudProfileConfig.DNSServers, gardencorev1beta1.IPFamilyIPv4),
+		Name:            fctx.defaultSubnetName(),
+		NetworkID:       networkID,
+		IPVersion:       4,
+		DNSNameservers:  filterDNSServersByIPFamily(fctx.dnsServers(), gardencorev1beta1.IPFamilyIPv4),
+		HostRoutes:      fctx.desiredHostRoutes(),
+		AllocationPools: fctx.desiredAllocationPools(),
 	}
 	if fctx.config.Networks.SubnetPool != nil {
 		desired.SubnetPoolID = fctx.config.Networks.SubnetPool.ID
@@ -319,10 +433,24 @@ func (fctx *FlowContext) ensureSubnet(ctx context.Context) error {
 	}
 	if current != nil {
 		fctx.state.Set(IdentifierSubnet, current.ID)
+		// Only allocation pools which were set from the InfrastructureConfig are reset when they are removed from it, so
+		// that pools which were changed by operators are kept.
+		resetAllocationPools := len(desired.AllocationPools) == 0 && fctx.state.Get(AllocationPoolsManaged) != nil
+		if resetAllocationPools {
+			desired.AllocationPools, err = defaultAllocationPools(current.CIDR, current.GatewayIP)
+			if err != nil {
+				return err
+			}
+		}
 		log.Info("updating...")
 		if _, err := fctx.access.UpdateSubnet(ctx, desired, current); err != nil {
 			return err
 		}
+		if resetAllocationPools {
+			fctx.state.Set(AllocationPoolsManaged, "")
+		} else if len(desired.AllocationPools) > 0 {
+			fctx.state.Set(AllocationPoolsManaged, "true")
+		}
 		if fctx.config.Networks.SubnetPool != nil {
 			fctx.state.Set(IdentifierWorkersCIDR, current.CIDR)
 		}
@@ -337,6 +465,9 @@ func (fctx *FlowContext) ensureSubnet(ctx context.Context) error {
 			return err
 		}
 		fctx.state.Set(IdentifierSubnet, created.ID)
+		if len(desired.AllocationPools) > 0 {
+			fctx.state.Set(AllocationPoolsManaged, "true")
+		}
 		if fctx.config.Networks.SubnetPool != nil {
 			allocatedCIDR, err := fctx.waitForSubnetCIDR(ctx, log, created.ID)
 			if err != nil {
@@ -378,7 +509,7 @@ func (fctx *FlowContext) ensureSubnetIPv6(ctx context.Context) error {
 			NetworkID:       networkID,
 			CIDR:            nodeCIDR,
 			IPVersion:       6,
-			DNSNameservers:  filterDNSServersByIPFamily(fctx.cloudProfileConfig.DNSServers, gardencorev1beta1.IPFamilyIPv6),
+			DNSNameservers:  filterDNSServersByIPFamily(fctx.dnsServers(), gardencorev1beta1.IPFamilyIPv6),
 			IPv6RAMode:      "slaac",
 			IPv6AddressMode: "slaac",
 			SubnetPoolID:    subnetPoolID,
@@ -388,7 +519,7 @@ func (fctx *FlowContext) ensureSubnetIPv6(ctx context.Context) error {
 			NetworkID:      networkID,
 			CIDR:           podCIDR,
 			IPVersion:      6,
-			DNSNameservers: filterDNSServersByIPFamily(fctx.cloudProfileConfig.DNSServers, gardencorev1beta1.IPFamilyIPv6),
+			DNSNameservers: filterDNSServersByIPFamily(fctx.dnsServers(), gardencorev1beta1.IPFamilyIPv6),
 			SubnetPoolID:   subnetPoolID,
 		},
 		{
@@ -396,7 +527,7 @@ func (fctx *FlowContext) ensureSubnetIPv6(ctx context.Context) error {
 			NetworkID:      networkID,
 			CIDR:           serviceCIDR,
 			IPVersion:      6,
-			DNSNameservers: filterDNSServersByIPFamily(fctx.cloudProfileConfig.DNSServers, gardencorev1beta1.IPFamilyIPv6),
+			DNSNameservers: filterDNSServersByIPFamily(fctx.dnsServers(), gardencorev1beta1.IPFamilyIPv6),
 			SubnetPoolID:   subnetPoolID,
 		},
 	}
@@ -561,6 +692,31 @@ func (fctx *FlowContext) ensureRouterInterfaceIPv6(ctx context.Context) error {
 	return fctx.access.AddRouterInterfaceAndWait(ctx, *routerID, *subnetIPv6ID)
 }
 
+func (fctx *FlowContext) ensureRouterRoutes(ctx context.Context) error {
+	log := shared.LogFromContext(ctx)
+
+	routerID := fctx.state.Get(IdentifierRouter)
+	if routerID == nil {
+		return fmt.Errorf("internal error: missing routerID")
+	}
+
+	desired := fctx.desiredRouterRoutes()
+	previous := routesFromString(ptr.Deref(fctx.state.Get(RouterRoutes), ""))
+	if len(desired) == 0 && len(previous) == 0 {
+		return nil
+	}
+
+	modified, err := fctx.access.UpdateRouterRoutes(ctx, *routerID, desired, previous)
+	if err != nil {
+		return err
+	}
+	if modified {
+		log.Info("updated router routes")
+	}
+	fctx.state.Set(RouterRoutes, routesToString(desired))
+	return nil
+}
+
 func (fctx *FlowContext) ensureSecGroup(ctx context.Context) error {
 	log := shared.LogFromContext(ctx)
 
@@ -764,6 +920,157 @@ func (fctx *FlowContext) ensureShareNetwork(ctx context.Context) error {
 	return nil
 }
 
+// ensureAvailabilityZones records the availability zones the router and the network are scheduled in.
+// Neutron schedules both resources once they are attached to agents, hence this is done after the router interface
+// and the subnet have been created.
+func (fctx *FlowContext) ensureAvailabilityZones(ctx context.Context) error {
+	if routerID := ptr.Deref(fctx.state.Get(IdentifierRouter), ""); routerID != "" {
+		zones, err := fctx.networking.GetRouterAvailabilityZones(ctx, routerID)
+		if err != nil {
+			return err
+		}
+		fctx.state.Set(RouterAvailabilityZones, strings.Join(zones, ","))
+	}
+	if networkID := ptr.Deref(fctx.state.Get(IdentifierNetwork), ""); networkID != "" {
+		zones, err := fctx.networking.GetNetworkAvailabilityZones(ctx, networkID)
+		if err != nil {
+			return err
+		}
+		fctx.state.Set(NetworkAvailabilityZones, strings.Join(zones, ","))
+	}
+	return nil
+}
+
+// ensureFirewall attaches a firewall group to the router ports of the shoot's subnets. The firewall group filters the
+// traffic entering the router from the port with its ingress policy, hence the egress rules of the shoot are
+// configured as ingress policy of the firewall group and vice versa.
+func (fctx *FlowContext) ensureFirewall(ctx context.Context) error {
+	firewall := fctx.config.Networks.Firewall
+	if firewall == nil {
+		return fctx.deleteFirewall(ctx)
+	}
+
+	log := shared.LogFromContext(ctx)
+	routerID := fctx.state.Get(IdentifierRouter)
+	if routerID == nil {
+		return fmt.Errorf("internal error: missing routerID")
+	}
+	subnetIDs := []*string{fctx.state.Get(IdentifierSubnet)}
+	if fctx.isDualStack() {
+		subnetIDs = append(subnetIDs, fctx.state.Get(IdentifierSubnetIPv6))
+	}
+	var ports []string
+	for _, subnetID := range subnetIDs {
+		if subnetID == nil {
+			return fmt.Errorf("internal error: missing subnetID")
+		}
+		portID, err := fctx.access.GetRouterInterfacePortID(ctx, *routerID, *subnetID)
+		if err != nil {
+			return err
+		}
+		if portID == nil {
+			return fmt.Errorf("missing router interface for subnet %s", *subnetID)
+		}
+		ports = append(ports, *portID)
+	}
+
+	egressPolicy, err := fctx.ensureFirewallPolicy(ctx, IdentifierFirewallEgressPolicy, fctx.defaultFirewallPolicyName("egress"),
+		fctx.desiredFirewallRules(firewall.EgressRules, false))
+	if err != nil {
+		return err
+	}
+	ingressPolicy, err := fctx.ensureFirewallPolicy(ctx, IdentifierFirewallIngressPolicy, fctx.defaultFirewallPolicyName("ingress"),
+		fctx.desiredFirewallRules(firewall.IngressRules, true))
+	if err != nil {
+		return err
+	}
+
+	current, err := fctx.findExistingFirewallGroup(ctx)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		log.Info("creating...")
+		current, err = fctx.networking.CreateFirewallGroup(ctx, fwgroups.CreateOpts{
+			Name:                    fctx.defaultFirewallGroupName(),
+			IngressFirewallPolicyID: egressPolicy.ID,
+			EgressFirewallPolicyID:  ingressPolicy.ID,
+			Ports:                   ports,
+		})
+		if err != nil {
+			return err
+		}
+	} else if current.IngressFirewallPolicyID != egressPolicy.ID || current.EgressFirewallPolicyID != ingressPolicy.ID ||
+		!sets.New(current.Ports...).Equal(sets.New(ports...)) {
+		log.Info("updating...", "firewallGroup", current.ID)
+		current, err = fctx.networking.UpdateFirewallGroup(ctx, current.ID, fwgroups.UpdateOpts{
+			IngressFirewallPolicyID: &egressPolicy.ID,
+			EgressFirewallPolicyID:  &ingressPolicy.ID,
+			Ports:                   &ports,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	fctx.state.Set(IdentifierFirewallGroup, current.ID)
+	return nil
+}
+
+func (fctx *FlowContext) ensureFirewallPolicy(ctx context.Context, identifier, name string, desired []fwrules.Rule) (*fwpolicies.Policy, error) {
+	log := shared.LogFromContext(ctx)
+	current, err := fctx.findExistingFirewallPolicy(ctx, identifier, name)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		log.Info("creating...", "firewallPolicy", name)
+		current, err = fctx.networking.CreateFirewallPolicy(ctx, fwpolicies.CreateOpts{Name: name})
+		if err != nil {
+			return nil, err
+		}
+	}
+	fctx.state.Set(identifier, current.ID)
+
+	if _, err := fctx.access.UpdateFirewallPolicyRules(ctx, current, desired); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+func (fctx *FlowContext) findExistingFirewallGroup(ctx context.Context) (*fwgroups.Group, error) {
+	list := func(ctx context.Context, opts fwgroups.ListOpts) ([]*fwgroups.Group, error) {
+		list, err := fctx.networking.ListFirewallGroups(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return sliceToPtr(list), nil
+	}
+	return findExisting(ctx, fctx.state.Get(IdentifierFirewallGroup), fctx.defaultFirewallGroupName(),
+		func(ctx context.Context, id string) (*fwgroups.Group, error) {
+			return firstOrNil(list(ctx, fwgroups.ListOpts{ID: id}))
+		},
+		func(ctx context.Context, name string) ([]*fwgroups.Group, error) {
+			return list(ctx, fwgroups.ListOpts{Name: name})
+		})
+}
+
+func (fctx *FlowContext) findExistingFirewallPolicy(ctx context.Context, identifier, name string) (*fwpolicies.Policy, error) {
+	list := func(ctx context.Context, opts fwpolicies.ListOpts) ([]*fwpolicies.Policy, error) {
+		list, err := fctx.networking.ListFirewallPolicies(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		return sliceToPtr(list), nil
+	}
+	return findExisting(ctx, fctx.state.Get(identifier), name,
+		func(ctx context.Context, id string) (*fwpolicies.Policy, error) {
+			return firstOrNil(list(ctx, fwpolicies.ListOpts{ID: id}))
+		},
+		func(ctx context.Context, name string) ([]*fwpolicies.Policy, error) {
+			return list(ctx, fwpolicies.ListOpts{Name: name})
+		})
+}
+
 func (fctx *FlowContext) ensureEgressCIDRs(router *access.Router) error {
 	var result []string
 	for _, efip := range router.ExternalFixedIPs {
@@ -6,9 +6,11 @@ package infraflow
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"strings"
 	"sync"
 	"time"
@@ -16,13 +18,23 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/go-logr/logr"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	fwrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	qosrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
 	netutils "k8s.io/utils/net"
+	"k8s.io/utils/ptr"
+
+	openstackapi "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/controller/infrastructure/infraflow/access"
 )
 
 const (
 	servicePrefix = "kube_service_"
+
+	qosDirectionEgress = "egress"
 )
 
 // ErrorMultipleMatches is returned when the findExisting finds multiple resources matching a name.
@@ -62,6 +74,14 @@ func sliceToPtr[T any](slice []T) []*T {
 	return res
 }
 
+// firstOrNil returns the first element of a list result or nil if it is empty.
+func firstOrNil[T any](list []*T, err error) (*T, error) {
+	if err != nil || len(list) == 0 {
+		return nil, err
+	}
+	return list[0], nil
+}
+
 // filterDNSServersByIPFamily returns only the DNS servers matching the given IP family.
 // OpenStack rejects DNS nameservers whose address family doesn't match the subnet's IP version.
 func filterDNSServersByIPFamily(dnsServers []string, ipFamily gardencorev1beta1.IPFamily) []string {
@@ -76,6 +96,35 @@ func filterDNSServersByIPFamily(dnsServers []string, ipFamily gardencorev1beta1.
 	return result
 }
 
+// routesToString serializes the given routes to be stored in the state.
+func routesToString(routes []routers.Route) string {
+	var parts []string
+	for _, route := range routes {
+		parts = append(parts, route.DestinationCIDR+"="+route.NextHop)
+	}
+	return strings.Join(parts, ",")
+}
+
+// routesFromString deserializes routes stored in the state by routesToString.
+func routesFromString(s string) []routers.Route {
+	var routes []routers.Route
+	for part := range strings.SplitSeq(s, ",") {
+		destination, nextHop, found := strings.Cut(part, "=")
+		if !found {
+			continue
+		}
+		routes = append(routes, routers.Route{DestinationCIDR: destination, NextHop: nextHop})
+	}
+	return routes
+}
+
+func splitAvailabilityZones(zones *string) []string {
+	if zones == nil || *zones == "" {
+		return nil
+	}
+	return strings.Split(*zones, ",")
+}
+
 // ComputeEgressCIDRs converts an IP to a CIDR depending on the IP family.
 func ComputeEgressCIDRs(ips []string) []string {
 	var result []string
@@ -236,6 +285,199 @@ func (fctx *FlowContext) defaultSharedNetworkName() string {
 	return fctx.infra.Namespace
 }
 
+func (fctx *FlowContext) defaultQoSPolicyName() string {
+	return fctx.infra.Namespace
+}
+
+// hasConfiguredRouter returns true if an existing router is configured to be used instead of creating a new one.
+func (fctx *FlowContext) hasConfiguredRouter() bool {
+	return fctx.config.Networks.Router != nil && fctx.config.Networks.Router.ID != ""
+}
+
+// desiredRouterRoutes returns the static routes configured for the router.
+func (fctx *FlowContext) desiredRouterRoutes() []routers.Route {
+	if fctx.config.Networks.Router == nil {
+		return nil
+	}
+	var routes []routers.Route
+	for _, route := range fctx.config.Networks.Router.Routes {
+		routes = append(routes, routers.Route{DestinationCIDR: route.Destination, NextHop: route.NextHop})
+	}
+	return routes
+}
+
+// dnsServers returns the DNS servers for the node subnets. The DNS servers of the InfrastructureConfig take precedence
+// over the ones of the CloudProfile.
+func (fctx *FlowContext) dnsServers() []string {
+	if len(fctx.config.Networks.DNSServers) > 0 {
+		return fctx.config.Networks.DNSServers
+	}
+	return fctx.cloudProfileConfig.DNSServers
+}
+
+// desiredHostRoutes returns the host routes configured for the node subnet.
+func (fctx *FlowContext) desiredHostRoutes() []subnets.HostRoute {
+	var routes []subnets.HostRoute
+	for _, route := range fctx.config.Networks.HostRoutes {
+		routes = append(routes, subnets.HostRoute{DestinationCIDR: route.Destination, NextHop: route.NextHop})
+	}
+	return routes
+}
+
+// desiredAllocationPools returns the allocation pools configured for the node subnet.
+func (fctx *FlowContext) desiredAllocationPools() []subnets.AllocationPool {
+	var pools []subnets.AllocationPool
+	for _, pool := range fctx.config.Networks.AllocationPools {
+		pools = append(pools, subnets.AllocationPool{Start: pool.Start, End: pool.End})
+	}
+	return pools
+}
+
+// defaultAllocationPools returns the allocation pools which Neutron assigns to an IPv4 subnet with the given CIDR and
+// gateway IP if none are specified, i.e. all addresses of the subnet except for its network, broadcast and gateway
+// addresses.
+func defaultAllocationPools(cidr, gatewayIP string) ([]subnets.AllocationPool, error) {
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil || !prefix.Addr().Is4() {
+		return nil, fmt.Errorf("invalid IPv4 CIDR %q of subnet", cidr)
+	}
+	prefix = prefix.Masked()
+
+	network := prefix.Addr().As4()
+	hostBits := uint32(1)<<(32-prefix.Bits()) - 1
+	broadcast := binary.BigEndian.Uint32(network[:]) | hostBits
+	var last [4]byte
+	binary.BigEndian.PutUint32(last[:], broadcast)
+
+	start, end := prefix.Addr().Next(), netip.AddrFrom4(last).Prev()
+	if !start.Less(end) {
+		return nil, nil
+	}
+
+	var pools []subnets.AllocationPool
+	gateway, err := netip.ParseAddr(gatewayIP)
+	if err == nil && !gateway.Less(start) && !end.Less(gateway) {
+		if start.Less(gateway) {
+			pools = append(pools, subnets.AllocationPool{Start: start.String(), End: gateway.Prev().String()})
+		}
+		start = gateway.Next()
+		if end.Less(start) {
+			return pools, nil
+		}
+	}
+	return append(pools, subnets.AllocationPool{Start: start.String(), End: end.String()}), nil
+}
+
+// availabilityZoneHints returns the availability zone hints for the router and the network. If none are configured
+// in the InfrastructureConfig, the zones of the worker pools are used for new infrastructures, unless the availability
+// zones of the networks are ignored in the CloudProfile. The hints are only applied when the resources are created,
+// hence the hints of existing routers and networks are never changed.
+func (fctx *FlowContext) availabilityZoneHints() []string {
+	if len(fctx.config.Networks.AvailabilityZoneHints) > 0 {
+		return fctx.config.Networks.AvailabilityZoneHints
+	}
+	if fctx.infra.Status.ProviderStatus != nil || ptr.Deref(fctx.cloudProfileConfig.IgnoreNetworkAZ, false) {
+		return nil
+	}
+	return fctx.workerZones
+}
+
+// workerZones returns the sorted set of zones used by the given worker pools.
+func workerZones(workers []gardencorev1beta1.Worker) []string {
+	zones := sets.New[string]()
+	for _, worker := range workers {
+		zones.Insert(worker.Zones...)
+	}
+	if zones.Len() == 0 {
+		return nil
+	}
+	return sets.List(zones)
+}
+
+// hasQoSPolicyRules returns true if a QoS policy with the configured rules is created for the shoot.
+func (fctx *FlowContext) hasQoSPolicyRules() bool {
+	qos := fctx.config.Networks.QoSPolicy
+	return qos != nil && qos.ID == nil
+}
+
+// desiredQoSPolicyRules returns the rules of the QoS policy created for the shoot.
+func (fctx *FlowContext) desiredQoSPolicyRules() *access.QoSPolicyRules {
+	desired := &access.QoSPolicyRules{}
+	qos := fctx.config.Networks.QoSPolicy
+	if qos == nil {
+		return desired
+	}
+	for _, rule := range qos.BandwidthLimitRules {
+		desired.BandwidthLimitRules = append(desired.BandwidthLimitRules, qosrules.BandwidthLimitRule{
+			MaxKBps:      rule.MaxKbps,
+			MaxBurstKBps: ptr.Deref(rule.MaxBurstKbps, 0),
+			Direction:    ptr.Deref(rule.Direction, qosDirectionEgress),
+		})
+	}
+	for _, rule := range qos.MinimumBandwidthRules {
+		desired.MinimumBandwidthRules = append(desired.MinimumBandwidthRules, qosrules.MinimumBandwidthRule{
+			MinKBps:   rule.MinKbps,
+			Direction: ptr.Deref(rule.Direction, qosDirectionEgress),
+		})
+	}
+	for _, rule := range qos.DSCPMarkingRules {
+		desired.DSCPMarkingRules = append(desired.DSCPMarkingRules, qosrules.DSCPMarkingRule{DSCPMark: rule.DSCPMark})
+	}
+	return desired
+}
+
+func (fctx *FlowContext) defaultFirewallGroupName() string {
+	return fctx.infra.Namespace
+}
+
+func (fctx *FlowContext) defaultFirewallPolicyName(direction string) string {
+	return fctx.infra.Namespace + "-" + direction
+}
+
+// desiredFirewallRules returns the rules of a firewall policy. The rules allowing the traffic between the node and pod
+// networks of the shoot are put in front of the configured rules. If no rules are configured, all traffic is allowed.
+// If the shoot's network is the destination of the traffic, the internal CIDRs are matched against the source address.
+func (fctx *FlowContext) desiredFirewallRules(configured []openstackapi.FirewallRule, toShoot bool) []fwrules.Rule {
+	var desired []fwrules.Rule
+	networking := fctx.computeInfrastructureNetworkingStatus()
+	for _, cidr := range append(networking.Nodes, networking.Pods...) {
+		rule := fwrules.Rule{
+			Protocol:  string(fwrules.ProtocolAny),
+			Action:    string(fwrules.ActionAllow),
+			IPVersion: 4,
+		}
+		if netutils.IsIPv6CIDRString(cidr) {
+			rule.IPVersion = 6
+		}
+		if toShoot {
+			rule.SourceIPAddress = cidr
+		} else {
+			rule.DestinationIPAddress = cidr
+		}
+		desired = append(desired, rule)
+	}
+
+	if len(configured) == 0 {
+		desired = append(desired, fwrules.Rule{Protocol: string(fwrules.ProtocolAny), Action: string(fwrules.ActionAllow), IPVersion: 4})
+		if fctx.isDualStack() {
+			desired = append(desired, fwrules.Rule{Protocol: string(fwrules.ProtocolAny), Action: string(fwrules.ActionAllow), IPVersion: 6})
+		}
+		return desired
+	}
+	for _, rule := range configured {
+		desired = append(desired, fwrules.Rule{
+			Protocol:             ptr.Deref(rule.Protocol, string(fwrules.ProtocolAny)),
+			Action:               rule.Action,
+			IPVersion:            ptr.Deref(rule.IPVersion, 4),
+			SourceIPAddress:      ptr.Deref(rule.SourceCIDR, ""),
+			DestinationIPAddress: ptr.Deref(rule.DestinationCIDR, ""),
+			SourcePort:           ptr.Deref(rule.SourcePort, ""),
+			DestinationPort:      ptr.Deref(rule.DestinationPort, ""),
+		})
+	}
+	return desired
+}
+
 func (fctx *FlowContext) workersCIDR() string {
 	return fctx.config.WorkersCIDR()
 }
@@ -6,6 +6,8 @@ package infraflow
 
 import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/subnets"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -57,3 +59,58 @@ var _ = Describe("filterDNSServersByIPFamily", func() {
 		),
 	)
 })
+
+var _ = Describe("routesToString and routesFromString", func() {
+	It("round-trips IPv4 and IPv6 routes", func() {
+		routes := []routers.Route{
+			{DestinationCIDR: "192.168.0.0/16", NextHop: "10.250.0.10"},
+			{DestinationCIDR: "fd00::/64", NextHop: "2001:db8::10"},
+		}
+		Expect(routesFromString(routesToString(routes))).To(Equal(routes))
+	})
+
+	It("handles empty values", func() {
+		Expect(routesToString(nil)).To(BeEmpty())
+		Expect(routesFromString("")).To(BeNil())
+	})
+})
+
+var _ = Describe("defaultAllocationPools", func() {
+	DescribeTable("returns the allocation pools which Neutron assigns by default",
+		func(cidr, gatewayIP string, expected []subnets.AllocationPool) {
+			Expect(defaultAllocationPools(cidr, gatewayIP)).To(Equal(expected))
+		},
+		Entry("gateway at the start of the subnet", "10.250.0.0/16", "10.250.0.1",
+			[]subnets.AllocationPool{{Start: "10.250.0.2", End: "10.250.255.254"}},
+		),
+		Entry("gateway in the middle of the subnet", "10.250.0.0/24", "10.250.0.100",
+			[]subnets.AllocationPool{{Start: "10.250.0.1", End: "10.250.0.99"}, {Start: "10.250.0.101", End: "10.250.0.254"}},
+		),
+		Entry("gateway at the end of the subnet", "10.250.0.0/24", "10.250.0.254",
+			[]subnets.AllocationPool{{Start: "10.250.0.1", End: "10.250.0.253"}},
+		),
+		Entry("subnet without gateway", "10.250.0.0/24", "",
+			[]subnets.AllocationPool{{Start: "10.250.0.1", End: "10.250.0.254"}},
+		),
+	)
+
+	It("fails for an invalid CIDR", func() {
+		_, err := defaultAllocationPools("2001:db8::/64", "")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("workerZones", func() {
+	It("returns the sorted set of worker zones", func() {
+		workers := []gardencorev1beta1.Worker{
+			{Name: "a", Zones: []string{"zone-b", "zone-a"}},
+			{Name: "b", Zones: []string{"zone-a", "zone-c"}},
+		}
+		Expect(workerZones(workers)).To(Equal([]string{"zone-a", "zone-b", "zone-c"}))
+	})
+
+	It("returns nil if no zones are used", func() {
+		Expect(workerZones(nil)).To(BeNil())
+		Expect(workerZones([]gardencorev1beta1.Worker{{Name: "a"}})).To(BeNil())
+	})
+})
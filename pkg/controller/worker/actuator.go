@@ -15,8 +15,11 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardener "github.com/gardener/gardener/pkg/client/kubernetes"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
@@ -98,9 +101,15 @@ type WorkerDelegate struct {
 	cluster            *extensionscontroller.Cluster
 	worker             *extensionsv1alpha1.Worker
 
-	machineClasses     []map[string]interface{}
-	machineDeployments worker.MachineDeployments
-	machineImages      []api.MachineImage
+	machineClasses             []map[string]interface{}
+	machineDeployments         worker.MachineDeployments
+	machineImages              []api.MachineImage
+	workerPoolZones            []api.WorkerPoolZones
+	flavorCapacities           map[string]corev1.ResourceList
+	flavorExtraSpecs           map[string]map[string]string
+	userDataObjects            sets.Set[string]
+	userDataSizes              map[string]int
+	existingMachineDeployments []machinev1alpha1.MachineDeployment
 
 	openstackClient openstackclient.Factory
 }
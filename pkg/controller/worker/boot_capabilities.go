@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	extraSpecSecureBoot            = "os:secure_boot"
+	extraSpecTPMVersion            = "hw:tpm_version"
+	extraSpecTPMModel              = "hw:tpm_model"
+	extraSpecMemoryEncryption      = "hw:mem_encryption"
+	extraSpecMemoryEncryptionModel = "hw:mem_encryption_model"
+	extraSpecTraitAMDSEV           = "trait:HW_CPU_X86_AMD_SEV"
+	extraSpecTraitIntelTDX         = "trait:HW_CPU_X86_INTEL_TDX"
+
+	imagePropertyFirmwareType     = "hw_firmware_type"
+	imagePropertySecureBoot       = "os_secure_boot"
+	imagePropertyTPMVersion       = "hw_tpm_version"
+	imagePropertyTPMModel         = "hw_tpm_model"
+	imagePropertyMemoryEncryption = "hw_mem_encryption"
+	imagePropertyMachineType      = "hw_machine_type"
+
+	firmwareTypeUEFI         = "uefi"
+	memoryEncryptionIntelTDX = "intel-tdx"
+)
+
+// flavorBootCapabilities derives the boot capabilities which are required by a flavor from its extra specs.
+func flavorBootCapabilities(extraSpecs map[string]string) gardencorev1beta1.Capabilities {
+	capabilities := gardencorev1beta1.Capabilities{}
+
+	if extraSpecs[extraSpecSecureBoot] == "required" {
+		capabilities[api.CapabilitySecureBoot] = []string{api.CapabilityValueEnabled}
+	}
+	if extraSpecs[extraSpecTPMVersion] != "" {
+		capabilities[api.CapabilityTPM] = []string{api.CapabilityValueEnabled}
+	}
+
+	memoryEncryption, _ := strconv.ParseBool(extraSpecs[extraSpecMemoryEncryption])
+	switch {
+	case extraSpecs[extraSpecTraitIntelTDX] == "required" || extraSpecs[extraSpecMemoryEncryptionModel] == memoryEncryptionIntelTDX:
+		capabilities[api.CapabilityConfidentialComputing] = []string{api.ConfidentialComputingTDX}
+	case extraSpecs[extraSpecTraitAMDSEV] == "required" || memoryEncryption:
+		capabilities[api.CapabilityConfidentialComputing] = []string{api.ConfidentialComputingSEV}
+	}
+
+	return capabilities
+}
+
+// withFlavorBootCapabilities adds the boot capabilities required by the flavor to the capabilities of a machine type,
+// so that a matching machine image is selected. Only capabilities which are defined in the CloudProfile are added, and
+// capabilities which are explicitly set for the machine type take precedence.
+func withFlavorBootCapabilities(machineTypeCapabilities, flavorCapabilities gardencorev1beta1.Capabilities, capabilityDefinitions []gardencorev1beta1.CapabilityDefinition) gardencorev1beta1.Capabilities {
+	for _, definition := range capabilityDefinitions {
+		values, ok := flavorCapabilities[definition.Name]
+		if !ok {
+			continue
+		}
+		if _, ok := machineTypeCapabilities[definition.Name]; !ok {
+			machineTypeCapabilities[definition.Name] = values
+		}
+	}
+	return machineTypeCapabilities
+}
+
+// verifyMachineImageBootCapabilities checks that the Glance image selected for a worker pool can boot machines of the
+// flavor of the worker pool. Nova rejects servers whose image properties contradict the extra specs of the flavor, and
+// images without UEFI firmware cannot boot with secure boot or memory encryption.
+func (w *WorkerDelegate) verifyMachineImageBootCapabilities(ctx context.Context, pool extensionsv1alpha1.WorkerPool, machineImage *api.MachineImage) error {
+	extraSpecs := w.flavorExtraSpecs[pool.MachineType]
+	requiredCapabilities := flavorBootCapabilities(extraSpecs)
+	if len(requiredCapabilities) == 0 {
+		return nil
+	}
+
+	imageClient, err := w.openstackClient.Images(osclient.WithRegion(w.worker.Spec.Region))
+	if err != nil {
+		return err
+	}
+
+	listOpts, imageRef := images.ListOpts{ID: machineImage.ID, Visibility: "all"}, machineImage.ID
+	if machineImage.ID == "" {
+		listOpts, imageRef = images.ListOpts{Name: machineImage.Image, Visibility: "all"}, machineImage.Image
+	}
+	imageList, err := imageClient.ListImages(ctx, listOpts)
+	if err != nil {
+		return fmt.Errorf("failed to look up machine image %q: %w", imageRef, err)
+	}
+	if len(imageList) == 0 {
+		return fmt.Errorf("machine image %q not found", imageRef)
+	}
+
+	if problems := imageBootProblems(imageList[0].Properties, extraSpecs, requiredCapabilities); len(problems) > 0 {
+		return fmt.Errorf("machine image %q of worker pool %q cannot boot machines of flavor %q: %s", imageRef, pool.Name, pool.MachineType, strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// imageBootProblems returns the reasons why an image with the given properties cannot boot with the required
+// capabilities of a flavor.
+func imageBootProblems(properties map[string]any, extraSpecs map[string]string, requiredCapabilities gardencorev1beta1.Capabilities) []string {
+	var problems []string
+
+	_, secureBoot := requiredCapabilities[api.CapabilitySecureBoot]
+	_, confidentialComputing := requiredCapabilities[api.CapabilityConfidentialComputing]
+	if (secureBoot || confidentialComputing) && imageProperty(properties, imagePropertyFirmwareType) != firmwareTypeUEFI {
+		problems = append(problems, "image does not use UEFI firmware")
+	}
+	if secureBoot && imageProperty(properties, imagePropertySecureBoot) == "disabled" {
+		problems = append(problems, "image disables secure boot")
+	}
+
+	if _, ok := requiredCapabilities[api.CapabilityTPM]; ok {
+		for extraSpec, property := range map[string]string{extraSpecTPMVersion: imagePropertyTPMVersion, extraSpecTPMModel: imagePropertyTPMModel} {
+			if value := imageProperty(properties, property); value != "" && extraSpecs[extraSpec] != "" && value != extraSpecs[extraSpec] {
+				problems = append(problems, fmt.Sprintf("image requests %s %q but flavor requests %q", property, value, extraSpecs[extraSpec]))
+			}
+		}
+	}
+
+	if confidentialComputing {
+		if memoryEncryption, err := strconv.ParseBool(imageProperty(properties, imagePropertyMemoryEncryption)); err == nil && !memoryEncryption {
+			problems = append(problems, "image disables memory encryption")
+		}
+		if machineType := imageProperty(properties, imagePropertyMachineType); machineType != "" && !strings.Contains(machineType, "q35") {
+			problems = append(problems, fmt.Sprintf("memory encryption requires the q35 machine type, but image requests %q", machineType))
+		}
+	}
+
+	slices.Sort(problems)
+	return problems
+}
+
+// imageProperty returns a property of a Glance image as string.
+func imageProperty(properties map[string]any, name string) string {
+	value, ok := properties[name]
+	if !ok || value == nil {
+		return ""
+	}
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
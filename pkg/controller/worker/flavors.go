@@ -0,0 +1,365 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gophercloud/gophercloud/v2/openstack/baremetal/v1/nodes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+// BareMetalNodesAvailableConditionType is the type of the worker condition which reports whether enough Ironic nodes are
+// available for the minimum of the worker pools with bare metal machine types.
+const BareMetalNodesAvailableConditionType gardencorev1beta1.ConditionType = "BareMetalNodesAvailable"
+
+const (
+	// resourceGPU is the name of the node template resource used by Gardener and the cluster-autoscaler for GPUs.
+	resourceGPU corev1.ResourceName = "gpu"
+
+	extraSpecVGPU       = "resources:VGPU"
+	extraSpecVCPU       = "resources:VCPU"
+	extraSpecMemoryMB   = "resources:MEMORY_MB"
+	extraSpecPCIAliases = "pci_passthrough:alias"
+	// extraSpecCustomResourcePrefix is the prefix of the extra spec with which bare metal flavors request a node of
+	// an Ironic resource class, e.g. "resources:CUSTOM_BAREMETAL_LARGE=1".
+	extraSpecCustomResourcePrefix = "resources:CUSTOM_"
+)
+
+// bareMetalNodeProperties are the properties of Ironic nodes from which the capacity of bare metal machines is derived.
+var bareMetalNodeProperties = []struct {
+	name         string
+	resourceName corev1.ResourceName
+	unit         int64
+	format       resource.Format
+}{
+	{"cpus", corev1.ResourceCPU, 1, resource.DecimalSI},
+	{"memory_mb", corev1.ResourceMemory, 1024 * 1024, resource.BinarySI},
+	{"local_gb", corev1.ResourceEphemeralStorage, 1024 * 1024 * 1024, resource.BinarySI},
+}
+
+// reconcileFlavorCapacities determines the flavors of the machine types used by the worker pools and derives the
+// capacity of their nodes, so that it can be used for the node templates of the machine classes. The flavors are read
+// from Nova on every reconciliation, as operators may change them, and kept in the worker status. If they cannot be
+// read, the flavors in the worker status are used, or the capacity of the machine types in the CloudProfile if they
+// were never read. The extra specs are kept for matching the boot capabilities of the flavors with the machine images.
+func (w *WorkerDelegate) reconcileFlavorCapacities(ctx context.Context, computeClient osclient.Compute, workerStatus *api.WorkerStatus) error {
+	machineTypes := sets.New[string]()
+	for _, pool := range w.worker.Spec.Pools {
+		machineTypes.Insert(pool.MachineType)
+	}
+
+	machineTypeFlavors := make(map[string]api.Flavor, machineTypes.Len())
+	if err := readFlavors(ctx, computeClient, machineTypes, machineTypeFlavors); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to read flavors, falling back to the flavors in the worker status or the capacity of the machine types in the CloudProfile", "machineTypes", sets.List(machineTypes))
+		for _, flavor := range workerStatus.Flavors {
+			if _, ok := machineTypeFlavors[flavor.Name]; !ok && machineTypes.Has(flavor.Name) {
+				machineTypeFlavors[flavor.Name] = flavor
+			}
+		}
+	}
+
+	var (
+		useFlavorCapacity     = ptr.Deref(w.cloudProfileConfig.UseFlavorCapacity, false)
+		flavorCapacities      = make(map[string]corev1.ResourceList, len(machineTypeFlavors))
+		flavorExtraSpecs      = make(map[string]map[string]string, len(machineTypeFlavors))
+		bareMetalMachineTypes []string
+	)
+	workerStatus.Flavors = nil
+	for _, name := range slices.Sorted(maps.Keys(machineTypeFlavors)) {
+		flavor := machineTypeFlavors[name]
+		workerStatus.Flavors = append(workerStatus.Flavors, flavor)
+		flavorExtraSpecs[name] = flavor.ExtraSpecs
+
+		if useFlavorCapacity || helper.IsBareMetalMachineType(w.cloudProfileConfig.MachineTypes, name) {
+			flavorCapacities[name] = flavorCapacity(flavor, w.cloudProfileConfig.FlavorPCIAliases)
+		}
+	}
+	for _, machineType := range sets.List(machineTypes) {
+		if helper.IsBareMetalMachineType(w.cloudProfileConfig.MachineTypes, machineType) {
+			bareMetalMachineTypes = append(bareMetalMachineTypes, machineType)
+		}
+	}
+
+	w.flavorCapacities = flavorCapacities
+	w.flavorExtraSpecs = flavorExtraSpecs
+	return w.reconcileBareMetalCapacities(ctx, computeClient, bareMetalMachineTypes)
+}
+
+// readFlavors reads the flavors with the given names and their extra specs from Nova and adds them to the given map.
+func readFlavors(ctx context.Context, computeClient osclient.Compute, names sets.Set[string], machineTypeFlavors map[string]api.Flavor) error {
+	allFlavors, err := computeClient.ListFlavors(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list flavors: %w", err)
+	}
+
+	for _, flavor := range allFlavors {
+		if !names.Has(flavor.Name) {
+			continue
+		}
+
+		extraSpecs, err := computeClient.ListFlavorExtraSpecs(ctx, flavor.ID)
+		if err != nil {
+			return fmt.Errorf("failed to list extra specs of flavor %q: %w", flavor.Name, err)
+		}
+		machineTypeFlavors[flavor.Name] = api.Flavor{
+			Name:       flavor.Name,
+			VCPUs:      int32(flavor.VCPUs), // #nosec: G115 - The flavor properties of Nova are 32-bit integers.
+			RAM:        int32(flavor.RAM),   // #nosec: G115 - The flavor properties of Nova are 32-bit integers.
+			Disk:       int32(flavor.Disk),  // #nosec: G115 - The flavor properties of Nova are 32-bit integers.
+			ExtraSpecs: extraSpecs,
+		}
+	}
+	return nil
+}
+
+// reconcileBareMetalCapacities derives the capacity of the bare metal machine types from the properties of the Ironic
+// nodes of their resource classes. It also checks that enough nodes are left to provide the minimum of the worker
+// pools, as machines of bare metal flavors cannot be created if no node is available. The result is reported with the
+// BareMetalNodesAvailable condition of the worker instead of failing its reconciliation, so that the other worker
+// pools are still reconciled. If the nodes cannot be read, the capacity of the machine types in the CloudProfile is used.
+func (w *WorkerDelegate) reconcileBareMetalCapacities(ctx context.Context, computeClient osclient.Compute, machineTypes []string) error {
+	if len(machineTypes) == 0 {
+		return w.updateCondition(ctx, BareMetalNodesAvailableConditionType, nil)
+	}
+
+	var (
+		realClock = clock.RealClock{}
+		condition = v1beta1helper.GetOrInitConditionWithClock(realClock, w.worker.Status.Conditions, BareMetalNodesAvailableConditionType)
+	)
+	shortages, err := w.bareMetalShortages(ctx, computeClient, machineTypes)
+	switch {
+	case len(shortages) > 0:
+		messages := shortages
+		if err != nil {
+			messages = append(messages, err.Error())
+		}
+		condition = v1beta1helper.UpdatedConditionWithClock(realClock, condition, gardencorev1beta1.ConditionFalse, "InsufficientBareMetalNodes", strings.Join(messages, "; "))
+	case err != nil:
+		logf.FromContext(ctx).Error(err, "Failed to determine the capacity of bare metal machine types", "machineTypes", machineTypes)
+		condition = v1beta1helper.UpdatedConditionUnknownErrorWithClock(realClock, condition, err)
+	default:
+		condition = v1beta1helper.UpdatedConditionWithClock(realClock, condition, gardencorev1beta1.ConditionTrue, "BareMetalNodesAvailable", "Enough bare metal nodes are available for the worker pools.")
+	}
+	return w.updateCondition(ctx, BareMetalNodesAvailableConditionType, &condition)
+}
+
+// bareMetalShortages returns a message for each of the given bare metal machine types for which not enough Ironic nodes
+// are available. The returned error contains the machine types whose capacity could not be determined.
+func (w *WorkerDelegate) bareMetalShortages(ctx context.Context, computeClient osclient.Compute, machineTypes []string) ([]string, error) {
+	var (
+		resourceClasses = map[string]string{}
+		errs            []error
+	)
+	for _, machineType := range machineTypes {
+		extraSpecs, ok := w.flavorExtraSpecs[machineType]
+		if !ok {
+			errs = append(errs, fmt.Errorf("flavor of bare metal machine type %q is unknown", machineType))
+			continue
+		}
+		resourceClass, ok := flavorResourceClass(extraSpecs)
+		if !ok {
+			errs = append(errs, fmt.Errorf("bare metal flavor %q does not request a custom resource class", machineType))
+			continue
+		}
+		resourceClasses[machineType] = resourceClass
+	}
+	if len(resourceClasses) == 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	bareMetalClient, err := w.openstackClient.BareMetal(osclient.WithRegion(w.worker.Spec.Region))
+	if err != nil {
+		return nil, errors.Join(append(errs, err)...)
+	}
+
+	allNodes, err := bareMetalClient.ListNodes(ctx, nodes.ListOpts{})
+	if err != nil {
+		if osclient.IsForbiddenError(err) {
+			err = fmt.Errorf("not allowed to list bare metal nodes, the capacity of the bare metal machine types is unknown: %w", err)
+		} else {
+			err = fmt.Errorf("failed to list bare metal nodes: %w", err)
+		}
+		return nil, errors.Join(append(errs, err)...)
+	}
+
+	nodesByResourceClass := map[string][]nodes.Node{}
+	for _, node := range allNodes {
+		if node.Maintenance || node.ResourceClass == "" {
+			continue
+		}
+		resourceClass := normalizeResourceClass(node.ResourceClass)
+		nodesByResourceClass[resourceClass] = append(nodesByResourceClass[resourceClass], node)
+	}
+
+	var (
+		shootServerIDs sets.Set[string]
+		shortages      []string
+	)
+	for _, machineType := range slices.Sorted(maps.Keys(resourceClasses)) {
+		classNodes := nodesByResourceClass[resourceClasses[machineType]]
+		maps.Copy(w.flavorCapacities[machineType], bareMetalNodeCapacity(classNodes))
+
+		var (
+			required  int
+			poolNames []string
+		)
+		for _, pool := range w.worker.Spec.Pools {
+			if pool.MachineType == machineType {
+				required += int(pool.Minimum)
+				poolNames = append(poolNames, strconv.Quote(pool.Name))
+			}
+		}
+
+		var available int
+		for _, node := range classNodes {
+			if node.ProvisionState == string(nodes.Available) && node.InstanceUUID == "" {
+				available++
+			}
+		}
+		if available >= required {
+			continue
+		}
+
+		// The nodes which are already provisioned for machines of the shoot count towards the minimum as well.
+		if shootServerIDs == nil {
+			servers, err := computeClient.FindServersByName(ctx, "^"+w.cluster.Shoot.Status.TechnicalID+"-")
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to list servers of the shoot: %w", err))
+				break
+			}
+			shootServerIDs = sets.New[string]()
+			for _, server := range servers {
+				shootServerIDs.Insert(server.ID)
+			}
+		}
+		for _, node := range classNodes {
+			if shootServerIDs.Has(node.InstanceUUID) {
+				available++
+			}
+		}
+		if available < required {
+			shortages = append(shortages, fmt.Sprintf("not enough bare metal nodes for machine type %q: the worker pools %s require at least %d nodes, but only %d are available", machineType, strings.Join(poolNames, ", "), required, available))
+		}
+	}
+	return shortages, errors.Join(errs...)
+}
+
+// flavorCapacity derives the capacity of a node from its flavor. The CPU and memory resources are skipped if the flavor
+// overrides them with placement resources, which is the case e.g. for bare metal flavors. GPUs are derived from the
+// requested vGPUs and from the PCI aliases which are mapped to a resource in the CloudProfile.
+func flavorCapacity(flavor api.Flavor, pciAliases []api.FlavorPCIAlias) corev1.ResourceList {
+	capacity, extraSpecs := corev1.ResourceList{}, flavor.ExtraSpecs
+
+	if _, ok := extraSpecs[extraSpecVCPU]; !ok && flavor.VCPUs > 0 {
+		capacity[corev1.ResourceCPU] = *resource.NewQuantity(int64(flavor.VCPUs), resource.DecimalSI)
+	}
+	if _, ok := extraSpecs[extraSpecMemoryMB]; !ok && flavor.RAM > 0 {
+		capacity[corev1.ResourceMemory] = *resource.NewQuantity(int64(flavor.RAM)*1024*1024, resource.BinarySI)
+	}
+	if flavor.Disk > 0 {
+		capacity[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(int64(flavor.Disk)*1024*1024*1024, resource.BinarySI)
+	}
+
+	devices := map[corev1.ResourceName]int64{}
+	if count, err := strconv.ParseInt(extraSpecs[extraSpecVGPU], 10, 64); err == nil && count > 0 {
+		devices[resourceGPU] += count
+	}
+
+	resourceNames := make(map[string]corev1.ResourceName, len(pciAliases))
+	for _, alias := range pciAliases {
+		resourceNames[alias.Name] = corev1.ResourceName(alias.ResourceName)
+	}
+	// The extra spec has the format "<alias>:<count>[,<alias>:<count>]...".
+	for _, entry := range strings.Split(extraSpecs[extraSpecPCIAliases], ",") {
+		alias, countStr, _ := strings.Cut(strings.TrimSpace(entry), ":")
+		resourceName, ok := resourceNames[alias]
+		if !ok {
+			continue
+		}
+		count, err := strconv.ParseInt(countStr, 10, 64)
+		if err != nil || count <= 0 {
+			continue
+		}
+		devices[resourceName] += count
+	}
+
+	for resourceName, count := range devices {
+		capacity[resourceName] = *resource.NewQuantity(count, resource.DecimalSI)
+	}
+	return capacity
+}
+
+// flavorResourceClass returns the Ironic resource class requested by the extra specs of a bare metal flavor.
+func flavorResourceClass(extraSpecs map[string]string) (string, bool) {
+	for key, value := range extraSpecs {
+		if strings.HasPrefix(key, extraSpecCustomResourcePrefix) && value == "1" {
+			return strings.TrimPrefix(key, "resources:"), true
+		}
+	}
+	return "", false
+}
+
+// normalizeResourceClass returns the name of the placement resource class of an Ironic resource class, e.g.
+// "CUSTOM_BAREMETAL_LARGE" for "baremetal.large".
+func normalizeResourceClass(resourceClass string) string {
+	return "CUSTOM_" + strings.Map(func(r rune) rune {
+		if (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return '_'
+	}, strings.ToUpper(resourceClass))
+}
+
+// bareMetalNodeCapacity derives the capacity of a bare metal machine from the properties of the Ironic nodes it may be
+// scheduled to. The smallest value of the nodes is used, so that the cluster-autoscaler does not overestimate them.
+func bareMetalNodeCapacity(classNodes []nodes.Node) corev1.ResourceList {
+	capacity := corev1.ResourceList{}
+	for _, property := range bareMetalNodeProperties {
+		var minimum int64
+		for _, node := range classNodes {
+			value, ok := nodeProperty(node, property.name)
+			if !ok || value <= 0 {
+				continue
+			}
+			if minimum == 0 || value < minimum {
+				minimum = value
+			}
+		}
+		if minimum > 0 {
+			capacity[property.resourceName] = *resource.NewQuantity(minimum*property.unit, property.format)
+		}
+	}
+	return capacity
+}
+
+// nodeProperty returns a numeric property of an Ironic node, which may be given as number or as string.
+func nodeProperty(node nodes.Node, name string) (int64, bool) {
+	switch value := node.Properties[name].(type) {
+	case float64:
+		return int64(value), true
+	case string:
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		return parsed, err == nil
+	}
+	return 0, false
+}
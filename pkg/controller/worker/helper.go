@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,6 +53,20 @@ func (w *WorkerDelegate) updateWorkerProviderStatus(ctx context.Context, workerS
 	return w.seedClient.Status().Patch(ctx, w.worker, patch)
 }
 
+// updateCondition sets the given condition in the status of the worker. The condition of the given type is removed if
+// it is nil.
+func (w *WorkerDelegate) updateCondition(ctx context.Context, conditionType gardencorev1beta1.ConditionType, condition *gardencorev1beta1.Condition) error {
+	patch := k8sclient.MergeFrom(w.worker.DeepCopy())
+	if condition != nil {
+		w.worker.Status.Conditions = v1beta1helper.MergeConditions(w.worker.Status.Conditions, *condition)
+	} else if v1beta1helper.GetCondition(w.worker.Status.Conditions, conditionType) != nil {
+		w.worker.Status.Conditions = v1beta1helper.RemoveConditions(w.worker.Status.Conditions, conditionType)
+	} else {
+		return nil
+	}
+	return w.seedClient.Status().Patch(ctx, w.worker, patch)
+}
+
 func (w *WorkerDelegate) updateMachineDependenciesStatus(ctx context.Context, workerStatus *api.WorkerStatus, serverGroupDependencies []api.ServerGroupDependency, err error) error {
 	workerStatus.ServerGroupDependencies = serverGroupDependencies
 	if statusUpdateErr := w.updateWorkerProviderStatus(ctx, workerStatus); statusUpdateErr != nil {
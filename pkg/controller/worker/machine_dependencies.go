@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
@@ -33,32 +34,65 @@ func (w *WorkerDelegate) PreReconcileHook(ctx context.Context) error {
 		return err
 	}
 
+	if err := w.reconcileFlavorCapacities(ctx, computeClient, workerStatus); err != nil {
+		return err
+	}
+
+	if err := w.reconcileExhaustedZones(ctx, computeClient, workerStatus); err != nil {
+		return err
+	}
+
 	serverGroupDepSet, err := w.reconcileServerGroups(ctx, computeClient, workerStatus.DeepCopy())
 	return w.updateMachineDependenciesStatus(ctx, workerStatus, serverGroupDepSet.extract(), err)
 }
 
 func (w *WorkerDelegate) reconcileServerGroups(ctx context.Context, computeClient osclient.Compute, workerStatus *api.WorkerStatus) (serverGroupDependencySet, error) {
 	serverGroupDepSet := newServerGroupDependencySet(workerStatus.ServerGroupDependencies)
+
 	for _, pool := range w.worker.Spec.Pools {
-		serverGroupDependencyStatus, err := w.reconcilePoolServerGroup(ctx, computeClient, pool, serverGroupDepSet)
+		poolProviderConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
 		if err != nil {
 			return serverGroupDepSet, fmt.Errorf("reconciling server groups failed for pool %q: %w", pool.Name, err)
 		}
-		serverGroupDepSet.upsert(serverGroupDependencyStatus)
-	}
-	return serverGroupDepSet, nil
-}
 
-func (w *WorkerDelegate) reconcilePoolServerGroup(ctx context.Context, computeClient osclient.Compute, pool extensionsv1alpha1.WorkerPool, set serverGroupDependencySet) (*api.ServerGroupDependency, error) {
-	poolProviderConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
-	if err != nil {
-		return nil, err
-	}
+		if !isServerGroupRequired(poolProviderConfig) {
+			continue
+		}
+
+		// Pools which can have more machines than the configured maximum number of server group members are spread over
+		// several server groups.
+		zones := serverGroupZones(pool, poolProviderConfig)
+		shards := make(map[string]int32, len(zones))
+		for zoneIndex, zone := range zones {
+			maximum, maxSurge := pool.Maximum, pool.MaxSurge
+			if zone != "" {
+				zoneIdx, zoneLen := int32(zoneIndex), int32(len(zones)) // #nosec: G115 - We validate if num pool zones exceeds max_int32.
+				maximum = worker.DistributeOverZones(zoneIdx, pool.Maximum, zoneLen)
+				maxSurge = worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum)
+			}
 
-	if !isServerGroupRequired(poolProviderConfig) {
-		return nil, nil
+			shards[zone] = serverGroupShards(maximum, maxSurge, poolProviderConfig.ServerGroup.MaxMembers)
+			for index := range shards[zone] {
+				serverGroupDependencyStatus, err := w.reconcilePoolServerGroup(ctx, computeClient, pool, poolProviderConfig, zone, index, serverGroupDepSet)
+				if err != nil {
+					return serverGroupDepSet, fmt.Errorf("reconciling server groups failed for pool %q: %w", pool.Name, err)
+				}
+				serverGroupDepSet.upsert(serverGroupDependencyStatus)
+			}
+		}
+
+		// Server groups which are no longer needed, e.g. of removed zones or after switching between one server group
+		// per pool and one per zone, are deleted after the reconciliation, once their machines are gone.
+		for _, dep := range serverGroupDepSet.getByPoolName(pool.Name) {
+			if dep.Index >= shards[dep.Zone] {
+				serverGroupDepSet.delete(dep)
+			}
+		}
 	}
+	return serverGroupDepSet, nil
+}
 
+func (w *WorkerDelegate) reconcilePoolServerGroup(ctx context.Context, computeClient osclient.Compute, pool extensionsv1alpha1.WorkerPool, poolProviderConfig *api.WorkerConfig, zone string, index int32, set serverGroupDependencySet) (*api.ServerGroupDependency, error) {
 	// Determine Kubernetes version and naming strategy
 	k8sVersion, err := semver.NewVersion(ptr.Deref(pool.KubernetesVersion, w.cluster.Shoot.Spec.Kubernetes.Version))
 	if err != nil {
@@ -67,17 +101,19 @@ func (w *WorkerDelegate) reconcilePoolServerGroup(ctx context.Context, computeCl
 	forceNewNameFormat := versionutils.ConstraintK8sGreaterEqual135.Check(k8sVersion)
 
 	// Generate expected server group names
-	name := generateServerGroupNameV2(string(w.cluster.Shoot.GetUID()), pool.Name, poolProviderConfig.ServerGroup.Policy)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate server group name for worker pool %q: %w", pool.Name, err)
-	}
+	maxServerPerHost := poolProviderConfig.ServerGroup.MaxServerPerHost
+	name := generateServerGroupNameV2(string(w.cluster.Shoot.GetUID()), pool.Name, zone, poolProviderConfig.ServerGroup.Policy, index, maxServerPerHost)
 
 	policyMatch := func(sg *servergroups.ServerGroup) bool {
-		return sg != nil && len(sg.Policies) > 0 && sg.Policies[0] == poolProviderConfig.ServerGroup.Policy
+		if sg == nil || serverGroupPolicy(sg) != poolProviderConfig.ServerGroup.Policy {
+			return false
+		}
+		// The rules, the zone and the index are only encoded in the name of the server group.
+		return (maxServerPerHost == nil && zone == "" && index == 0) || sg.Name == name
 	}
 
 	// Check if we have a current dependency in the status
-	currentPoolDependency := set.getByPoolName(pool.Name)
+	currentPoolDependency := set.get(pool.Name, zone, index)
 	if currentPoolDependency != nil {
 		serverGroup, err := computeClient.GetServerGroup(ctx, currentPoolDependency.ID)
 		if err != nil && !osclient.IsNotFoundError(err) {
@@ -114,6 +150,8 @@ func (w *WorkerDelegate) reconcilePoolServerGroup(ctx context.Context, computeCl
 					PoolName: pool.Name,
 					ID:       serverGroup.ID,
 					Name:     serverGroup.Name,
+					Zone:     zone,
+					Index:    index,
 				}, nil
 			}
 		} else {
@@ -124,13 +162,20 @@ func (w *WorkerDelegate) reconcilePoolServerGroup(ctx context.Context, computeCl
 					PoolName: pool.Name,
 					ID:       serverGroup.ID,
 					Name:     serverGroup.Name,
+					Zone:     zone,
+					Index:    index,
 				}, nil
 			}
 		}
 	}
 
 	// if we did not have a valid matching candidate for adoption, create a new server group.
-	result, err := computeClient.CreateServerGroup(ctx, name, poolProviderConfig.ServerGroup.Policy)
+	var result *servergroups.ServerGroup
+	if maxServerPerHost != nil {
+		result, err = computeClient.CreateServerGroupWithRules(ctx, name, poolProviderConfig.ServerGroup.Policy, servergroups.Rules{MaxServerPerHost: int(*maxServerPerHost)})
+	} else {
+		result, err = computeClient.CreateServerGroup(ctx, name, poolProviderConfig.ServerGroup.Policy)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -139,12 +184,20 @@ func (w *WorkerDelegate) reconcilePoolServerGroup(ctx context.Context, computeCl
 		PoolName: pool.Name,
 		ID:       result.ID,
 		Name:     result.Name,
+		Zone:     zone,
+		Index:    index,
 	}, nil
 }
 
 // PostReconcileHook implements genericactuator.WorkerDelegate.
 func (w *WorkerDelegate) PostReconcileHook(ctx context.Context) error {
-	return w.cleanupMachineDependencies(ctx)
+	if err := w.cleanupMachineDependencies(ctx); err != nil {
+		return err
+	}
+	if err := w.cleanupUserData(ctx); err != nil {
+		return err
+	}
+	return w.updateUserDataCondition(ctx)
 }
 
 // PreDeleteHook implements genericactuator.WorkerDelegate.
@@ -154,7 +207,10 @@ func (w *WorkerDelegate) PreDeleteHook(_ context.Context) error {
 
 // PostDeleteHook implements genericactuator.WorkerDelegate.
 func (w *WorkerDelegate) PostDeleteHook(ctx context.Context) error {
-	return w.cleanupMachineDependencies(ctx)
+	if err := w.cleanupMachineDependencies(ctx); err != nil {
+		return err
+	}
+	return w.cleanupUserData(ctx)
 }
 
 // cleanupMachineDependencies cleans up machine dependencies.
@@ -187,6 +243,7 @@ func (w *WorkerDelegate) cleanupMachineDependencies(ctx context.Context) error {
 // b) worker pool is deleted
 // c) worker pool's server group configuration (e.g. policy) changed
 // d) worker pool no longer requires use of server groups
+// e) worker pool switched between one server group per pool and one per zone, or a zone was removed from the worker pool
 func (w *WorkerDelegate) cleanupServerGroupDependencies(ctx context.Context, computeClient osclient.Compute, set serverGroupDependencySet) error {
 	groups, err := computeClient.ListServerGroups(ctx)
 	if err != nil {
@@ -218,13 +275,13 @@ func (w *WorkerDelegate) cleanupServerGroupDependencies(ctx context.Context, com
 				return err
 			}
 
-			set.deleteByPoolName(d.PoolName)
+			set.delete(d)
 			return nil
 		})
 	}
 
-	// Find out which worker pools use server groups. Deps whose worker pool is not present in the map will be deleted.
-	configs := sets.NewString()
+	// Find out which worker pools and zones use server groups. Deps whose worker pool and zone are not present in the map will be deleted.
+	configs := map[string]sets.Set[string]{}
 	for _, pool := range w.worker.Spec.Pools {
 		poolConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
 		if err != nil {
@@ -235,12 +292,12 @@ func (w *WorkerDelegate) cleanupServerGroupDependencies(ctx context.Context, com
 			continue
 		}
 
-		configs.Insert(pool.Name)
+		configs[pool.Name] = sets.New(serverGroupZones(pool, poolConfig)...)
 	}
 
-	// handles cases [b,d]
+	// handles cases [b,d,e]
 	return set.forEach(func(d api.ServerGroupDependency) error {
-		if configs.Has(d.PoolName) {
+		if configs[d.PoolName].Has(d.Zone) {
 			return nil
 		}
 
@@ -248,7 +305,7 @@ func (w *WorkerDelegate) cleanupServerGroupDependencies(ctx context.Context, com
 			return err
 		}
 
-		set.deleteByPoolName(d.PoolName)
+		set.delete(d)
 		return nil
 	})
 }
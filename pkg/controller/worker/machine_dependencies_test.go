@@ -7,6 +7,7 @@ package worker_test
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"time"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -20,6 +21,8 @@ import (
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -80,6 +83,7 @@ var _ = Describe("#MachineDependencies", func() {
 				WithStatusSubresource(&extensionsv1alpha1.Worker{}).
 				Build()
 			osFactory.EXPECT().Compute(gomock.Any()).AnyTimes().Return(computeClient, nil)
+			computeClient.EXPECT().ListFlavors(gomock.Any()).AnyTimes().Return(nil, nil)
 		})
 
 		Context("#PreReconcileHook", func() {
@@ -256,6 +260,203 @@ var _ = Describe("#MachineDependencies", func() {
 					}),
 				))
 			})
+
+			It("should keep worker pools on a single server group without maximum number of members", func() {
+				var (
+					ctx    = context.Background()
+					policy = "foo"
+				)
+
+				pool := newWorkerPoolWithPolicy("pool", &policy)
+				pool.Maximum = 15
+				pool.MaxSurge = intstr.FromInt32(1)
+				w.Spec.Pools = append(w.Spec.Pools, *pool)
+				syncWorkerSpec(ctx, cl, w)
+
+				workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, w, newClusterWithDefaultCloudProfileConfig(namespace, technicalID), osFactory)
+
+				computeClient.EXPECT().ListServerGroups(ctx).Return([]servergroups.ServerGroup{}, nil)
+				computeClient.EXPECT().CreateServerGroup(ctx, gomock.Any(), policy).Return(&servergroups.ServerGroup{
+					ID:       "id-1",
+					Name:     "sg-1",
+					Policies: []string{policy},
+				}, nil)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerStatus(w)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(
+					apiv1alpha1.ServerGroupDependency{PoolName: "pool", ID: "id-1", Name: "sg-1", Index: 0},
+				))
+			})
+
+			It("should spread worker pools over several server groups if they exceed the maximum number of members", func() {
+				var (
+					ctx    = context.Background()
+					policy = "foo"
+				)
+
+				pool := newWorkerPoolWithPolicy("pool", &policy)
+				pool.Maximum = 15
+				pool.MaxSurge = intstr.FromInt32(1)
+				pool.ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					ServerGroup: &apiv1alpha1.ServerGroup{
+						Policy:     policy,
+						MaxMembers: ptr.To[int32](10),
+					},
+				})}
+				w.Spec.Pools = append(w.Spec.Pools, *pool)
+				syncWorkerSpec(ctx, cl, w)
+
+				workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, w, newClusterWithDefaultCloudProfileConfig(namespace, technicalID), osFactory)
+
+				var names []string
+				computeClient.EXPECT().ListServerGroups(ctx).Return([]servergroups.ServerGroup{}, nil).Times(2)
+				computeClient.EXPECT().CreateServerGroup(ctx, gomock.Any(), policy).DoAndReturn(
+					func(_ context.Context, name string, policy string) (*servergroups.ServerGroup, error) {
+						names = append(names, name)
+						return &servergroups.ServerGroup{
+							ID:       fmt.Sprintf("id-%d", len(names)),
+							Name:     name,
+							Policies: []string{policy},
+						}, nil
+					}).Times(2)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				Expect(names[0]).NotTo(Equal(names[1]))
+				workerStatus := decodeWorkerStatus(w)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(
+					apiv1alpha1.ServerGroupDependency{PoolName: "pool", ID: "id-1", Name: names[0], Index: 0},
+					apiv1alpha1.ServerGroupDependency{PoolName: "pool", ID: "id-2", Name: names[1], Index: 1},
+				))
+			})
+
+			It("should remove server groups which are no longer needed from the status", func() {
+				var (
+					ctx    = context.Background()
+					policy = "foo"
+				)
+
+				w.Spec.Pools = append(w.Spec.Pools, *newWorkerPoolWithPolicy("pool", &policy))
+				syncWorkerSpec(ctx, cl, w)
+				w.Status.ProviderStatus = workerProviderStatusRaw([]apiv1alpha1.ServerGroupDependency{
+					{PoolName: "pool", ID: "id-1", Name: "sg-1"},
+					{PoolName: "pool", ID: "id-2", Name: "sg-2", Index: 1},
+				})
+
+				workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, w, newClusterWithDefaultCloudProfileConfig(namespace, technicalID), osFactory)
+
+				computeClient.EXPECT().GetServerGroup(ctx, "id-1").Return(&servergroups.ServerGroup{
+					ID:       "id-1",
+					Name:     "sg-1",
+					Policies: []string{policy},
+				}, nil)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerStatus(w)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(
+					apiv1alpha1.ServerGroupDependency{PoolName: "pool", ID: "id-1", Name: "sg-1"},
+				))
+			})
+
+			It("should create server groups with the max_server_per_host rule", func() {
+				var (
+					ctx    = context.Background()
+					policy = "anti-affinity"
+				)
+
+				pool := newWorkerPoolWithPolicy("pool", &policy)
+				pool.ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					ServerGroup: &apiv1alpha1.ServerGroup{
+						Policy:           policy,
+						MaxServerPerHost: ptr.To[int32](2),
+					},
+				})}
+				w.Spec.Pools = append(w.Spec.Pools, *pool)
+				syncWorkerSpec(ctx, cl, w)
+
+				workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, w, newClusterWithDefaultCloudProfileConfig(namespace, technicalID), osFactory)
+
+				computeClient.EXPECT().ListServerGroups(ctx).Return([]servergroups.ServerGroup{}, nil)
+				computeClient.EXPECT().CreateServerGroupWithRules(ctx, gomock.Any(), policy, servergroups.Rules{MaxServerPerHost: 2}).DoAndReturn(
+					func(_ context.Context, name string, policy string, _ servergroups.Rules) (*servergroups.ServerGroup, error) {
+						return &servergroups.ServerGroup{
+							ID:     "id",
+							Name:   name,
+							Policy: &policy,
+						}, nil
+					})
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerStatus(w)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(
+					MatchFields(IgnoreExtras, Fields{
+						"ID":       Equal("id"),
+						"PoolName": Equal("pool"),
+					}),
+				))
+			})
+
+			It("should create one server group per zone and replace the server group of the worker pool", func() {
+				var (
+					ctx    = context.Background()
+					policy = "affinity"
+				)
+
+				pool := newWorkerPoolWithPolicy("pool", &policy)
+				pool.Zones = []string{"zone-a", "zone-b"}
+				pool.ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					ServerGroup: &apiv1alpha1.ServerGroup{
+						Policy:  policy,
+						PerZone: ptr.To(true),
+					},
+				})}
+				w.Spec.Pools = append(w.Spec.Pools, *pool)
+				syncWorkerSpec(ctx, cl, w)
+				w.Status.ProviderStatus = workerProviderStatusRaw([]apiv1alpha1.ServerGroupDependency{
+					{PoolName: "pool", ID: "id-pool", Name: "sg-pool"},
+				})
+
+				workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, w, newClusterWithDefaultCloudProfileConfig(namespace, technicalID), osFactory)
+
+				var names []string
+				computeClient.EXPECT().ListServerGroups(ctx).Return([]servergroups.ServerGroup{
+					{ID: "id-pool", Name: "sg-pool", Policies: []string{policy}},
+				}, nil).Times(2)
+				computeClient.EXPECT().CreateServerGroup(ctx, gomock.Any(), policy).DoAndReturn(
+					func(_ context.Context, name string, policy string) (*servergroups.ServerGroup, error) {
+						names = append(names, name)
+						return &servergroups.ServerGroup{
+							ID:       fmt.Sprintf("id-%d", len(names)),
+							Name:     name,
+							Policies: []string{policy},
+						}, nil
+					}).Times(2)
+
+				Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+				Expect(names[0]).NotTo(Equal(names[1]))
+				workerStatus := decodeWorkerStatus(w)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(
+					apiv1alpha1.ServerGroupDependency{PoolName: "pool", ID: "id-1", Name: names[0], Zone: "zone-a"},
+					apiv1alpha1.ServerGroupDependency{PoolName: "pool", ID: "id-2", Name: names[1], Zone: "zone-b"},
+				))
+			})
 		})
 
 		Context("#PreReconcileHook during Restore", func() {
@@ -681,6 +882,47 @@ var _ = Describe("#MachineDependencies", func() {
 				Expect(workerStatus.ServerGroupDependencies).NotTo(BeEmpty())
 			})
 
+			It("should clean the server group of the worker pool after switching to one server group per zone", func() {
+				var (
+					ctx    = context.Background()
+					policy = "foo"
+				)
+
+				pool := newWorkerPoolWithPolicy("pool", &policy)
+				pool.Zones = []string{"zone-a"}
+				pool.ProviderConfig = &runtime.RawExtension{Raw: encode(&apiv1alpha1.WorkerConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						Kind:       "WorkerConfig",
+					},
+					ServerGroup: &apiv1alpha1.ServerGroup{
+						Policy:  policy,
+						PerZone: ptr.To(true),
+					},
+				})}
+				w.Spec.Pools = append(w.Spec.Pools, *pool)
+				w.Status.ProviderStatus = workerProviderStatusRaw([]apiv1alpha1.ServerGroupDependency{
+					{PoolName: "pool", ID: "id-pool", Name: "sg-pool"},
+					{PoolName: "pool", ID: "id-zone-a", Name: "sg-zone-a", Zone: "zone-a"},
+				})
+				syncWorkerSpec(ctx, cl, w)
+				syncWorkerStatus(ctx, cl, w)
+				workerDelegate, _ = worker.NewWorkerDelegate(cl, scheme, nil, w, newClusterWithDefaultCloudProfileConfig(namespace, technicalID), osFactory)
+
+				computeClient.EXPECT().ListServerGroups(ctx).Return([]servergroups.ServerGroup{
+					{ID: "id-pool", Name: technicalID + "-pool-sg-pool"},
+					{ID: "id-zone-a", Name: technicalID + "-pool-sg-zone-a"},
+				}, nil)
+				computeClient.EXPECT().DeleteServerGroup(ctx, "id-pool").Return(nil)
+
+				Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+				workerStatus := decodeWorkerStatus(w)
+				Expect(workerStatus.ServerGroupDependencies).To(ConsistOf(
+					apiv1alpha1.ServerGroupDependency{PoolName: "pool", ID: "id-zone-a", Name: "sg-zone-a", Zone: "zone-a"},
+				))
+			})
+
 			It("should clean all server groups if worker is terminating", func() {
 
 				var (
@@ -20,6 +20,7 @@ import (
 )
 
 // UpdateMachineImagesStatus updates the worker provider status with the machine images used in the worker spec.
+// It also records the zone indices of the machine deployments of the worker pools.
 func (w *WorkerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	if w.machineImages == nil {
 		if err := w.generateMachineConfig(ctx); err != nil {
@@ -34,6 +35,7 @@ func (w *WorkerDelegate) UpdateMachineImagesStatus(ctx context.Context) error {
 	}
 
 	workerStatus.MachineImages = w.machineImages
+	workerStatus.WorkerPoolZones = w.workerPoolZones
 	if err := w.updateWorkerProviderStatus(ctx, workerStatus); err != nil {
 		return fmt.Errorf("unable to update worker provider status: %w", err)
 	}
@@ -26,6 +26,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -96,6 +97,7 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 		machineDeployments = worker.MachineDeployments{}
 		machineClasses     []map[string]interface{}
 		machineImages      []api.MachineImage
+		workerPoolZones    []api.WorkerPoolZones
 	)
 
 	infrastructureStatus := &api.InfrastructureStatus{}
@@ -137,17 +139,24 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 
 		capabilityDefinitions := helper.NormalizeCapabilityDefinitions(w.cluster.CloudProfile.Spec.MachineCapabilities)
 		architecture := ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
-		machineTypeCapabilities := helper.NormalizeMachineTypeCapabilities(machineTypeFromCloudProfile.Capabilities, &architecture, capabilityDefinitions)
+		machineTypeCapabilities := helper.NormalizeMachineTypeCapabilities(maps.Clone(machineTypeFromCloudProfile.Capabilities), &architecture, capabilityDefinitions)
+		machineTypeCapabilities = withFlavorBootCapabilities(machineTypeCapabilities, flavorBootCapabilities(w.flavorExtraSpecs[pool.MachineType]), capabilityDefinitions)
 		machineImage, err := w.selectMachineImageForWorkerPool(pool.MachineImage.Name, pool.MachineImage.Version, w.worker.Spec.Region, architecture, machineTypeCapabilities, capabilityDefinitions)
 		if err != nil {
 			return err
 		}
+		if err := w.verifyMachineImageBootCapabilities(ctx, pool, machineImage); err != nil {
+			return err
+		}
 
 		machineImages = EnsureUniformMachineImages(machineImages, w.cluster.CloudProfile.Spec.MachineCapabilities)
 		machineImages = appendMachineImage(machineImages, *machineImage, w.cluster.CloudProfile.Spec.MachineCapabilities)
 
+		// Bare metal machines boot from the local disk of their Ironic node instead of a root volume.
+		isBareMetal := helper.IsBareMetalMachineType(w.cloudProfileConfig.MachineTypes, pool.MachineType)
+
 		var volumeSize int
-		if pool.Volume != nil {
+		if pool.Volume != nil && !isBareMetal {
 			volumeSize, err = worker.DiskSize(pool.Volume.Size)
 			if err != nil {
 				return err
@@ -159,190 +168,252 @@ func (w *WorkerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
-		var serverGroupDep *api.ServerGroupDependency
-		if isServerGroupRequired(workerConfig) {
-			serverGroupDep = serverGroupDepSet.getByPoolName(pool.Name)
-			if serverGroupDep == nil {
-				return fmt.Errorf("server group is required for pool %q, but no server group dependency found", pool.Name)
-			}
+		machineLabels := map[string]string{}
+		for _, pair := range workerConfig.MachineLabels {
+			machineLabels[pair.Name] = pair.Value
 		}
 
-		workerPoolHash, err := w.generateWorkerPoolHash(pool, serverGroupDep, workerConfig)
+		userData, err := worker.FetchUserData(ctx, w.seedClient, w.worker.Namespace, pool)
 		if err != nil {
 			return err
 		}
-
-		machineLabels := map[string]string{}
-		for _, pair := range workerConfig.MachineLabels {
-			machineLabels[pair.Name] = pair.Value
+		userData, err = w.prepareUserData(ctx, pool, workerConfig, userData)
+		if err != nil {
+			return err
 		}
 
-		userData, err := worker.FetchUserData(ctx, w.seedClient, w.worker.Namespace, pool)
+		previousZones, err := w.previousWorkerPoolZones(ctx, workerStatus, pool.Name)
 		if err != nil {
 			return err
 		}
+		zoneIndices := assignZoneIndices(pool.Zones, previousZones)
+		workerPoolZones = append(workerPoolZones, api.WorkerPoolZones{PoolName: pool.Name, Zones: zoneIndices})
+
+		var readyMachines map[string]int32
+		if isZoneFallbackEnabled(workerConfig) {
+			readyMachines, err = w.readyMachinesOfExhaustedZones(ctx, pool, zoneIndices, workerStatus.ExhaustedZones)
+			if err != nil {
+				return err
+			}
+		}
 
 		for zoneIndex, zone := range pool.Zones {
 			zoneIdx := int32(zoneIndex) // #nosec: G115 - We validate if num pool zones exceeds max_int32.
-			securityGroups := append([]string{nodesSecurityGroup.Name}, workerConfig.AdditionalSecurityGroups...)
-			machineClassSpec := map[string]interface{}{
-				"region":           w.worker.Spec.Region,
-				"availabilityZone": zone,
-				"machineType":      pool.MachineType,
-				"keyName":          infrastructureStatus.Node.KeyName,
-				"networkID":        infrastructureStatus.Networks.ID,
-				"podNetworkCIDRs":  extensionscontroller.GetPodNetwork(w.cluster),
-				"securityGroups":   securityGroups,
-				"tags": utils.MergeStringMaps(
-					NormalizeLabelsForMachineClass(pool.Labels),
-					NormalizeLabelsForMachineClass(machineLabels),
-					map[string]string{
-						fmt.Sprintf("kubernetes.io-cluster-%s", w.cluster.Shoot.Status.TechnicalID): "1",
-						"kubernetes.io-role-node": "1",
-					},
-				),
-				"credentialsSecretRef": map[string]interface{}{
-					"name":      w.worker.Spec.SecretRef.Name,
-					"namespace": w.worker.Spec.SecretRef.Namespace,
-				},
-				"secret": map[string]interface{}{
-					"cloudConfig": string(userData),
-				},
-			}
 
-			// Collect only node subnet IDs; pod/service subnets must not be attached to machines.
-			var subnetIDs []string
-			for _, subnet := range subnets {
-				subnetIDs = append(subnetIDs, subnet.ID)
+			var serverGroupDeps []api.ServerGroupDependency
+			if isServerGroupRequired(workerConfig) {
+				serverGroupZone := ""
+				if isServerGroupPerZone(workerConfig) {
+					serverGroupZone = zone
+				}
+				serverGroupDeps = serverGroupDepSet.getByPoolNameAndZone(pool.Name, serverGroupZone)
+				if len(serverGroupDeps) == 0 {
+					return fmt.Errorf("server group is required for pool %q, but no server group dependency found", pool.Name)
+				}
 			}
+			shardLen := max(int32(len(serverGroupDeps)), 1) // #nosec: G115 - The number of server groups of a pool is bounded by its maximum.
 
-			machineClassSpec["subnetIDs"] = subnetIDs
+			for shard := range shardLen {
+				var serverGroupDep *api.ServerGroupDependency
+				if len(serverGroupDeps) > 0 {
+					serverGroupDep = &serverGroupDeps[shard]
+				}
+				// The shards are rotated per zone, so that the remainders of the zones are spread over different shards.
+				shardIdx := (shard + zoneIdx) % shardLen
 
-			if volumeSize > 0 {
-				machineClassSpec["rootDiskSize"] = volumeSize
-			}
+				workerPoolHash, err := w.generateWorkerPoolHash(pool, serverGroupDep, workerConfig)
+				if err != nil {
+					return err
+				}
+
+				securityGroups := append([]string{nodesSecurityGroup.Name}, workerConfig.AdditionalSecurityGroups...)
+				machineClassSpec := map[string]interface{}{
+					"region":           w.worker.Spec.Region,
+					"availabilityZone": zone,
+					"machineType":      pool.MachineType,
+					"keyName":          infrastructureStatus.Node.KeyName,
+					"networkID":        infrastructureStatus.Networks.ID,
+					"podNetworkCIDRs":  extensionscontroller.GetPodNetwork(w.cluster),
+					"securityGroups":   securityGroups,
+					"tags": utils.MergeStringMaps(
+						NormalizeLabelsForMachineClass(pool.Labels),
+						NormalizeLabelsForMachineClass(machineLabels),
+						w.cloudProfileConfig.ServerMetadata,
+						map[string]string{
+							fmt.Sprintf("kubernetes.io-cluster-%s", w.cluster.Shoot.Status.TechnicalID): "1",
+							"kubernetes.io-role-node": "1",
+						},
+					),
+					"credentialsSecretRef": map[string]interface{}{
+						"name":      w.worker.Spec.SecretRef.Name,
+						"namespace": w.worker.Spec.SecretRef.Namespace,
+					},
+					"secret": map[string]interface{}{
+						"cloudConfig": string(userData),
+					},
+				}
+
+				// Collect only node subnet IDs; pod/service subnets must not be attached to machines.
+				var subnetIDs []string
+				for _, subnet := range subnets {
+					subnetIDs = append(subnetIDs, subnet.ID)
+				}
+
+				machineClassSpec["subnetIDs"] = subnetIDs
 
-			// specifying the volume type requires a custom volume size to be specified too.
-			if pool.Volume != nil && pool.Volume.Type != nil {
-				machineClassSpec["rootDiskType"] = *pool.Volume.Type
-			} else if machineTypeFromCloudProfile.Storage != nil &&
-				machineTypeFromCloudProfile.Storage.Type != "" &&
-				machineTypeFromCloudProfile.Storage.Type != "default" {
-				// Use the storage type from the cloud profile as the default if not explicitly set in the shoot spec.
-				// This is required e.g. for KVM machines that need a "premium" disk type for boot disks.
-				machineClassSpec["rootDiskType"] = machineTypeFromCloudProfile.Storage.Type
-				if machineTypeFromCloudProfile.Storage.StorageSize != nil {
-					cloudProfileVolumeSize, err := worker.DiskSize(machineTypeFromCloudProfile.Storage.StorageSize.String())
-					if err == nil && cloudProfileVolumeSize > 0 {
-						if _, alreadySet := machineClassSpec["rootDiskSize"]; !alreadySet {
-							machineClassSpec["rootDiskSize"] = cloudProfileVolumeSize
+				if volumeSize > 0 {
+					machineClassSpec["rootDiskSize"] = volumeSize
+				}
+
+				if !isBareMetal {
+					// specifying the volume type requires a custom volume size to be specified too.
+					if pool.Volume != nil && pool.Volume.Type != nil {
+						machineClassSpec["rootDiskType"] = *pool.Volume.Type
+					} else if machineTypeFromCloudProfile.Storage != nil &&
+						machineTypeFromCloudProfile.Storage.Type != "" &&
+						machineTypeFromCloudProfile.Storage.Type != "default" {
+						// Use the storage type from the cloud profile as the default if not explicitly set in the shoot spec.
+						// This is required e.g. for KVM machines that need a "premium" disk type for boot disks.
+						machineClassSpec["rootDiskType"] = machineTypeFromCloudProfile.Storage.Type
+						if machineTypeFromCloudProfile.Storage.StorageSize != nil {
+							cloudProfileVolumeSize, err := worker.DiskSize(machineTypeFromCloudProfile.Storage.StorageSize.String())
+							if err == nil && cloudProfileVolumeSize > 0 {
+								if _, alreadySet := machineClassSpec["rootDiskSize"]; !alreadySet {
+									machineClassSpec["rootDiskSize"] = cloudProfileVolumeSize
+								}
+							}
 						}
 					}
 				}
-			}
 
-			if machineImage.ID != "" {
-				machineClassSpec["imageID"] = machineImage.ID
-			} else {
-				machineClassSpec["imageName"] = machineImage.Image
-			}
+				if machineImage.ID != "" {
+					machineClassSpec["imageID"] = machineImage.ID
+				} else {
+					machineClassSpec["imageName"] = machineImage.Image
+				}
 
-			if serverGroupDep != nil {
-				machineClassSpec["serverGroupID"] = serverGroupDep.ID
-			}
+				// TODO: Pass the other Nova scheduler hints (different_host, same_host, build_near_host_ip and query) once the
+				// machine-controller-manager provider for OpenStack supports them.
+				if serverGroupDep != nil {
+					machineClassSpec["serverGroupID"] = serverGroupDep.ID
+				}
 
-			var nodeTemplate machinev1alpha1.NodeTemplate
-			if pool.NodeTemplate != nil {
-				nodeTemplate = machinev1alpha1.NodeTemplate{
-					Capacity:        pool.NodeTemplate.Capacity.DeepCopy(),
-					VirtualCapacity: pool.NodeTemplate.VirtualCapacity.DeepCopy(),
-					InstanceType:    pool.MachineType,
-					Region:          w.worker.Spec.Region,
-					Zone:            zone,
-					Architecture:    ptr.To(architecture),
+				if ptr.Deref(workerConfig.UseConfigDrive, false) {
+					machineClassSpec["useConfigDrive"] = true
 				}
-			}
-			if workerConfig.NodeTemplate != nil {
-				// Support providerConfig extended resources by copying into node template capacity and virtualCapacity
-				maps.Copy(nodeTemplate.Capacity, workerConfig.NodeTemplate.Capacity)
-				if nodeTemplate.VirtualCapacity == nil {
-					nodeTemplate.VirtualCapacity = corev1.ResourceList{}
+
+				var nodeTemplate machinev1alpha1.NodeTemplate
+				flavorCapacity, hasFlavorCapacity := w.flavorCapacities[pool.MachineType]
+				if pool.NodeTemplate != nil || hasFlavorCapacity {
+					nodeTemplate = machinev1alpha1.NodeTemplate{
+						Capacity:     corev1.ResourceList{},
+						InstanceType: pool.MachineType,
+						Region:       w.worker.Spec.Region,
+						Zone:         zone,
+						Architecture: ptr.To(architecture),
+					}
+					if pool.NodeTemplate != nil && pool.NodeTemplate.Capacity != nil {
+						nodeTemplate.Capacity = pool.NodeTemplate.Capacity.DeepCopy()
+					}
+					if pool.NodeTemplate != nil {
+						nodeTemplate.VirtualCapacity = pool.NodeTemplate.VirtualCapacity.DeepCopy()
+					}
+					if hasFlavorCapacity {
+						// The capacity derived from the flavor is only determined if the CloudProfile opts in to it or for bare metal
+						// machine types, and it is more accurate than the one of the machine type in the CloudProfile.
+						maps.Copy(nodeTemplate.Capacity, flavorCapacity)
+						// Machines booting from a volume use it instead of the root disk of the flavor.
+						if rootDiskSize, ok := machineClassSpec["rootDiskSize"].(int); ok {
+							nodeTemplate.Capacity[corev1.ResourceEphemeralStorage] = *resource.NewQuantity(int64(rootDiskSize)*1024*1024*1024, resource.BinarySI)
+						}
+					}
 				}
-				maps.Copy(nodeTemplate.VirtualCapacity, workerConfig.NodeTemplate.VirtualCapacity)
-			}
-			machineClassSpec["nodeTemplate"] = nodeTemplate
+				if workerConfig.NodeTemplate != nil {
+					// Support providerConfig extended resources by copying into node template capacity and virtualCapacity
+					maps.Copy(nodeTemplate.Capacity, workerConfig.NodeTemplate.Capacity)
+					if nodeTemplate.VirtualCapacity == nil {
+						nodeTemplate.VirtualCapacity = corev1.ResourceList{}
+					}
+					maps.Copy(nodeTemplate.VirtualCapacity, workerConfig.NodeTemplate.VirtualCapacity)
+				}
+				machineClassSpec["nodeTemplate"] = nodeTemplate
 
-			var (
-				deploymentName = fmt.Sprintf("%s-%s-z%d", w.cluster.Shoot.Status.TechnicalID, pool.Name, zoneIndex+1)
-				className      = fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
-			)
+				deploymentName := fmt.Sprintf("%s-%s-z%d", w.cluster.Shoot.Status.TechnicalID, pool.Name, zoneIndices[zoneIndex].Index+1)
+				if serverGroupDep != nil && serverGroupDep.Index > 0 {
+					deploymentName = fmt.Sprintf("%s-s%d", deploymentName, serverGroupDep.Index+1)
+				}
+				className := fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
 
-			updateConfiguration := machinev1alpha1.UpdateConfiguration{
-				MaxUnavailable: ptr.To(worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxUnavailable, zoneLen, pool.Minimum)),
-				MaxSurge:       ptr.To(worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum)),
-			}
+				zoneMinimum, zoneMaximum := distributeOverAvailableZones(zoneIdx, pool.Zones, pool.Minimum, pool.Maximum, readyMachines)
 
-			machineDeploymentStrategy := machinev1alpha1.MachineDeploymentStrategy{
-				Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
-				RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
-					UpdateConfiguration: updateConfiguration,
-				},
-			}
+				updateConfiguration := machinev1alpha1.UpdateConfiguration{
+					MaxUnavailable: ptr.To(worker.DistributePositiveIntOrPercent(shardIdx, worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxUnavailable, zoneLen, pool.Minimum), shardLen, zoneMinimum)),
+					MaxSurge:       ptr.To(worker.DistributePositiveIntOrPercent(shardIdx, worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum), shardLen, zoneMaximum)),
+				}
 
-			if gardencorev1beta1helper.IsUpdateStrategyInPlace(pool.UpdateStrategy) {
-				machineDeploymentStrategy = machinev1alpha1.MachineDeploymentStrategy{
-					Type: machinev1alpha1.InPlaceUpdateMachineDeploymentStrategyType,
-					InPlaceUpdate: &machinev1alpha1.InPlaceUpdateMachineDeployment{
+				machineDeploymentStrategy := machinev1alpha1.MachineDeploymentStrategy{
+					Type: machinev1alpha1.RollingUpdateMachineDeploymentStrategyType,
+					RollingUpdate: &machinev1alpha1.RollingUpdateMachineDeployment{
 						UpdateConfiguration: updateConfiguration,
-						OrchestrationType:   machinev1alpha1.OrchestrationTypeAuto,
 					},
 				}
 
-				if gardencorev1beta1helper.IsUpdateStrategyManualInPlace(pool.UpdateStrategy) {
-					machineDeploymentStrategy.InPlaceUpdate.OrchestrationType = machinev1alpha1.OrchestrationTypeManual
+				if gardencorev1beta1helper.IsUpdateStrategyInPlace(pool.UpdateStrategy) {
+					machineDeploymentStrategy = machinev1alpha1.MachineDeploymentStrategy{
+						Type: machinev1alpha1.InPlaceUpdateMachineDeploymentStrategyType,
+						InPlaceUpdate: &machinev1alpha1.InPlaceUpdateMachineDeployment{
+							UpdateConfiguration: updateConfiguration,
+							OrchestrationType:   machinev1alpha1.OrchestrationTypeAuto,
+						},
+					}
+
+					if gardencorev1beta1helper.IsUpdateStrategyManualInPlace(pool.UpdateStrategy) {
+						machineDeploymentStrategy.InPlaceUpdate.OrchestrationType = machinev1alpha1.OrchestrationTypeManual
+					}
+				}
+				var preserveMax int32
+				if pool.MachineControllerManagerSettings != nil {
+					preserveMax = ptr.Deref(pool.MachineControllerManagerSettings.AutoPreserveFailedMachineMax, 0)
 				}
-			}
-			var preserveMax int32
-			if pool.MachineControllerManagerSettings != nil {
-				preserveMax = ptr.Deref(pool.MachineControllerManagerSettings.AutoPreserveFailedMachineMax, 0)
-			}
 
-			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:                         deploymentName,
-				ClassName:                    className,
-				SecretName:                   className,
-				PoolName:                     pool.Name,
-				Minimum:                      worker.DistributeOverZones(zoneIdx, pool.Minimum, zoneLen),
-				Maximum:                      worker.DistributeOverZones(zoneIdx, pool.Maximum, zoneLen),
-				Strategy:                     machineDeploymentStrategy,
-				Priority:                     pool.Priority,
-				Labels:                       addTopologyLabel(pool.Labels, zone),
-				Annotations:                  pool.Annotations,
-				Taints:                       pool.Taints,
-				MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
-				ClusterAutoscalerAnnotations: extensionsv1alpha1helper.GetMachineDeploymentClusterAutoscalerAnnotations(pool.ClusterAutoscaler),
-				AutoPreserveFailedMachineMax: worker.DistributeOverZones(zoneIdx, preserveMax, zoneLen),
-			})
-
-			machineClassSpec["name"] = className
-			machineClassSpec["labels"] = map[string]string{
-				v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass,
-			}
+				machineDeployments = append(machineDeployments, worker.MachineDeployment{
+					Name:                         deploymentName,
+					ClassName:                    className,
+					SecretName:                   className,
+					PoolName:                     pool.Name,
+					Minimum:                      worker.DistributeOverZones(shardIdx, zoneMinimum, shardLen),
+					Maximum:                      worker.DistributeOverZones(shardIdx, zoneMaximum, shardLen),
+					Strategy:                     machineDeploymentStrategy,
+					Priority:                     pool.Priority,
+					Labels:                       addTopologyLabel(pool.Labels, zone, helper.FindVolumeZone(w.cloudProfileConfig.VolumeAZMappings, w.worker.Spec.Region, zone)),
+					Annotations:                  pool.Annotations,
+					Taints:                       pool.Taints,
+					MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
+					ClusterAutoscalerAnnotations: extensionsv1alpha1helper.GetMachineDeploymentClusterAutoscalerAnnotations(pool.ClusterAutoscaler),
+					AutoPreserveFailedMachineMax: worker.DistributeOverZones(shardIdx, worker.DistributeOverZones(zoneIdx, preserveMax, zoneLen), shardLen),
+				})
+
+				machineClassSpec["name"] = className
+				machineClassSpec["labels"] = map[string]string{
+					v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass,
+				}
 
-			if pool.MachineImage.Name != "" && pool.MachineImage.Version != "" {
-				machineClassSpec["operatingSystem"] = map[string]interface{}{
-					"operatingSystemName":    pool.MachineImage.Name,
-					"operatingSystemVersion": strings.ReplaceAll(pool.MachineImage.Version, "+", "_"),
+				if pool.MachineImage.Name != "" && pool.MachineImage.Version != "" {
+					machineClassSpec["operatingSystem"] = map[string]interface{}{
+						"operatingSystemName":    pool.MachineImage.Name,
+						"operatingSystemVersion": strings.ReplaceAll(pool.MachineImage.Version, "+", "_"),
+					}
 				}
-			}
 
-			machineClasses = append(machineClasses, machineClassSpec)
+				machineClasses = append(machineClasses, machineClassSpec)
+			}
 		}
 	}
 
 	w.machineDeployments = machineDeployments
 	w.machineClasses = machineClasses
 	w.machineImages = EnsureUniformMachineImages(machineImages, w.cluster.CloudProfile.Spec.MachineCapabilities)
+	w.workerPoolZones = workerPoolZones
 
 	return nil
 }
@@ -374,6 +445,10 @@ func (w *WorkerDelegate) generateWorkerPoolHash(pool extensionsv1alpha1.WorkerPo
 		additionalHashData = append(additionalHashData, sortedSGs...)
 	}
 
+	if ptr.Deref(workerConfig.UseConfigDrive, false) {
+		additionalHashData = append(additionalHashData, "useConfigDrive")
+	}
+
 	// hash v1 would otherwise hash the ProviderConfig
 	pool.ProviderConfig = nil
 
@@ -393,9 +468,9 @@ func NormalizeLabelsForMachineClass(in map[string]string) map[string]string {
 	return res
 }
 
-func addTopologyLabel(labels map[string]string, zone string) map[string]string {
+func addTopologyLabel(labels map[string]string, zone, volumeZone string) map[string]string {
 	return utils.MergeStringMaps(labels, map[string]string{
-		openstack.CSIDiskDriverTopologyKey:   zone,
+		openstack.CSIDiskDriverTopologyKey:   volumeZone,
 		openstack.CSIManilaDriverTopologyKey: zone,
 	})
 }
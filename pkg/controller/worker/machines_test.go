@@ -5,10 +5,15 @@
 package worker_test
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/rand"
 	"embed"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
 	"path/filepath"
 	"strings"
 	"time"
@@ -23,8 +28,14 @@ import (
 	mockkubernetes "github.com/gardener/gardener/pkg/client/kubernetes/mock"
 	"github.com/gardener/gardener/pkg/utils"
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/baremetal/v1/nodes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -40,6 +51,7 @@ import (
 	apiv1alpha1 "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
 	. "github.com/gardener/gardener-extension-provider-openstack/pkg/controller/worker"
 	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client/mocks"
 )
 
 var _ = Describe("Machines", func() {
@@ -313,6 +325,10 @@ var _ = Describe("Machines", func() {
 						},
 					},
 					Shoot: &gardencorev1beta1.Shoot{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "openstack",
+							Namespace: "garden-foobar",
+						},
 						Spec: gardencorev1beta1.ShootSpec{
 							Networking: &gardencorev1beta1.Networking{
 								Pods: &podCIDR,
@@ -548,6 +564,7 @@ var _ = Describe("Machines", func() {
 				fakeScheme := runtime.NewScheme()
 				Expect(corev1.AddToScheme(fakeScheme)).To(Succeed())
 				Expect(extensionsv1alpha1.AddToScheme(fakeScheme)).To(Succeed())
+				Expect(machinev1alpha1.AddToScheme(fakeScheme)).To(Succeed())
 				c = fakeclient.NewClientBuilder().
 					WithScheme(fakeScheme).
 					WithObjects(
@@ -1358,6 +1375,27 @@ var _ = Describe("Machines", func() {
 				Expect(result[1].AutoPreserveFailedMachineMax).To(Equal(int32(0)))
 			})
 
+			It("should set the mapped volume availability zone as Cinder topology label", func() {
+				cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+				Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+				cloudProfileConfig.VolumeAZMappings = []apiv1alpha1.VolumeAZMapping{
+					{Region: region, ComputeZone: zone1, VolumeZone: "cinder-1"},
+					{Region: "other-region", ComputeZone: zone2, VolumeZone: "cinder-2"},
+				}
+				clusterWithVolumeAZMappings := &extensionscontroller.Cluster{
+					CloudProfile: cluster.CloudProfile.DeepCopy(),
+					Shoot:        cluster.Shoot,
+					Seed:         cluster.Seed,
+				}
+				clusterWithVolumeAZMappings.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfig)}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithVolumeAZMappings, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].Labels).To(Equal(map[string]string{openstack.CSIDiskDriverTopologyKey: "cinder-1", openstack.CSIManilaDriverTopologyKey: zone1}))
+				Expect(result[1].Labels).To(Equal(map[string]string{openstack.CSIDiskDriverTopologyKey: zone2, openstack.CSIManilaDriverTopologyKey: zone2}))
+			})
+
 			It("should use storage type and size from cloud profile machine type as default when no pool volume is specified", func() {
 				premiumStorageSize := resource.MustParse("64Gi")
 				clusterWithPremiumMachineType := &extensionscontroller.Cluster{
@@ -1419,6 +1457,1034 @@ var _ = Describe("Machines", func() {
 					}
 				}
 			})
+
+			It("should keep the machine deployment names of existing zones when the zones of a worker pool change", func() {
+				zone3 := region + "c"
+				w.Spec.Pools[0].Zones = []string{zone2, zone3}
+				w.Status.ProviderStatus = &runtime.RawExtension{
+					Object: &apiv1alpha1.WorkerStatus{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerStatus",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						WorkerPoolZones: []apiv1alpha1.WorkerPoolZones{
+							{
+								PoolName: namePool1,
+								Zones: []apiv1alpha1.ZoneIndex{
+									{Name: zone1, Index: 0},
+									{Name: zone2, Index: 1},
+								},
+							},
+						},
+					},
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].Name).To(Equal(fmt.Sprintf("%s-%s-z2", technicalID, namePool1)))
+				Expect(result[1].Name).To(Equal(fmt.Sprintf("%s-%s-z3", technicalID, namePool1)))
+				Expect(result[2].Name).To(Equal(fmt.Sprintf("%s-%s-z1", technicalID, namePool2)))
+				Expect(result[3].Name).To(Equal(fmt.Sprintf("%s-%s-z2", technicalID, namePool2)))
+			})
+
+			It("should derive the machine deployment names of existing zones from the machine deployments if the zones are not recorded", func() {
+				for i, zone := range []string{zone1, zone2} {
+					Expect(c.Create(ctx, &machinev1alpha1.MachineDeployment{
+						ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-%s-z%d", technicalID, namePool1, i+1), Namespace: namespace},
+						Spec: machinev1alpha1.MachineDeploymentSpec{
+							Template: machinev1alpha1.MachineTemplateSpec{
+								Spec: machinev1alpha1.MachineSpec{
+									NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+										ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+											v1beta1constants.LabelWorkerPool:     namePool1,
+											openstack.CSIManilaDriverTopologyKey: zone,
+										}},
+									},
+								},
+							},
+						},
+					})).To(Succeed())
+				}
+				w.Spec.Pools[0].Zones = []string{zone2}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].Name).To(Equal(fmt.Sprintf("%s-%s-z2", technicalID, namePool1)))
+				Expect(result[1].Name).To(Equal(fmt.Sprintf("%s-%s-z1", technicalID, namePool2)))
+			})
+
+			It("should ignore machine deployments of other worker pools when deriving the machine deployment names of existing zones", func() {
+				zone3 := region + "c"
+				for name, labels := range map[string]map[string]string{
+					fmt.Sprintf("%s-%s-z1", technicalID, namePool1):    {v1beta1constants.LabelWorkerPool: namePool1, openstack.CSIManilaDriverTopologyKey: zone1},
+					fmt.Sprintf("%s-%s-z4", technicalID, namePool1):    {openstack.CSIManilaDriverTopologyKey: zone3},
+					fmt.Sprintf("%s-%s-z5-z1", technicalID, namePool1): {v1beta1constants.LabelWorkerPool: namePool1, openstack.CSIManilaDriverTopologyKey: zone3},
+				} {
+					Expect(c.Create(ctx, &machinev1alpha1.MachineDeployment{
+						ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+						Spec: machinev1alpha1.MachineDeploymentSpec{
+							Template: machinev1alpha1.MachineTemplateSpec{
+								Spec: machinev1alpha1.MachineSpec{
+									NodeTemplateSpec: machinev1alpha1.NodeTemplateSpec{
+										ObjectMeta: metav1.ObjectMeta{Labels: labels},
+									},
+								},
+							},
+						},
+					})).To(Succeed())
+				}
+				w.Spec.Pools[0].Zones = []string{zone1, zone3}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result[0].Name).To(Equal(fmt.Sprintf("%s-%s-z1", technicalID, namePool1)))
+				Expect(result[1].Name).To(Equal(fmt.Sprintf("%s-%s-z2", technicalID, namePool1)))
+			})
+
+			It("should spread a worker pool over its server group shards", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Object: &apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						ServerGroup: &apiv1alpha1.ServerGroup{Policy: "anti-affinity"},
+					},
+				}
+				w.Status.ProviderStatus = &runtime.RawExtension{
+					Object: &apiv1alpha1.WorkerStatus{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerStatus",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						ServerGroupDependencies: []apiv1alpha1.ServerGroupDependency{
+							{PoolName: namePool1, ID: "sg-id-1", Name: "sg-1"},
+							{PoolName: namePool1, ID: "sg-id-2", Name: "sg-2", Index: 1},
+						},
+					},
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, nil)
+
+				result, err := workerDelegate.GenerateMachineDeployments(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(8))
+				Expect(result[0].Name).To(Equal(fmt.Sprintf("%s-%s-z1", technicalID, namePool1)))
+				Expect(result[1].Name).To(Equal(fmt.Sprintf("%s-%s-z1-s2", technicalID, namePool1)))
+				Expect(result[2].Name).To(Equal(fmt.Sprintf("%s-%s-z2", technicalID, namePool1)))
+				Expect(result[3].Name).To(Equal(fmt.Sprintf("%s-%s-z2-s2", technicalID, namePool1)))
+
+				var minimum, maximum int32
+				for _, deployment := range result[:4] {
+					minimum += deployment.Minimum
+					maximum += deployment.Maximum
+				}
+				Expect(minimum).To(Equal(minPool1))
+				Expect(maximum).To(Equal(maxPool1))
+
+				var capturedMachineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+					).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, o := range opts {
+							o.MutateApplyOptions(applyOpts)
+						}
+						if values, ok := applyOpts.Values.(map[string]interface{}); ok {
+							if classes, ok := values["machineClasses"].([]map[string]interface{}); ok {
+								capturedMachineClasses = classes
+							}
+						}
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				Expect(capturedMachineClasses).To(HaveLen(8))
+				for i, serverGroupID := range []string{"sg-id-1", "sg-id-2", "sg-id-1", "sg-id-2"} {
+					Expect(capturedMachineClasses[i]).To(HaveKeyWithValue("serverGroupID", serverGroupID))
+				}
+			})
+
+			It("should reference the server group of the zone if there is one server group per zone", func() {
+				w.Spec.Pools[0].ProviderConfig = &runtime.RawExtension{
+					Object: &apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						ServerGroup: &apiv1alpha1.ServerGroup{Policy: "affinity", PerZone: ptr.To(true)},
+					},
+				}
+				w.Status.ProviderStatus = &runtime.RawExtension{
+					Object: &apiv1alpha1.WorkerStatus{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerStatus",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						ServerGroupDependencies: []apiv1alpha1.ServerGroupDependency{
+							{PoolName: namePool1, ID: "sg-id-1", Name: "sg-1", Zone: zone1},
+							{PoolName: namePool1, ID: "sg-id-2", Name: "sg-2", Zone: zone2},
+						},
+					},
+				}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, nil)
+
+				var capturedMachineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+					).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, o := range opts {
+							o.MutateApplyOptions(applyOpts)
+						}
+						if values, ok := applyOpts.Values.(map[string]interface{}); ok {
+							if classes, ok := values["machineClasses"].([]map[string]interface{}); ok {
+								capturedMachineClasses = classes
+							}
+						}
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				Expect(capturedMachineClasses).To(HaveLen(6))
+				Expect(capturedMachineClasses[0]).To(HaveKeyWithValue("availabilityZone", zone1))
+				Expect(capturedMachineClasses[0]).To(HaveKeyWithValue("serverGroupID", "sg-id-1"))
+				Expect(capturedMachineClasses[1]).To(HaveKeyWithValue("availabilityZone", zone2))
+				Expect(capturedMachineClasses[1]).To(HaveKeyWithValue("serverGroupID", "sg-id-2"))
+			})
+
+			It("should set the server metadata of the CloudProfile", func() {
+				cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+				Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+				cloudProfileConfig.ServerMetadata = map[string]string{
+					"cost-center":             "1234",
+					"kubernetes.io-role-node": "0",
+				}
+				clusterWithServerMetadata := &extensionscontroller.Cluster{
+					CloudProfile: cluster.CloudProfile.DeepCopy(),
+					Shoot:        cluster.Shoot,
+					Seed:         cluster.Seed,
+				}
+				clusterWithServerMetadata.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfig)}
+				workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithServerMetadata, nil)
+
+				var capturedMachineClasses []map[string]interface{}
+				chartApplier.
+					EXPECT().
+					ApplyFromEmbeddedFS(
+						ctx,
+						charts.InternalChart,
+						filepath.Join("internal", "machineclass"),
+						namespace,
+						"machineclass",
+						gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+					).
+					DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+						applyOpts := &kubernetes.ApplyOptions{}
+						for _, o := range opts {
+							o.MutateApplyOptions(applyOpts)
+						}
+						if values, ok := applyOpts.Values.(map[string]interface{}); ok {
+							if classes, ok := values["machineClasses"].([]map[string]interface{}); ok {
+								capturedMachineClasses = classes
+							}
+						}
+						return nil
+					})
+
+				Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+				Expect(capturedMachineClasses).To(HaveLen(6))
+				Expect(capturedMachineClasses[0]).To(HaveKeyWithValue("tags", map[string]string{
+					fmt.Sprintf("kubernetes.io-cluster-%s", technicalID): "1",
+					"kubernetes.io-role-node":                            "1",
+					"cost-center":                                        "1234",
+				}))
+			})
+
+			Context("oversized user data", func() {
+				var (
+					setUserData = func(data []byte) {
+						secret := &corev1.Secret{}
+						Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: userDataSecretName}, secret)).To(Succeed())
+						secret.Data[userDataSecretDataKey] = data
+						Expect(c.Update(ctx, secret)).To(Succeed())
+					}
+					incompressibleUserData = func(prefix string, size int) []byte {
+						random := make([]byte, size)
+						_, err := rand.Read(random)
+						Expect(err).NotTo(HaveOccurred())
+						return []byte(prefix + base64.StdEncoding.EncodeToString(random) + "\n")
+					}
+				)
+
+				It("should compress oversized user data of cloud-init", func() {
+					largeUserData := []byte("#cloud-config\n" + strings.Repeat("write_files: []\n", 10000))
+					setUserData(largeUserData)
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, nil)
+
+					var capturedMachineClasses []map[string]interface{}
+					chartApplier.
+						EXPECT().
+						ApplyFromEmbeddedFS(
+							ctx,
+							charts.InternalChart,
+							filepath.Join("internal", "machineclass"),
+							namespace,
+							"machineclass",
+							gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+						).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOpts := &kubernetes.ApplyOptions{}
+							for _, o := range opts {
+								o.MutateApplyOptions(applyOpts)
+							}
+							if values, ok := applyOpts.Values.(map[string]interface{}); ok {
+								if classes, ok := values["machineClasses"].([]map[string]interface{}); ok {
+									capturedMachineClasses = classes
+								}
+							}
+							return nil
+						})
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+					Expect(capturedMachineClasses).NotTo(BeEmpty())
+					reader, err := gzip.NewReader(strings.NewReader(capturedMachineClasses[0]["secret"].(map[string]interface{})["cloudConfig"].(string)))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(io.ReadAll(reader)).To(Equal(largeUserData))
+				})
+
+				It("should fail if oversized user data cannot be compressed and must not be stored in Swift", func() {
+					setUserData(incompressibleUserData(`{"ignition":{"version":"3.4.0"},"storage":{"files":[{"contents":{"source":"data:,`, 50000))
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, nil)
+
+					err := workerDelegate.DeployMachineClasses(ctx)
+					Expect(err).To(MatchError(ContainSubstring(`user data of worker pool "pool-1" exceeds the size limit of Nova`)))
+				})
+
+				It("should fail if oversized user data must be stored in Swift but the worker pool does not opt in", func() {
+					setUserData(incompressibleUserData("#!/bin/bash\n# ", 50000))
+
+					cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+					Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+					cloudProfileConfig.StoreOversizedUserData = ptr.To(true)
+					clusterWithSwift := &extensionscontroller.Cluster{
+						CloudProfile: cluster.CloudProfile.DeepCopy(),
+						Shoot:        cluster.Shoot,
+						Seed:         cluster.Seed,
+					}
+					clusterWithSwift.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfig)}
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithSwift, nil)
+
+					err := workerDelegate.DeployMachineClasses(ctx)
+					Expect(err).To(MatchError(ContainSubstring(`user data of worker pool "pool-1" exceeds the size limit of Nova`)))
+				})
+
+				It("should report user data which is close to the size limit with a condition", func() {
+					setUserData(incompressibleUserData("#!/bin/bash\n# ", 35000))
+
+					osFactory := mocks.NewMockFactory(ctrl)
+					computeClient := mocks.NewMockCompute(ctrl)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil)
+					computeClient.EXPECT().ListServerGroups(ctx).Return(nil, nil)
+					clusterWithUID := &extensionscontroller.Cluster{
+						CloudProfile: cluster.CloudProfile,
+						Shoot:        cluster.Shoot.DeepCopy(),
+						Seed:         cluster.Seed,
+					}
+					clusterWithUID.Shoot.UID = "12345678-1234-1234-1234-123456789012"
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithUID, osFactory)
+
+					chartApplier.
+						EXPECT().
+						ApplyFromEmbeddedFS(
+							ctx,
+							charts.InternalChart,
+							filepath.Join("internal", "machineclass"),
+							namespace,
+							"machineclass",
+							gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+						).
+						Return(nil)
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(w), w)).To(Succeed())
+					Expect(w.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":    Equal(UserDataWithinSizeLimitConditionType),
+						"Status":  Equal(gardencorev1beta1.ConditionFalse),
+						"Reason":  Equal("UserDataCloseToSizeLimit"),
+						"Message": ContainSubstring(`"pool-1"`),
+					})))
+				})
+
+				It("should store oversized user data in Swift and remove stale objects", func() {
+					largeUserData := incompressibleUserData("#!/bin/bash\n# ", 50000)
+					setUserData(largeUserData)
+
+					cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+					Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+					cloudProfileConfig.StoreOversizedUserData = ptr.To(true)
+					clusterWithSwift := &extensionscontroller.Cluster{
+						CloudProfile: cluster.CloudProfile.DeepCopy(),
+						Shoot:        cluster.Shoot.DeepCopy(),
+						Seed:         cluster.Seed,
+					}
+					clusterWithSwift.Shoot.UID = "12345678-1234-1234-1234-123456789012"
+					clusterWithSwift.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfig)}
+					workerConfig := &apiv1alpha1.WorkerConfig{
+						TypeMeta: metav1.TypeMeta{
+							Kind:       "WorkerConfig",
+							APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+						},
+						StoreOversizedUserData: ptr.To(true),
+					}
+					for i := range w.Spec.Pools {
+						w.Spec.Pools[i].ProviderConfig = &runtime.RawExtension{Raw: encode(workerConfig)}
+					}
+
+					var (
+						container     = technicalID + "-user-data"
+						osFactory     = mocks.NewMockFactory(ctrl)
+						storageClient = mocks.NewMockStorage(ctrl)
+						computeClient = mocks.NewMockCompute(ctrl)
+						objects       = map[string]struct{}{}
+					)
+					osFactory.EXPECT().Storage(gomock.Any()).Return(storageClient, nil).AnyTimes()
+					storageClient.EXPECT().CreateContainerIfNotExists(ctx, container).Return(nil).AnyTimes()
+					storageClient.EXPECT().EnsureContainerTempURLKey(ctx, container).Return(nil).AnyTimes()
+					storageClient.EXPECT().CreateObject(ctx, container, gomock.Any(), largeUserData).DoAndReturn(func(_ context.Context, _, object string, _ []byte) error {
+						objects[object] = struct{}{}
+						return nil
+					}).Times(3)
+					storageClient.EXPECT().CreateTempURL(ctx, container, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _, object string, expiresAt time.Time) (string, error) {
+						Expect(expiresAt).To(BeTemporally(">", time.Now().Add(71*time.Hour)))
+						Expect(expiresAt).To(BeTemporally("<=", time.Now().Add(72*time.Hour)))
+						return "https://swift.example.com/" + object, nil
+					}).Times(3)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithSwift, osFactory)
+
+					var capturedMachineClasses []map[string]interface{}
+					chartApplier.
+						EXPECT().
+						ApplyFromEmbeddedFS(
+							ctx,
+							charts.InternalChart,
+							filepath.Join("internal", "machineclass"),
+							namespace,
+							"machineclass",
+							gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+						).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOpts := &kubernetes.ApplyOptions{}
+							for _, o := range opts {
+								o.MutateApplyOptions(applyOpts)
+							}
+							if values, ok := applyOpts.Values.(map[string]interface{}); ok {
+								if classes, ok := values["machineClasses"].([]map[string]interface{}); ok {
+									capturedMachineClasses = classes
+								}
+							}
+							return nil
+						})
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+					Expect(objects).To(HaveLen(3))
+					for _, class := range capturedMachineClasses {
+						Expect(class["secret"]).To(HaveKeyWithValue("cloudConfig", MatchRegexp(`^#include\nhttps://swift.example.com/pool-\d-[0-9a-f]{16}\n$`)))
+					}
+
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil)
+					computeClient.EXPECT().ListServerGroups(ctx).Return(nil, nil)
+					var existingObjects []string
+					for object := range objects {
+						existingObjects = append(existingObjects, object)
+					}
+					storageClient.EXPECT().ListObjectNames(ctx, container).Return(append(existingObjects, "pool-1-stale"), nil)
+					storageClient.EXPECT().DeleteObject(ctx, container, "pool-1-stale").Return(nil)
+
+					Expect(workerDelegate.PostReconcileHook(ctx)).To(Succeed())
+				})
+			})
+
+			Context("flavor capacities", func() {
+				var (
+					clusterWithFlavorCapacity *extensionscontroller.Cluster
+					osFactory                 *mocks.MockFactory
+					computeClient             *mocks.MockCompute
+				)
+
+				BeforeEach(func() {
+					cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+					Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+					cloudProfileConfig.FlavorPCIAliases = []apiv1alpha1.FlavorPCIAlias{{Name: "a100", ResourceName: "nvidia.com/gpu"}}
+					cloudProfileConfig.UseFlavorCapacity = ptr.To(true)
+					clusterWithFlavorCapacity = &extensionscontroller.Cluster{
+						CloudProfile: cluster.CloudProfile.DeepCopy(),
+						Shoot:        cluster.Shoot,
+						Seed:         cluster.Seed,
+					}
+					clusterWithFlavorCapacity.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfig)}
+					w.Spec.Pools[1].Volume = &extensionsv1alpha1.Volume{Size: "80Gi"}
+					Expect(c.Update(ctx, w)).To(Succeed())
+
+					osFactory = mocks.NewMockFactory(ctrl)
+					computeClient = mocks.NewMockCompute(ctrl)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil)
+				})
+
+				deployMachineClasses := func() []map[string]interface{} {
+					var capturedMachineClasses []map[string]interface{}
+					chartApplier.
+						EXPECT().
+						ApplyFromEmbeddedFS(
+							ctx,
+							charts.InternalChart,
+							filepath.Join("internal", "machineclass"),
+							namespace,
+							"machineclass",
+							gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+						).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOpts := &kubernetes.ApplyOptions{}
+							for _, o := range opts {
+								o.MutateApplyOptions(applyOpts)
+							}
+							if values, ok := applyOpts.Values.(map[string]interface{}); ok {
+								if classes, ok := values["machineClasses"].([]map[string]interface{}); ok {
+									capturedMachineClasses = classes
+								}
+							}
+							return nil
+						})
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+					Expect(capturedMachineClasses).To(HaveLen(6))
+					return capturedMachineClasses
+				}
+
+				expectNodeCapacities := func(machineClasses []map[string]interface{}, expectedCapacities ...corev1.ResourceList) {
+					for i, expectedCapacity := range expectedCapacities {
+						for _, class := range machineClasses[2*i : 2*i+2] {
+							nodeTemplate := class["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+							Expect(nodeTemplate.Capacity).To(HaveLen(len(expectedCapacity)))
+							for name, quantity := range expectedCapacity {
+								Expect(nodeTemplate.Capacity).To(HaveKeyWithValue(name, BeComparableTo(quantity)))
+							}
+						}
+					}
+				}
+
+				It("should derive the node templates from the flavors of the machine types", func() {
+					computeClient.EXPECT().ListFlavors(ctx).Return([]flavors.Flavor{
+						{ID: "flavor-id", Name: machineType, VCPUs: 16, RAM: 65536, Disk: 50},
+						{ID: "other-flavor-id", Name: "other"},
+					}, nil)
+					computeClient.EXPECT().ListFlavorExtraSpecs(ctx, "flavor-id").Return(map[string]string{
+						"resources:VGPU":        "2",
+						"pci_passthrough:alias": "a100:4,nic:1",
+					}, nil)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithFlavorCapacity, osFactory)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					Expect(decodeWorkerStatus(w).Flavors).To(Equal([]apiv1alpha1.Flavor{{
+						Name:       machineType,
+						VCPUs:      16,
+						RAM:        65536,
+						Disk:       50,
+						ExtraSpecs: map[string]string{"resources:VGPU": "2", "pci_passthrough:alias": "a100:4,nic:1"},
+					}}))
+					expectNodeCapacities(deployMachineClasses(),
+						corev1.ResourceList{
+							"cpu":               resource.MustParse("16"),
+							"memory":            resource.MustParse("64Gi"),
+							"ephemeral-storage": resource.MustParse("50Gi"),
+							"gpu":               resource.MustParse("2"),
+							"nvidia.com/gpu":    resource.MustParse("4"),
+						},
+						corev1.ResourceList{
+							"cpu":               resource.MustParse("16"),
+							"memory":            resource.MustParse("64Gi"),
+							"ephemeral-storage": resource.MustParse("80Gi"),
+							"gpu":               resource.MustParse("2"),
+							"nvidia.com/gpu":    resource.MustParse("4"),
+						},
+						nodeCapacity,
+					)
+				})
+
+				It("should read the flavors again which are kept in the worker status", func() {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerStatus",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							Flavors: []apiv1alpha1.Flavor{
+								{Name: machineType, VCPUs: 8, RAM: 32768, Disk: 40},
+							},
+						}),
+					}
+					Expect(c.Status().Update(ctx, w)).To(Succeed())
+					computeClient.EXPECT().ListFlavors(ctx).Return([]flavors.Flavor{
+						{ID: "flavor-id", Name: machineType, VCPUs: 16, RAM: 65536, Disk: 50},
+					}, nil)
+					computeClient.EXPECT().ListFlavorExtraSpecs(ctx, "flavor-id").Return(nil, nil)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithFlavorCapacity, osFactory)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					Expect(decodeWorkerStatus(w).Flavors).To(Equal([]apiv1alpha1.Flavor{{
+						Name:  machineType,
+						VCPUs: 16,
+						RAM:   65536,
+						Disk:  50,
+					}}))
+				})
+
+				It("should use the flavors kept in the worker status if the flavors cannot be read", func() {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerStatus",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							Flavors: []apiv1alpha1.Flavor{
+								{Name: machineType, VCPUs: 8, RAM: 32768, Disk: 40},
+								{Name: machineTypeArm, VCPUs: 4, RAM: 16384, Disk: 20},
+								{Name: "unused", VCPUs: 2, RAM: 4096, Disk: 10},
+							},
+						}),
+					}
+					Expect(c.Status().Update(ctx, w)).To(Succeed())
+					computeClient.EXPECT().ListFlavors(ctx).Return(nil, fmt.Errorf("service unavailable"))
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithFlavorCapacity, osFactory)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					var flavorNames []string
+					for _, flavor := range decodeWorkerStatus(w).Flavors {
+						flavorNames = append(flavorNames, flavor.Name)
+					}
+					Expect(flavorNames).To(Equal([]string{machineType, machineTypeArm}))
+					expectNodeCapacities(deployMachineClasses(),
+						corev1.ResourceList{
+							"cpu":               resource.MustParse("8"),
+							"memory":            resource.MustParse("32Gi"),
+							"ephemeral-storage": resource.MustParse("40Gi"),
+							"gpu":               resource.MustParse("1"),
+						},
+						corev1.ResourceList{
+							"cpu":               resource.MustParse("8"),
+							"memory":            resource.MustParse("32Gi"),
+							"ephemeral-storage": resource.MustParse("80Gi"),
+							"gpu":               resource.MustParse("1"),
+						},
+						corev1.ResourceList{
+							"cpu":               resource.MustParse("4"),
+							"memory":            resource.MustParse("16Gi"),
+							"ephemeral-storage": resource.MustParse("20Gi"),
+							"gpu":               resource.MustParse("1"),
+						},
+					)
+				})
+
+				It("should keep the capacity of the CloudProfile if the flavor capacity is not enabled", func() {
+					computeClient.EXPECT().ListFlavors(ctx).Return([]flavors.Flavor{
+						{ID: "flavor-id", Name: machineType, VCPUs: 16, RAM: 65536, Disk: 50},
+					}, nil)
+					computeClient.EXPECT().ListFlavorExtraSpecs(ctx, "flavor-id").Return(nil, nil)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, osFactory)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					expectNodeCapacities(deployMachineClasses(), nodeCapacity, nodeCapacity, nodeCapacity)
+				})
+
+				It("should fall back to the capacity of the CloudProfile if the flavors cannot be read", func() {
+					computeClient.EXPECT().ListFlavors(ctx).Return(nil, fmt.Errorf("service unavailable"))
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithFlavorCapacity, osFactory)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					Expect(decodeWorkerStatus(w).Flavors).To(BeEmpty())
+					expectNodeCapacities(deployMachineClasses(), nodeCapacity, nodeCapacity, nodeCapacity)
+				})
+			})
+
+			Context("zone fallback", func() {
+				var zoneFallbackConfig *runtime.RawExtension
+
+				BeforeEach(func() {
+					zoneFallbackConfig = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerConfig{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerConfig",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ZoneFallback: ptr.To(true),
+						}),
+					}
+				})
+
+				It("should detect the zones in which Nova found no valid host", func() {
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Raw: encode(&apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerStatus",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ExhaustedZones: []apiv1alpha1.ExhaustedZone{
+								{PoolName: namePool1, Zone: zone1, LastDetectionTime: metav1.NewTime(time.Now().Add(-2 * time.Hour))},
+								{PoolName: namePool2, Zone: zone1, LastDetectionTime: metav1.Now()},
+							},
+						}),
+					}
+					Expect(c.Status().Update(ctx, w)).To(Succeed())
+					w.Spec.Pools[0].ProviderConfig = zoneFallbackConfig
+
+					osFactory := mocks.NewMockFactory(ctrl)
+					computeClient := mocks.NewMockCompute(ctrl)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil)
+					computeClient.EXPECT().ListFlavors(ctx).Return(nil, nil)
+					computeClient.EXPECT().FindServersByName(ctx, "^"+technicalID+"-").Return([]servers.Server{
+						{Name: technicalID + "-pool-1-z2-abcde-fghij", Status: "ERROR", Fault: servers.Fault{Message: "No valid host was found. There are not enough hosts available."}},
+						{Name: technicalID + "-pool-1-z1-abcde-klmno", Status: "ERROR", Fault: servers.Fault{Message: "Build of instance aborted"}},
+						{Name: technicalID + "-pool-1-z1-abcde-pqrst", Status: "ACTIVE"},
+					}, nil)
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, osFactory)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					workerStatus := decodeWorkerStatus(w)
+					Expect(workerStatus.ExhaustedZones).To(HaveLen(1))
+					Expect(workerStatus.ExhaustedZones[0].PoolName).To(Equal(namePool1))
+					Expect(workerStatus.ExhaustedZones[0].Zone).To(Equal(zone2))
+					Expect(workerStatus.ExhaustedZones[0].LastDetectionTime.Time).To(BeTemporally("~", time.Now(), time.Minute))
+				})
+
+				It("should shift the minimum and maximum of the worker pool away from exhausted zones", func() {
+					w.Spec.Pools[0].ProviderConfig = zoneFallbackConfig
+					w.Status.ProviderStatus = &runtime.RawExtension{
+						Object: &apiv1alpha1.WorkerStatus{
+							TypeMeta: metav1.TypeMeta{
+								Kind:       "WorkerStatus",
+								APIVersion: apiv1alpha1.SchemeGroupVersion.String(),
+							},
+							ExhaustedZones: []apiv1alpha1.ExhaustedZone{
+								{PoolName: namePool1, Zone: zone2, LastDetectionTime: metav1.Now()},
+							},
+						},
+					}
+					machineDeployment := &machinev1alpha1.MachineDeployment{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      fmt.Sprintf("%s-%s-z2", technicalID, namePool1),
+							Namespace: namespace,
+						},
+						Status: machinev1alpha1.MachineDeploymentStatus{ReadyReplicas: 1},
+					}
+					Expect(c.Create(ctx, machineDeployment)).To(Succeed())
+
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, cluster, nil)
+
+					result, err := workerDelegate.GenerateMachineDeployments(ctx)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result[0].Name).To(Equal(fmt.Sprintf("%s-%s-z1", technicalID, namePool1)))
+					Expect(result[0].Minimum).To(Equal(minPool1 - 1))
+					Expect(result[0].Maximum).To(Equal(maxPool1 - 1))
+					Expect(result[1].Name).To(Equal(fmt.Sprintf("%s-%s-z2", technicalID, namePool1)))
+					Expect(result[1].Minimum).To(Equal(int32(1)))
+					Expect(result[1].Maximum).To(Equal(int32(1)))
+					// Worker pools without zone fallback are distributed as usual.
+					Expect(result[2].Minimum).To(Equal(worker.DistributeOverZones(0, minPool2, 2)))
+					Expect(result[3].Minimum).To(Equal(worker.DistributeOverZones(1, minPool2, 2)))
+				})
+			})
+
+			Context("bare metal machine types", func() {
+				var (
+					osFactory       *mocks.MockFactory
+					computeClient   *mocks.MockCompute
+					bareMetalClient *mocks.MockBareMetal
+				)
+
+				BeforeEach(func() {
+					cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+					Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+					cloudProfileConfig.MachineTypes = []apiv1alpha1.MachineType{{Name: machineTypeArm, BareMetal: ptr.To(true)}}
+					clusterWithBareMetal := &extensionscontroller.Cluster{
+						CloudProfile: cluster.CloudProfile.DeepCopy(),
+						Shoot:        cluster.Shoot,
+						Seed:         cluster.Seed,
+					}
+					clusterWithBareMetal.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfig)}
+					w.Spec.Pools[2].Volume = &extensionsv1alpha1.Volume{Size: "50Gi", Type: ptr.To("premium")}
+					w.Spec.Pools[2].Minimum = 3
+
+					osFactory = mocks.NewMockFactory(ctrl)
+					computeClient = mocks.NewMockCompute(ctrl)
+					bareMetalClient = mocks.NewMockBareMetal(ctrl)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil)
+					osFactory.EXPECT().BareMetal(gomock.Any()).Return(bareMetalClient, nil)
+					computeClient.EXPECT().ListFlavors(ctx).Return([]flavors.Flavor{
+						{ID: "flavor-id", Name: machineType, VCPUs: 16, RAM: 65536, Disk: 50},
+						{ID: "bm-flavor-id", Name: machineTypeArm, VCPUs: 1, RAM: 1024, Disk: 10},
+					}, nil)
+					computeClient.EXPECT().ListFlavorExtraSpecs(ctx, "flavor-id").Return(nil, nil)
+					computeClient.EXPECT().ListFlavorExtraSpecs(ctx, "bm-flavor-id").Return(map[string]string{
+						"resources:CUSTOM_BAREMETAL_LARGE": "1",
+						"resources:VCPU":                   "0",
+						"resources:MEMORY_MB":              "0",
+						"resources:DISK_GB":                "0",
+					}, nil)
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, clusterWithBareMetal, osFactory)
+				})
+
+				expectListNodes := func() {
+					bareMetalClient.EXPECT().ListNodes(ctx, gomock.Any()).Return([]nodes.Node{
+						{ResourceClass: "baremetal.large", ProvisionState: "available", Properties: map[string]any{"cpus": float64(128), "memory_mb": float64(524288), "local_gb": "960"}},
+						{ResourceClass: "baremetal.large", ProvisionState: "available", Properties: map[string]any{"cpus": float64(64), "memory_mb": float64(262144), "local_gb": "480"}},
+						{ResourceClass: "baremetal.large", ProvisionState: "active", InstanceUUID: "server-id", Properties: map[string]any{"cpus": float64(64), "memory_mb": float64(262144), "local_gb": "480"}},
+						{ResourceClass: "baremetal.large", ProvisionState: "available", Maintenance: true, Properties: map[string]any{"cpus": float64(8)}},
+						{ResourceClass: "baremetal.small", ProvisionState: "available", Properties: map[string]any{"cpus": float64(8)}},
+					}, nil)
+				}
+
+				bareMetalCondition := func() *gardencorev1beta1.Condition {
+					for _, condition := range w.Status.Conditions {
+						if condition.Type == BareMetalNodesAvailableConditionType {
+							return &condition
+						}
+					}
+					return nil
+				}
+
+				It("should derive the node templates from the Ironic nodes and omit the root volume", func() {
+					expectListNodes()
+					computeClient.EXPECT().FindServersByName(ctx, "^"+technicalID+"-").Return([]servers.Server{{ID: "server-id"}}, nil)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					var capturedMachineClasses []map[string]interface{}
+					chartApplier.
+						EXPECT().
+						ApplyFromEmbeddedFS(
+							ctx,
+							charts.InternalChart,
+							filepath.Join("internal", "machineclass"),
+							namespace,
+							"machineclass",
+							gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+						).
+						DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+							applyOpts := &kubernetes.ApplyOptions{}
+							for _, o := range opts {
+								o.MutateApplyOptions(applyOpts)
+							}
+							if values, ok := applyOpts.Values.(map[string]interface{}); ok {
+								if classes, ok := values["machineClasses"].([]map[string]interface{}); ok {
+									capturedMachineClasses = classes
+								}
+							}
+							return nil
+						})
+
+					Expect(bareMetalCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Status": Equal(gardencorev1beta1.ConditionTrue),
+						"Reason": Equal("BareMetalNodesAvailable"),
+					})))
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+					Expect(capturedMachineClasses).To(HaveLen(6))
+					for _, class := range capturedMachineClasses[4:] {
+						Expect(class).NotTo(HaveKey("rootDiskSize"))
+						Expect(class).NotTo(HaveKey("rootDiskType"))
+						nodeTemplate := class["nodeTemplate"].(machinev1alpha1.NodeTemplate)
+						Expect(nodeTemplate.Capacity).To(HaveKeyWithValue(corev1.ResourceCPU, BeComparableTo(resource.MustParse("64"))))
+						Expect(nodeTemplate.Capacity).To(HaveKeyWithValue(corev1.ResourceMemory, BeComparableTo(resource.MustParse("256Gi"))))
+						Expect(nodeTemplate.Capacity).To(HaveKeyWithValue(corev1.ResourceEphemeralStorage, BeComparableTo(resource.MustParse("480Gi"))))
+					}
+				})
+
+				It("should report in the condition if not enough bare metal nodes are available", func() {
+					expectListNodes()
+					computeClient.EXPECT().FindServersByName(ctx, "^"+technicalID+"-").Return(nil, nil)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					Expect(bareMetalCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Status":  Equal(gardencorev1beta1.ConditionFalse),
+						"Reason":  Equal("InsufficientBareMetalNodes"),
+						"Message": Equal(`not enough bare metal nodes for machine type "large-arm": the worker pools "pool-3" require at least 3 nodes, but only 2 are available`),
+					})))
+				})
+
+				It("should report an unknown capacity if the bare metal nodes must not be listed", func() {
+					bareMetalClient.EXPECT().ListNodes(ctx, gomock.Any()).Return(nil, gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusForbidden})
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+
+					Expect(bareMetalCondition()).To(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+						"Message": ContainSubstring("not allowed to list bare metal nodes, the capacity of the bare metal machine types is unknown"),
+					})))
+				})
+			})
+
+			Context("boot capabilities", func() {
+				var (
+					osFactory     *mocks.MockFactory
+					computeClient *mocks.MockCompute
+					imageClient   *mocks.MockImages
+					bootCluster   *extensionscontroller.Cluster
+				)
+
+				BeforeEach(func() {
+					osFactory = mocks.NewMockFactory(ctrl)
+					computeClient = mocks.NewMockCompute(ctrl)
+					imageClient = mocks.NewMockImages(ctrl)
+					osFactory.EXPECT().Compute(gomock.Any()).Return(computeClient, nil)
+					osFactory.EXPECT().Images(gomock.Any()).Return(imageClient, nil)
+					computeClient.EXPECT().ListFlavors(ctx).Return([]flavors.Flavor{
+						{ID: "flavor-id", Name: machineType, VCPUs: 16, RAM: 65536, Disk: 50},
+						{ID: "secure-flavor-id", Name: machineTypeArm, VCPUs: 8, RAM: 32768, Disk: 50},
+					}, nil)
+					computeClient.EXPECT().ListFlavorExtraSpecs(ctx, "flavor-id").Return(nil, nil)
+					computeClient.EXPECT().ListFlavorExtraSpecs(ctx, "secure-flavor-id").Return(map[string]string{
+						"os:secure_boot": "required",
+						"hw:tpm_version": "2.0",
+						"hw:tpm_model":   "tpm-crb",
+					}, nil)
+					bootCluster = cluster
+				})
+
+				JustBeforeEach(func() {
+					workerDelegate, _ = NewWorkerDelegate(c, scheme, chartApplier, w, bootCluster, osFactory)
+					Expect(workerDelegate.PreReconcileHook(ctx)).To(Succeed())
+				})
+
+				It("should accept machine images which support the boot capabilities of the flavor", func() {
+					imageClient.EXPECT().ListImages(ctx, gomock.Any()).Return([]images.Image{{
+						ID:         "image-id",
+						Properties: map[string]any{"hw_firmware_type": "uefi", "os_secure_boot": "required", "hw_tpm_version": "2.0"},
+					}}, nil)
+					chartApplier.EXPECT().ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join("internal", "machineclass"), namespace, "machineclass", gomock.Any())
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+				})
+
+				It("should fail if the machine image cannot boot machines of the flavor", func() {
+					imageClient.EXPECT().ListImages(ctx, gomock.Any()).Return([]images.Image{{
+						ID:         "image-id",
+						Properties: map[string]any{"hw_firmware_type": "bios", "hw_tpm_model": "tpm-tis"},
+					}}, nil)
+
+					Expect(workerDelegate.DeployMachineClasses(ctx)).To(MatchError(And(
+						ContainSubstring(`of worker pool "pool-3" cannot boot machines of flavor "large-arm"`),
+						ContainSubstring("image does not use UEFI firmware"),
+						ContainSubstring(`image requests hw_tpm_model "tpm-tis" but flavor requests "tpm-crb"`),
+					)))
+				})
+
+				Context("with secure boot capabilities in the CloudProfile", func() {
+					BeforeEach(func() {
+						if !isCapabilitiesCloudProfile {
+							Skip("boot capabilities only apply to capabilities CloudProfiles")
+						}
+
+						cloudProfileConfig := &apiv1alpha1.CloudProfileConfig{}
+						Expect(json.Unmarshal(cluster.CloudProfile.Spec.ProviderConfig.Raw, cloudProfileConfig)).To(Succeed())
+						secureImageID := "secure-image-id"
+						if usesGlobalImageNames {
+							secureImageID = ""
+						}
+						flavors := cloudProfileConfig.MachineImages[0].Versions[0].CapabilityFlavors
+						flavors[0].Capabilities = gardencorev1beta1.Capabilities{v1beta1constants.ArchitectureName: {archARM}, "secureBoot": {"disabled"}}
+						flavors = append(flavors, apiv1alpha1.MachineImageFlavor{
+							Capabilities: gardencorev1beta1.Capabilities{v1beta1constants.ArchitectureName: {archARM}, "secureBoot": {"enabled"}},
+							Image:        "secure-image",
+							Regions:      []apiv1alpha1.RegionIDMapping{{Name: region, ID: secureImageID}},
+						})
+						cloudProfileConfig.MachineImages[0].Versions[0].CapabilityFlavors = flavors
+
+						bootCluster = &extensionscontroller.Cluster{
+							CloudProfile: cluster.CloudProfile.DeepCopy(),
+							Shoot:        cluster.Shoot,
+							Seed:         cluster.Seed,
+						}
+						bootCluster.CloudProfile.Spec.MachineCapabilities = append(bootCluster.CloudProfile.Spec.MachineCapabilities,
+							gardencorev1beta1.CapabilityDefinition{Name: "secureBoot", Values: []string{"disabled", "enabled"}})
+						bootCluster.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: encode(cloudProfileConfig)}
+					})
+
+					It("should select the machine image which supports the boot capabilities of the flavor", func() {
+						imageClient.EXPECT().ListImages(ctx, gomock.Any()).Return([]images.Image{{
+							ID:         "secure-image-id",
+							Properties: map[string]any{"hw_firmware_type": "uefi", "os_secure_boot": "required"},
+						}}, nil)
+
+						var capturedMachineClasses []map[string]interface{}
+						chartApplier.
+							EXPECT().
+							ApplyFromEmbeddedFS(
+								ctx,
+								charts.InternalChart,
+								filepath.Join("internal", "machineclass"),
+								namespace,
+								"machineclass",
+								gomock.AssignableToTypeOf(kubernetes.Values(nil)),
+							).
+							DoAndReturn(func(_ context.Context, _ embed.FS, _, _, _ string, opts ...kubernetes.ApplyOption) error {
+								applyOpts := &kubernetes.ApplyOptions{}
+								for _, o := range opts {
+									o.MutateApplyOptions(applyOpts)
+								}
+								if values, ok := applyOpts.Values.(map[string]interface{}); ok {
+									if classes, ok := values["machineClasses"].([]map[string]interface{}); ok {
+										capturedMachineClasses = classes
+									}
+								}
+								return nil
+							})
+
+						Expect(workerDelegate.DeployMachineClasses(ctx)).To(Succeed())
+
+						Expect(capturedMachineClasses).To(HaveLen(6))
+						for _, class := range capturedMachineClasses[4:] {
+							if usesGlobalImageNames {
+								Expect(class).To(HaveKeyWithValue("imageName", "secure-image"))
+							} else {
+								Expect(class).To(HaveKeyWithValue("imageID", "secure-image-id"))
+							}
+						}
+						Expect(capturedMachineClasses[0]).NotTo(HaveKeyWithValue("imageID", "secure-image-id"))
+						Expect(capturedMachineClasses[0]).NotTo(HaveKeyWithValue("imageName", "secure-image"))
+					})
+				})
+			})
 		},
 			Entry("with capabilities and using imageIDs", true, false),
 			Entry("with capabilities and using ImageNames", true, true),
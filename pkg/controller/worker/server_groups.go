@@ -9,8 +9,11 @@ import (
 	"sort"
 	"strings"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
 )
@@ -27,11 +30,59 @@ func generateServerGroupNamePrefixV2(uuid string) string {
 	return uuid[:18] + "-"
 }
 
-func generateServerGroupNameV2(uuid, poolName, policy string) string {
+func generateServerGroupNameV2(uuid, poolName, zone, policy string, index int32, maxServerPerHost *int32) string {
 	hashSeed := fmt.Sprintf("pool=%s,policy=%s", poolName, policy)
+	if zone != "" {
+		hashSeed += fmt.Sprintf(",zone=%s", zone)
+	}
+	if maxServerPerHost != nil {
+		hashSeed += fmt.Sprintf(",maxServerPerHost=%d", *maxServerPerHost)
+	}
+	if index > 0 {
+		hashSeed += fmt.Sprintf(",index=%d", index)
+	}
 	return fmt.Sprintf("%s%s", generateServerGroupNamePrefixV2(uuid), utils.ComputeSHA256Hex([]byte(hashSeed))[:8])
 }
 
+// serverGroupPolicy returns the policy of the given server group. Depending on the API microversion, it is either
+// returned as list or as single value.
+func serverGroupPolicy(sg *servergroups.ServerGroup) string {
+	if len(sg.Policies) > 0 {
+		return sg.Policies[0]
+	}
+	return ptr.Deref(sg.Policy, "")
+}
+
+// isServerGroupPerZone returns true if one server group is created per zone of the worker pool.
+func isServerGroupPerZone(config *api.WorkerConfig) bool {
+	return isServerGroupRequired(config) && ptr.Deref(config.ServerGroup.PerZone, false)
+}
+
+// serverGroupZones returns the zones for which server groups are created for a worker pool. An empty zone stands for a
+// single server group shared by all zones of the worker pool.
+func serverGroupZones(pool extensionsv1alpha1.WorkerPool, config *api.WorkerConfig) []string {
+	if isServerGroupPerZone(config) {
+		return pool.Zones
+	}
+	return []string{""}
+}
+
+// serverGroupShards returns the number of server groups needed for the given maximum number of machines, so that
+// none of them exceeds the given maximum number of members, including the machines which are surged during a rolling
+// update. Without a maximum number of members, a single server group is used.
+func serverGroupShards(maximum int32, maxSurge intstr.IntOrString, maxMembers *int32) int32 {
+	if maxMembers == nil || *maxMembers <= 0 {
+		return 1
+	}
+
+	surge, err := intstr.GetScaledValueFromIntOrPercent(&maxSurge, int(maximum), true)
+	if err != nil {
+		surge = 0
+	}
+	machines := int(maximum) + surge
+	return int32(max(1, (machines+int(*maxMembers)-1)/int(*maxMembers))) // #nosec: G115 - The number of machines of a pool is an int32.
+}
+
 func filterServerGroupsByPrefix(sgs []servergroups.ServerGroup, prefix string) []servergroups.ServerGroup {
 	var result []servergroups.ServerGroup
 	for _, sg := range sgs {
@@ -43,16 +94,27 @@ func filterServerGroupsByPrefix(sgs []servergroups.ServerGroup, prefix string) [
 	return result
 }
 
-// serverGroupDependencySet is a set implementation for ServerGroupDependency objects that uses the PoolName as identifying key.
+// serverGroupDependencyKey identifies a ServerGroupDependency by its PoolName, Zone and Index.
+type serverGroupDependencyKey struct {
+	poolName string
+	zone     string
+	index    int32
+}
+
+func keyOf(d api.ServerGroupDependency) serverGroupDependencyKey {
+	return serverGroupDependencyKey{poolName: d.PoolName, zone: d.Zone, index: d.Index}
+}
+
+// serverGroupDependencySet is a set implementation for ServerGroupDependency objects that uses the PoolName, the Zone and the Index as identifying key.
 type serverGroupDependencySet struct {
-	set map[string]api.ServerGroupDependency
+	set map[serverGroupDependencyKey]api.ServerGroupDependency
 }
 
 // newServerGroupDependencySet creates a new serverGroupDependencySet.
 func newServerGroupDependencySet(deps []api.ServerGroupDependency) serverGroupDependencySet {
-	m := make(map[string]api.ServerGroupDependency, len(deps))
+	m := make(map[serverGroupDependencyKey]api.ServerGroupDependency, len(deps))
 	for _, d := range deps {
-		m[d.PoolName] = d
+		m[keyOf(d)] = d
 	}
 
 	return serverGroupDependencySet{m}
@@ -63,18 +125,42 @@ func (s *serverGroupDependencySet) upsert(d *api.ServerGroupDependency) {
 	if d == nil {
 		return
 	}
-	s.set[d.PoolName] = *d
+	s.set[keyOf(*d)] = *d
 }
 
-// getByPoolName retrieves a ServerGroupDependency if it matches the provided PoolName. It returns nil if there is no matching entry in the set.
-func (s *serverGroupDependencySet) getByPoolName(pn string) *api.ServerGroupDependency {
-	d, ok := s.set[pn]
+// get retrieves the ServerGroupDependency with the provided PoolName, Zone and Index. It returns nil if there is no matching entry in the set.
+func (s *serverGroupDependencySet) get(pn, zone string, index int32) *api.ServerGroupDependency {
+	d, ok := s.set[serverGroupDependencyKey{poolName: pn, zone: zone, index: index}]
 	if !ok {
 		return nil
 	}
 	return &d
 }
 
+// getByPoolName retrieves all ServerGroupDependencies which match the provided PoolName, sorted by Zone and Index.
+func (s *serverGroupDependencySet) getByPoolName(pn string) []api.ServerGroupDependency {
+	var r []api.ServerGroupDependency
+	for _, v := range s.set {
+		if v.PoolName == pn {
+			r = append(r, v)
+		}
+	}
+
+	sortServerGroupDependencies(r)
+	return r
+}
+
+// getByPoolNameAndZone retrieves all ServerGroupDependencies which match the provided PoolName and Zone, sorted by Index.
+func (s *serverGroupDependencySet) getByPoolNameAndZone(pn, zone string) []api.ServerGroupDependency {
+	var r []api.ServerGroupDependency
+	for _, v := range s.getByPoolName(pn) {
+		if v.Zone == zone {
+			r = append(r, v)
+		}
+	}
+	return r
+}
+
 // getById retrieves a ServerGroupDependency if it matches the provided ID. It returns nil if there is no matching entry in the set.
 func (s *serverGroupDependencySet) getById(id string) *api.ServerGroupDependency {
 	for _, v := range s.set {
@@ -86,12 +172,12 @@ func (s *serverGroupDependencySet) getById(id string) *api.ServerGroupDependency
 	return nil
 }
 
-// deleteByPoolName deletes a ServerGroupDependency if it matches the provided PoolName. It is a no-op if there is no matching entry in the set.
-func (s *serverGroupDependencySet) deleteByPoolName(pn string) {
-	delete(s.set, pn)
+// delete deletes the given ServerGroupDependency. It is a no-op if there is no matching entry in the set.
+func (s *serverGroupDependencySet) delete(d api.ServerGroupDependency) {
+	delete(s.set, keyOf(d))
 }
 
-// extract produces a slice from the elements contained in the set, sorted by PoolName.
+// extract produces a slice from the elements contained in the set, sorted by PoolName, Zone and Index.
 func (s *serverGroupDependencySet) extract() []api.ServerGroupDependency {
 	if len(s.set) == 0 {
 		return nil
@@ -103,9 +189,7 @@ func (s *serverGroupDependencySet) extract() []api.ServerGroupDependency {
 	}
 
 	// sort resulting slice to avoid randomization from map
-	sort.Slice(r, func(i, j int) bool {
-		return r[i].PoolName < r[j].PoolName
-	})
+	sortServerGroupDependencies(r)
 	return r
 }
 
@@ -118,3 +202,15 @@ func (s *serverGroupDependencySet) forEach(f func(dependency api.ServerGroupDepe
 	}
 	return nil
 }
+
+func sortServerGroupDependencies(deps []api.ServerGroupDependency) {
+	sort.Slice(deps, func(i, j int) bool {
+		if deps[i].PoolName != deps[j].PoolName {
+			return deps[i].PoolName < deps[j].PoolName
+		}
+		if deps[i].Zone != deps[j].Zone {
+			return deps[i].Zone < deps[j].Zone
+		}
+		return deps[i].Index < deps[j].Index
+	})
+}
@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/api/core/v1beta1/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// maxUserDataSize is the maximum size of the base64 encoded user data accepted by Nova.
+	maxUserDataSize = 65535
+	// userDataWarningSize is the size of the base64 encoded user data above which the UserDataWithinSizeLimit condition
+	// of the worker is set to false, so that growing user data is noticed before machines fail to be created.
+	userDataWarningSize = maxUserDataSize * 9 / 10
+	// userDataURLValidity is the minimum time for which the temporary URLs of user data stored in Swift are valid. The
+	// user data contains the bootstrap credentials of the machines, hence the URLs should expire soon. They are used by
+	// machines which are created after the reconciliation though, e.g. by the cluster-autoscaler, hence they must stay
+	// valid until the next reconciliation renews them. Shoots are reconciled at least once per day during their
+	// maintenance time window.
+	userDataURLValidity = 3 * 24 * time.Hour
+)
+
+// UserDataWithinSizeLimitConditionType is the type of the worker condition which reports whether the user data of the
+// worker pools is well below the size limit of Nova.
+const UserDataWithinSizeLimitConditionType gardencorev1beta1.ConditionType = "UserDataWithinSizeLimit"
+
+// userDataContainerName returns the name of the Swift container in which oversized user data is stored.
+func (w *WorkerDelegate) userDataContainerName() string {
+	return w.cluster.Shoot.Status.TechnicalID + "-user-data"
+}
+
+// prepareUserData returns the user data for the machines of the given worker pool. Nova rejects user data which exceeds
+// 64 KiB after base64 encoding. Larger user data is gzip compressed if it is processed by cloud-init, which detects
+// the compression itself. If it still exceeds the limit, it is stored in Swift if this is enabled in the CloudProfile
+// and the worker pool, and the machines only get a small loader which downloads it via a temporary URL.
+func (w *WorkerDelegate) prepareUserData(ctx context.Context, pool extensionsv1alpha1.WorkerPool, workerConfig *api.WorkerConfig, userData []byte) ([]byte, error) {
+	size := base64.StdEncoding.EncodedLen(len(userData))
+	if size <= maxUserDataSize {
+		w.recordUserDataSize(pool.Name, size)
+		return userData, nil
+	}
+
+	ignitionVersion, isIgnition := ignitionConfigVersion(userData)
+	if !isIgnition {
+		compressed, err := gzipUserData(userData)
+		if err != nil {
+			return nil, err
+		}
+		if compressedSize := base64.StdEncoding.EncodedLen(len(compressed)); compressedSize <= maxUserDataSize {
+			w.recordUserDataSize(pool.Name, compressedSize)
+			return compressed, nil
+		}
+	}
+
+	if !ptr.Deref(w.cloudProfileConfig.StoreOversizedUserData, false) || !ptr.Deref(workerConfig.StoreOversizedUserData, false) {
+		return nil, fmt.Errorf("user data of worker pool %q exceeds the size limit of Nova (%d > %d bytes)", pool.Name, size, maxUserDataSize)
+	}
+
+	url, err := w.storeUserData(ctx, pool, userData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to store user data of worker pool %q in Swift: %w", pool.Name, err)
+	}
+
+	if isIgnition {
+		return json.Marshal(map[string]interface{}{
+			"ignition": map[string]interface{}{
+				"version": ignitionVersion,
+				"config": map[string]interface{}{
+					"replace": map[string]interface{}{"source": url},
+				},
+			},
+		})
+	}
+	return []byte("#include\n" + url + "\n"), nil
+}
+
+// storeUserData uploads the user data of a worker pool to Swift and returns a temporary URL for downloading it.
+func (w *WorkerDelegate) storeUserData(ctx context.Context, pool extensionsv1alpha1.WorkerPool, userData []byte) (string, error) {
+	storageClient, err := w.openstackClient.Storage(osclient.WithRegion(w.worker.Spec.Region))
+	if err != nil {
+		return "", err
+	}
+
+	container := w.userDataContainerName()
+	if err := storageClient.CreateContainerIfNotExists(ctx, container); err != nil {
+		return "", err
+	}
+	if err := storageClient.EnsureContainerTempURLKey(ctx, container); err != nil {
+		return "", err
+	}
+
+	object := fmt.Sprintf("%s-%s", pool.Name, utils.ComputeSHA256Hex(userData)[:16])
+	if err := storageClient.CreateObject(ctx, container, object, userData); err != nil {
+		return "", err
+	}
+	if w.userDataObjects == nil {
+		w.userDataObjects = sets.New[string]()
+	}
+	w.userDataObjects.Insert(object)
+
+	// The expiry is rounded to the hour to keep the machine class secrets stable within a reconciliation.
+	expiresAt := time.Now().UTC().Truncate(time.Hour).Add(userDataURLValidity)
+	return storageClient.CreateTempURL(ctx, container, object, expiresAt)
+}
+
+// recordUserDataSize remembers the size of the user data of the given worker pool if it is close to the size limit of
+// Nova, so that it is reported with the UserDataWithinSizeLimit condition.
+func (w *WorkerDelegate) recordUserDataSize(poolName string, size int) {
+	if size <= userDataWarningSize {
+		return
+	}
+	if w.userDataSizes == nil {
+		w.userDataSizes = map[string]int{}
+	}
+	w.userDataSizes[poolName] = size
+}
+
+// updateUserDataCondition reports the worker pools whose user data is close to the size limit of Nova with the
+// UserDataWithinSizeLimit condition. The size of the user data is only known when the machine classes are generated,
+// hence it cannot be checked when the shoot is admitted.
+func (w *WorkerDelegate) updateUserDataCondition(ctx context.Context) error {
+	var (
+		realClock = clock.RealClock{}
+		condition = v1beta1helper.GetOrInitConditionWithClock(realClock, w.worker.Status.Conditions, UserDataWithinSizeLimitConditionType)
+	)
+	if len(w.userDataSizes) == 0 {
+		condition = v1beta1helper.UpdatedConditionWithClock(realClock, condition, gardencorev1beta1.ConditionTrue, "UserDataWithinSizeLimit", "The user data of all worker pools is well below the size limit of Nova.")
+		return w.updateCondition(ctx, UserDataWithinSizeLimitConditionType, &condition)
+	}
+
+	var pools []string
+	for _, poolName := range slices.Sorted(maps.Keys(w.userDataSizes)) {
+		pools = append(pools, fmt.Sprintf("%q (%d bytes)", poolName, w.userDataSizes[poolName]))
+	}
+	message := fmt.Sprintf("The user data of the worker pools %s is close to the size limit of Nova (%d bytes after base64 encoding). Machines cannot be created anymore if it grows beyond the limit.", strings.Join(pools, ", "), maxUserDataSize)
+	condition = v1beta1helper.UpdatedConditionWithClock(realClock, condition, gardencorev1beta1.ConditionFalse, "UserDataCloseToSizeLimit", message)
+	return w.updateCondition(ctx, UserDataWithinSizeLimitConditionType, &condition)
+}
+
+// cleanupUserData deletes the user data objects in Swift which are no longer used by the machine classes. If the worker
+// is being deleted, the whole container is deleted.
+func (w *WorkerDelegate) cleanupUserData(ctx context.Context) error {
+	if !ptr.Deref(w.cloudProfileConfig.StoreOversizedUserData, false) {
+		return nil
+	}
+
+	storageClient, err := w.openstackClient.Storage(osclient.WithRegion(w.worker.Spec.Region))
+	if err != nil {
+		return err
+	}
+
+	container := w.userDataContainerName()
+	if w.worker.DeletionTimestamp != nil {
+		return storageClient.DeleteContainerIfExists(ctx, container)
+	}
+
+	objects, err := storageClient.ListObjectNames(ctx, container)
+	if err != nil {
+		return err
+	}
+	for _, object := range objects {
+		if w.userDataObjects.Has(object) {
+			continue
+		}
+		if err := storageClient.DeleteObject(ctx, container, object); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ignitionConfigVersion returns the version of the given user data if it is an Ignition config.
+func ignitionConfigVersion(userData []byte) (string, bool) {
+	var config struct {
+		Ignition *struct {
+			Version string `json:"version"`
+		} `json:"ignition"`
+	}
+	if err := json.Unmarshal(userData, &config); err != nil || config.Ignition == nil {
+		return "", false
+	}
+	return config.Ignition.Version, true
+}
+
+func gzipUserData(userData []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	writer, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := writer.Write(userData); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,295 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+	osclient "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+)
+
+const (
+	// noValidHostFault is the message of the instance fault of servers for which the Nova scheduler found no host.
+	noValidHostFault = "No valid host was found"
+	// zoneExhaustionPeriod is the time after the last detected capacity shortage for which the minimum and maximum of
+	// a worker pool with zone fallback are shifted away from a zone. Afterwards, machines are created in it again.
+	zoneExhaustionPeriod = time.Hour
+)
+
+// assignZoneIndices returns the indices of the machine deployments for the given zones of a worker pool. Zones which
+// are already known keep their index. New zones get the lowest index which is neither used by a current nor by a
+// previous zone, so that the machine deployment of a removed zone is deleted instead of being rolled to another zone.
+// Without previous zones, the indices are the positions of the zones.
+func assignZoneIndices(zones []string, previous *api.WorkerPoolZones) []api.ZoneIndex {
+	result := make([]api.ZoneIndex, 0, len(zones))
+	if previous == nil {
+		for i, zone := range zones {
+			result = append(result, api.ZoneIndex{Name: zone, Index: int32(i)}) // #nosec: G115 - We validate if num pool zones exceeds max_int32.
+		}
+		return result
+	}
+
+	previousIndices := make(map[string]int32, len(previous.Zones))
+	reserved := sets.New[int32]()
+	for _, zone := range previous.Zones {
+		previousIndices[zone.Name] = zone.Index
+		reserved.Insert(zone.Index)
+	}
+
+	var next int32
+	for _, zone := range zones {
+		index, ok := previousIndices[zone]
+		if !ok {
+			for reserved.Has(next) {
+				next++
+			}
+			index = next
+			reserved.Insert(index)
+		}
+		result = append(result, api.ZoneIndex{Name: zone, Index: index})
+	}
+	return result
+}
+
+func findWorkerPoolZones(workerPoolZones []api.WorkerPoolZones, poolName string) *api.WorkerPoolZones {
+	for i := range workerPoolZones {
+		if workerPoolZones[i].PoolName == poolName {
+			return &workerPoolZones[i]
+		}
+	}
+	return nil
+}
+
+// previousWorkerPoolZones returns the zone indices of the given worker pool which are recorded in the worker status. If
+// they are not recorded, e.g. for machine deployments created before the indices were recorded, they are derived from
+// the names and zones of the existing machine deployments of the pool. Hence, a zone which is removed at the same time
+// does not shift the indices of the other zones, which would rename and roll their machine deployments.
+func (w *WorkerDelegate) previousWorkerPoolZones(ctx context.Context, workerStatus *api.WorkerStatus, poolName string) (*api.WorkerPoolZones, error) {
+	if poolZones := findWorkerPoolZones(workerStatus.WorkerPoolZones, poolName); poolZones != nil {
+		return poolZones, nil
+	}
+
+	if w.existingMachineDeployments == nil {
+		machineDeployments := &machinev1alpha1.MachineDeploymentList{}
+		if err := w.seedClient.List(ctx, machineDeployments, client.InNamespace(w.worker.Namespace)); err != nil {
+			return nil, err
+		}
+		w.existingMachineDeployments = machineDeployments.Items
+	}
+
+	var (
+		prefix  = fmt.Sprintf("%s-%s-z", w.cluster.Shoot.Status.TechnicalID, poolName)
+		indices = map[string]int32{}
+	)
+	for _, machineDeployment := range w.existingMachineDeployments {
+		labels := machineDeployment.Spec.Template.Spec.NodeTemplateSpec.Labels
+		zone := labels[openstack.CSIManilaDriverTopologyKey]
+		if labels[v1beta1constants.LabelWorkerPool] != poolName || zone == "" {
+			continue
+		}
+		if index, ok := zoneIndexFromMachineDeploymentName(machineDeployment.Name, prefix); ok {
+			indices[zone] = index
+		}
+	}
+	if len(indices) == 0 {
+		return nil, nil
+	}
+
+	poolZones := &api.WorkerPoolZones{PoolName: poolName}
+	for _, zone := range slices.Sorted(maps.Keys(indices)) {
+		poolZones.Zones = append(poolZones.Zones, api.ZoneIndex{Name: zone, Index: indices[zone]})
+	}
+	return poolZones, nil
+}
+
+// zoneIndexFromMachineDeploymentName returns the zone index of the machine deployment with the given name, which must
+// consist of the given prefix, the zone number and optionally the shard suffix of further server groups of the zone.
+func zoneIndexFromMachineDeploymentName(name, prefix string) (int32, bool) {
+	suffix, ok := strings.CutPrefix(name, prefix)
+	if !ok {
+		return 0, false
+	}
+	zoneNumber, shard, hasShard := strings.Cut(suffix, "-s")
+	if hasShard {
+		if _, err := strconv.ParseUint(shard, 10, 32); err != nil {
+			return 0, false
+		}
+	}
+	index, err := strconv.ParseUint(zoneNumber, 10, 31)
+	if err != nil || index < 1 {
+		return 0, false
+	}
+	return int32(index - 1), true // #nosec: G115 - The index is parsed with a bit size of 31.
+}
+
+// isZoneFallbackEnabled returns whether the minimum and maximum of a worker pool are shifted away from exhausted zones.
+func isZoneFallbackEnabled(config *api.WorkerConfig) bool {
+	return config != nil && ptr.Deref(config.ZoneFallback, false)
+}
+
+// reconcileExhaustedZones detects the zones in which Nova found no valid host for the machines of worker pools with
+// zone fallback. The capacity shortage is recognized by the instance faults of the servers which failed to be
+// created. Zones are considered exhausted until no capacity shortage was detected for the zoneExhaustionPeriod.
+func (w *WorkerDelegate) reconcileExhaustedZones(ctx context.Context, computeClient osclient.Compute, workerStatus *api.WorkerStatus) error {
+	// The names of the servers start with the names of the machine deployments of the zones.
+	prefixes := map[string]api.ExhaustedZone{}
+	for _, pool := range w.worker.Spec.Pools {
+		workerConfig, err := helper.WorkerConfigFromRawExtension(pool.ProviderConfig)
+		if err != nil {
+			return err
+		}
+		if !isZoneFallbackEnabled(workerConfig) {
+			continue
+		}
+		previousZones, err := w.previousWorkerPoolZones(ctx, workerStatus, pool.Name)
+		if err != nil {
+			return err
+		}
+		for _, zone := range assignZoneIndices(pool.Zones, previousZones) {
+			prefix := fmt.Sprintf("%s-%s-z%d-", w.cluster.Shoot.Status.TechnicalID, pool.Name, zone.Index+1)
+			prefixes[prefix] = api.ExhaustedZone{PoolName: pool.Name, Zone: zone.Name}
+		}
+	}
+
+	var (
+		now      = metav1.Now()
+		detected = map[string]api.ExhaustedZone{}
+		known    = sets.New[string]()
+	)
+	if len(prefixes) > 0 {
+		servers, err := computeClient.FindServersByName(ctx, "^"+w.cluster.Shoot.Status.TechnicalID+"-")
+		if err != nil {
+			return err
+		}
+		for _, server := range servers {
+			if server.Status != "ERROR" || !strings.Contains(server.Fault.Message, noValidHostFault) {
+				continue
+			}
+			for prefix, zone := range prefixes {
+				if strings.HasPrefix(server.Name, prefix) {
+					zone.LastDetectionTime = now
+					detected[zone.PoolName+"/"+zone.Zone] = zone
+				}
+			}
+		}
+	}
+	for _, zone := range prefixes {
+		known.Insert(zone.PoolName + "/" + zone.Zone)
+	}
+
+	var exhaustedZones []api.ExhaustedZone
+	for _, zone := range workerStatus.ExhaustedZones {
+		key := zone.PoolName + "/" + zone.Zone
+		if !known.Has(key) || now.Sub(zone.LastDetectionTime.Time) > zoneExhaustionPeriod {
+			continue
+		}
+		if _, ok := detected[key]; !ok {
+			exhaustedZones = append(exhaustedZones, zone)
+		}
+	}
+	for _, key := range slices.Sorted(maps.Keys(detected)) {
+		zone := detected[key]
+		logf.FromContext(ctx).Info("Nova found no valid host for machines of worker pool", "pool", zone.PoolName, "zone", zone.Zone)
+		exhaustedZones = append(exhaustedZones, zone)
+	}
+	slices.SortFunc(exhaustedZones, func(a, b api.ExhaustedZone) int {
+		return cmp.Or(cmp.Compare(a.PoolName, b.PoolName), cmp.Compare(a.Zone, b.Zone))
+	})
+
+	workerStatus.ExhaustedZones = exhaustedZones
+	return nil
+}
+
+// readyMachinesOfExhaustedZones returns the number of ready machines in the exhausted zones of a worker pool.
+func (w *WorkerDelegate) readyMachinesOfExhaustedZones(ctx context.Context, pool extensionsv1alpha1.WorkerPool, zoneIndices []api.ZoneIndex, exhaustedZones []api.ExhaustedZone) (map[string]int32, error) {
+	exhausted := sets.New[string]()
+	for _, zone := range exhaustedZones {
+		if zone.PoolName == pool.Name {
+			exhausted.Insert(zone.Zone)
+		}
+	}
+	if exhausted.Len() == 0 {
+		return nil, nil
+	}
+
+	machineDeployments := &machinev1alpha1.MachineDeploymentList{}
+	if err := w.seedClient.List(ctx, machineDeployments, client.InNamespace(w.worker.Namespace)); err != nil {
+		return nil, err
+	}
+
+	readyMachines := make(map[string]int32, exhausted.Len())
+	for _, zone := range zoneIndices {
+		if !exhausted.Has(zone.Name) {
+			continue
+		}
+		name := fmt.Sprintf("%s-%s-z%d", w.cluster.Shoot.Status.TechnicalID, pool.Name, zone.Index+1)
+		readyMachines[zone.Name] = 0
+		for _, machineDeployment := range machineDeployments.Items {
+			// The machine deployments of further server groups of the zone have a shard suffix.
+			if machineDeployment.Name == name || strings.HasPrefix(machineDeployment.Name, name+"-s") {
+				readyMachines[zone.Name] += machineDeployment.Status.ReadyReplicas
+			}
+		}
+	}
+	return readyMachines, nil
+}
+
+// distributeOverAvailableZones distributes the minimum and maximum of a worker pool over its zones like
+// worker.DistributeOverZones. Exhausted zones keep at most their ready machines, and the remainder is distributed over
+// the other zones. The zones are distributed as usual if none or all of them are exhausted.
+func distributeOverAvailableZones(zoneIndex int32, zones []string, minimum, maximum int32, readyMachines map[string]int32) (int32, int32) {
+	zoneLen := int32(len(zones)) // #nosec: G115 - We validate if num pool zones exceeds max_int32.
+	if len(readyMachines) == 0 || len(readyMachines) == len(zones) {
+		return worker.DistributeOverZones(zoneIndex, minimum, zoneLen), worker.DistributeOverZones(zoneIndex, maximum, zoneLen)
+	}
+
+	var (
+		remainingMinimum = minimum
+		remainingMaximum = maximum
+		availableIndex   int32
+		availableLen     int32
+	)
+	for i, zone := range zones {
+		idx := int32(i) // #nosec: G115 - We validate if num pool zones exceeds max_int32.
+		ready, exhausted := readyMachines[zone]
+		if !exhausted {
+			if idx < zoneIndex {
+				availableIndex++
+			}
+			availableLen++
+			continue
+		}
+
+		zoneMinimum := min(worker.DistributeOverZones(idx, minimum, zoneLen), ready)
+		zoneMaximum := min(worker.DistributeOverZones(idx, maximum, zoneLen), ready)
+		if idx == zoneIndex {
+			return zoneMinimum, zoneMaximum
+		}
+		remainingMinimum -= zoneMinimum
+		remainingMaximum -= zoneMaximum
+	}
+
+	return worker.DistributeOverZones(availableIndex, remainingMinimum, availableLen), worker.DistributeOverZones(availableIndex, remainingMaximum, availableLen)
+}
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/baremetal/v1/nodes"
+)
+
+// resourceClassMicroversion defines the minimum API microversion for Ironic that returns the resource class of nodes.
+const resourceClassMicroversion = "1.21"
+
+// ListNodes lists all bare metal nodes with their details filtered by opts.
+func (c *BareMetalClient) ListNodes(ctx context.Context, opts nodes.ListOpts) ([]nodes.Node, error) {
+	microversion := c.client.Microversion
+	c.client.Microversion = resourceClassMicroversion
+	defer func() { c.client.Microversion = microversion }()
+
+	pages, err := nodes.ListDetail(c.client, opts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes.ExtractNodes(pages)
+}
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/availabilityzones"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+)
+
+// ListVolumeTypes lists all volume types which are visible to the project filtered by opts.
+func (c *BlockStorageClient) ListVolumeTypes(ctx context.Context, opts volumetypes.ListOpts) ([]volumetypes.VolumeType, error) {
+	pages, err := volumetypes.List(c.client, opts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return volumetypes.ExtractVolumeTypes(pages)
+}
+
+// GetVolumeTypeEncryption retrieves the encryption type of the volume type with the given ID. The encryption ID of the
+// result is empty if the volume type is not encrypted.
+func (c *BlockStorageClient) GetVolumeTypeEncryption(ctx context.Context, id string) (*volumetypes.GetEncryptionType, error) {
+	return volumetypes.GetEncryption(ctx, c.client, id).Extract()
+}
+
+// ListAvailabilityZones lists all availability zones of the Cinder service.
+func (c *BlockStorageClient) ListAvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error) {
+	pages, err := availabilityzones.List(c.client).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return availabilityzones.ExtractAvailabilityZones(pages)
+}
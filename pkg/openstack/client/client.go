@@ -227,6 +227,57 @@ func (oc *OpenstackClientFactory) Images(options ...Option) (Images, error) {
 	}, nil
 }
 
+// BareMetal creates a BareMetal client. The client uses Ironic v1 API for issuing calls.
+func (oc *OpenstackClientFactory) BareMetal(options ...Option) (BareMetal, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	client, err := openstack.NewBareMetalV1(oc.providerClient, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BareMetalClient{
+		client: client,
+	}, nil
+}
+
+// BlockStorage creates a BlockStorage client. The client uses Cinder v3 API for issuing calls.
+func (oc *OpenstackClientFactory) BlockStorage(options ...Option) (BlockStorage, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	client, err := openstack.NewBlockStorageV3(oc.providerClient, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BlockStorageClient{
+		client: client,
+	}, nil
+}
+
+// Identity creates an Identity client. The client uses Keystone v3 API for issuing calls.
+func (oc *OpenstackClientFactory) Identity(options ...Option) (Identity, error) {
+	eo := gophercloud.EndpointOpts{}
+	for _, opt := range options {
+		eo = opt(eo)
+	}
+
+	client, err := openstack.NewIdentityV3(oc.providerClient, eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdentityClient{
+		client: client,
+	}, nil
+}
+
 // IsNotFoundError checks if an error returned by OpenStack is caused by HTTP 404 status code.
 func IsNotFoundError(err error) bool {
 	if err == nil {
@@ -240,6 +291,11 @@ func IsNotFoundError(err error) bool {
 	return false
 }
 
+// IsForbiddenError checks if an error returned by OpenStack is caused by HTTP 403 status code.
+func IsForbiddenError(err error) bool {
+	return gophercloud.ResponseCodeIs(err, http.StatusForbidden)
+}
+
 // IgnoreNotFoundError ignore not found error
 func IgnoreNotFoundError(err error) error {
 	if IsNotFoundError(err) {
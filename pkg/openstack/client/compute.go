@@ -20,6 +20,8 @@ const (
 	ServerGroupPolicyAntiAffinity = "anti-affinity"
 	// ServerGroupPolicyAffinity is a constant for the affinity server group policy.
 	ServerGroupPolicyAffinity = "affinity"
+	// ServerGroupPolicySoftAntiAffinity is a constant for the soft-anti-affinity server group policy.
+	ServerGroupPolicySoftAntiAffinity = "soft-anti-affinity"
 
 	// softPolicyMicroversion defines the minimum API microversion for Nova that can support soft-* policy variants for server groups.
 	// We set the minimum supported microversion, since later versions (>=2.64) have non-backwards-compatible changes forcing the use of
@@ -29,6 +31,8 @@ const (
 	// https://docs.openstack.org/api-guide/compute/microversions.html
 	// https://docs.openstack.org/api-ref/compute/?expanded=create-server-group-detail#create-server-group
 	softPolicyMicroversion = "2.15"
+	// serverGroupRulesMicroversion defines the minimum API microversion for Nova that supports rules for server groups.
+	serverGroupRulesMicroversion = "2.64"
 )
 
 // CreateServerGroup creates a server group with the specified policy.
@@ -45,6 +49,21 @@ func (c *ComputeClient) CreateServerGroup(ctx context.Context, name, policy stri
 	return servergroups.Create(ctx, c.client, createOpts).Extract()
 }
 
+// CreateServerGroupWithRules creates a server group with the specified policy and rules.
+func (c *ComputeClient) CreateServerGroupWithRules(ctx context.Context, name, policy string, rules servergroups.Rules) (*servergroups.ServerGroup, error) {
+	microversion := c.client.Microversion
+	c.client.Microversion = serverGroupRulesMicroversion
+	defer func() { c.client.Microversion = microversion }()
+
+	createOpts := servergroups.CreateOpts{
+		Name:   name,
+		Policy: policy,
+		Rules:  &rules,
+	}
+
+	return servergroups.Create(ctx, c.client, createOpts).Extract()
+}
+
 // GetServerGroup retrieves the server group with the specified id.
 func (c *ComputeClient) GetServerGroup(ctx context.Context, id string) (*servergroups.ServerGroup, error) {
 	return servergroups.Get(ctx, c.client, id).Extract()
@@ -100,15 +119,9 @@ func (c *ComputeClient) FindServersByName(ctx context.Context, name string) ([]s
 // FindFlavorID find flavor ID by flavor name.
 func (c *ComputeClient) FindFlavorID(ctx context.Context, name string) (string, error) {
 	// unfortunately, there is no way to filter by name
-	allPages, err := flavors.ListDetail(c.client, nil).AllPages(ctx)
-	if err != nil {
-		return "", fmt.Errorf("unable to list flavors: %w", err)
-	}
-
-	// Extract and filter
-	allFlavors, err := flavors.ExtractFlavors(allPages)
+	allFlavors, err := c.ListFlavors(ctx)
 	if err != nil {
-		return "", fmt.Errorf("unable to extract flavors: %w", err)
+		return "", err
 	}
 
 	for _, flavor := range allFlavors {
@@ -120,6 +133,25 @@ func (c *ComputeClient) FindFlavorID(ctx context.Context, name string) (string,
 	return "", fmt.Errorf("flavor with name %q not found", name)
 }
 
+// ListFlavors lists all flavors.
+func (c *ComputeClient) ListFlavors(ctx context.Context) ([]flavors.Flavor, error) {
+	allPages, err := flavors.ListDetail(c.client, nil).AllPages(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list flavors: %w", err)
+	}
+
+	allFlavors, err := flavors.ExtractFlavors(allPages)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract flavors: %w", err)
+	}
+	return allFlavors, nil
+}
+
+// ListFlavorExtraSpecs lists the extra specs of the flavor with the given ID.
+func (c *ComputeClient) ListFlavorExtraSpecs(ctx context.Context, id string) (map[string]string, error) {
+	return flavors.ListExtraSpecs(ctx, c.client, id).Extract()
+}
+
 // FindImages find image ID by images name.
 func (c *ComputeClient) FindImages(ctx context.Context, name string) ([]images.Image, error) {
 	listOpts := images.ListOpts{
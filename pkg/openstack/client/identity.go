@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+)
+
+// GetCurrentUserID returns the ID of the user the client is authenticated as.
+func (c *IdentityClient) GetCurrentUserID(ctx context.Context) (string, error) {
+	user, err := tokens.Get(ctx, c.client, c.client.Token()).ExtractUser()
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// ListApplicationCredentials lists all application credentials of the user with the given ID.
+func (c *IdentityClient) ListApplicationCredentials(ctx context.Context, userID string) ([]applicationcredentials.ApplicationCredential, error) {
+	pages, err := applicationcredentials.List(c.client, userID, applicationcredentials.ListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return applicationcredentials.ExtractApplicationCredentials(pages)
+}
+
+// CreateApplicationCredential creates an application credential for the user with the given ID.
+func (c *IdentityClient) CreateApplicationCredential(ctx context.Context, userID string, opts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+	return applicationcredentials.Create(ctx, c.client, userID, opts).Extract()
+}
+
+// DeleteApplicationCredential deletes the application credential with the given ID of the user with the given ID.
+func (c *IdentityClient) DeleteApplicationCredential(ctx context.Context, userID, id string) error {
+	return applicationcredentials.Delete(ctx, c.client, userID, id).ExtractErr()
+}
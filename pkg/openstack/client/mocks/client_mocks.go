@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client (interfaces: Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem)
+// Source: github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client (interfaces: Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem,Storage,Images,BareMetal,BlockStorage,Identity)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks/client_mocks.go -package=mocks . Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem
+//	mockgen -destination=mocks/client_mocks.go -package=mocks . Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem,Storage,Images,BareMetal,BlockStorage,Identity
 //
 
 // Package mocks is a generated GoMock package.
@@ -12,17 +12,29 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	openstack "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
 	client "github.com/gardener/gardener-extension-provider-openstack/pkg/openstack/client"
+	nodes "github.com/gophercloud/gophercloud/v2/openstack/baremetal/v1/nodes"
+	availabilityzones "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/availabilityzones"
+	volumetypes "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+	flavors "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
 	keypairs "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/keypairs"
 	servergroups "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
 	servers "github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	applicationcredentials "github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	images "github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
 	loadbalancers "github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	groups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/groups"
+	policies "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/policies"
+	rules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/rules"
 	floatingips "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	routers "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
-	groups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
-	rules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
+	policies0 "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	rules0 "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/rules"
+	groups0 "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
+	rules1 "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	subnetpools "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
 	networks "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/networks"
 	ports "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/ports"
@@ -55,6 +67,44 @@ func (m *MockFactory) EXPECT() *MockFactoryMockRecorder {
 	return m.recorder
 }
 
+// BareMetal mocks base method.
+func (m *MockFactory) BareMetal(options ...client.Option) (client.BareMetal, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BareMetal", varargs...)
+	ret0, _ := ret[0].(client.BareMetal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BareMetal indicates an expected call of BareMetal.
+func (mr *MockFactoryMockRecorder) BareMetal(options ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BareMetal", reflect.TypeOf((*MockFactory)(nil).BareMetal), options...)
+}
+
+// BlockStorage mocks base method.
+func (m *MockFactory) BlockStorage(options ...client.Option) (client.BlockStorage, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BlockStorage", varargs...)
+	ret0, _ := ret[0].(client.BlockStorage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BlockStorage indicates an expected call of BlockStorage.
+func (mr *MockFactoryMockRecorder) BlockStorage(options ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockStorage", reflect.TypeOf((*MockFactory)(nil).BlockStorage), options...)
+}
+
 // Compute mocks base method.
 func (m *MockFactory) Compute(options ...client.Option) (client.Compute, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +143,25 @@ func (mr *MockFactoryMockRecorder) DNS(options ...any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DNS", reflect.TypeOf((*MockFactory)(nil).DNS), options...)
 }
 
+// Identity mocks base method.
+func (m *MockFactory) Identity(options ...client.Option) (client.Identity, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{}
+	for _, a := range options {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Identity", varargs...)
+	ret0, _ := ret[0].(client.Identity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Identity indicates an expected call of Identity.
+func (mr *MockFactoryMockRecorder) Identity(options ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Identity", reflect.TypeOf((*MockFactory)(nil).Identity), options...)
+}
+
 // Images mocks base method.
 func (m *MockFactory) Images(options ...client.Option) (client.Images, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +365,21 @@ func (mr *MockComputeMockRecorder) CreateServerGroup(ctx, name, policy any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServerGroup", reflect.TypeOf((*MockCompute)(nil).CreateServerGroup), ctx, name, policy)
 }
 
+// CreateServerGroupWithRules mocks base method.
+func (m *MockCompute) CreateServerGroupWithRules(ctx context.Context, name, policy string, arg3 servergroups.Rules) (*servergroups.ServerGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServerGroupWithRules", ctx, name, policy, arg3)
+	ret0, _ := ret[0].(*servergroups.ServerGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServerGroupWithRules indicates an expected call of CreateServerGroupWithRules.
+func (mr *MockComputeMockRecorder) CreateServerGroupWithRules(ctx, name, policy, arg3 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServerGroupWithRules", reflect.TypeOf((*MockCompute)(nil).CreateServerGroupWithRules), ctx, name, policy, arg3)
+}
+
 // DeleteKeyPair mocks base method.
 func (m *MockCompute) DeleteKeyPair(ctx context.Context, name string) error {
 	m.ctrl.T.Helper()
@@ -398,6 +482,36 @@ func (mr *MockComputeMockRecorder) GetServerGroup(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServerGroup", reflect.TypeOf((*MockCompute)(nil).GetServerGroup), ctx, id)
 }
 
+// ListFlavorExtraSpecs mocks base method.
+func (m *MockCompute) ListFlavorExtraSpecs(ctx context.Context, id string) (map[string]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlavorExtraSpecs", ctx, id)
+	ret0, _ := ret[0].(map[string]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlavorExtraSpecs indicates an expected call of ListFlavorExtraSpecs.
+func (mr *MockComputeMockRecorder) ListFlavorExtraSpecs(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlavorExtraSpecs", reflect.TypeOf((*MockCompute)(nil).ListFlavorExtraSpecs), ctx, id)
+}
+
+// ListFlavors mocks base method.
+func (m *MockCompute) ListFlavors(ctx context.Context) ([]flavors.Flavor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFlavors", ctx)
+	ret0, _ := ret[0].([]flavors.Flavor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFlavors indicates an expected call of ListFlavors.
+func (mr *MockComputeMockRecorder) ListFlavors(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFlavors", reflect.TypeOf((*MockCompute)(nil).ListFlavors), ctx)
+}
+
 // ListServerGroups mocks base method.
 func (m *MockCompute) ListServerGroups(ctx context.Context) ([]servergroups.ServerGroup, error) {
 	m.ctrl.T.Helper()
@@ -519,6 +633,96 @@ func (mr *MockNetworkingMockRecorder) AddRouterInterface(ctx, routerID, addOpts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRouterInterface", reflect.TypeOf((*MockNetworking)(nil).AddRouterInterface), ctx, routerID, addOpts)
 }
 
+// AddRoutesToRouter mocks base method.
+func (m *MockNetworking) AddRoutesToRouter(ctx context.Context, routes []routers.Route, routerID string) (*routers.Router, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRoutesToRouter", ctx, routes, routerID)
+	ret0, _ := ret[0].(*routers.Router)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRoutesToRouter indicates an expected call of AddRoutesToRouter.
+func (mr *MockNetworkingMockRecorder) AddRoutesToRouter(ctx, routes, routerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRoutesToRouter", reflect.TypeOf((*MockNetworking)(nil).AddRoutesToRouter), ctx, routes, routerID)
+}
+
+// CreateBandwidthLimitRule mocks base method.
+func (m *MockNetworking) CreateBandwidthLimitRule(ctx context.Context, policyID string, createOpts rules0.CreateBandwidthLimitRuleOpts) (*rules0.BandwidthLimitRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBandwidthLimitRule", ctx, policyID, createOpts)
+	ret0, _ := ret[0].(*rules0.BandwidthLimitRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBandwidthLimitRule indicates an expected call of CreateBandwidthLimitRule.
+func (mr *MockNetworkingMockRecorder) CreateBandwidthLimitRule(ctx, policyID, createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBandwidthLimitRule", reflect.TypeOf((*MockNetworking)(nil).CreateBandwidthLimitRule), ctx, policyID, createOpts)
+}
+
+// CreateDSCPMarkingRule mocks base method.
+func (m *MockNetworking) CreateDSCPMarkingRule(ctx context.Context, policyID string, createOpts rules0.CreateDSCPMarkingRuleOpts) (*rules0.DSCPMarkingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDSCPMarkingRule", ctx, policyID, createOpts)
+	ret0, _ := ret[0].(*rules0.DSCPMarkingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDSCPMarkingRule indicates an expected call of CreateDSCPMarkingRule.
+func (mr *MockNetworkingMockRecorder) CreateDSCPMarkingRule(ctx, policyID, createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDSCPMarkingRule", reflect.TypeOf((*MockNetworking)(nil).CreateDSCPMarkingRule), ctx, policyID, createOpts)
+}
+
+// CreateFirewallGroup mocks base method.
+func (m *MockNetworking) CreateFirewallGroup(ctx context.Context, createOpts groups.CreateOpts) (*groups.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirewallGroup", ctx, createOpts)
+	ret0, _ := ret[0].(*groups.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirewallGroup indicates an expected call of CreateFirewallGroup.
+func (mr *MockNetworkingMockRecorder) CreateFirewallGroup(ctx, createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallGroup", reflect.TypeOf((*MockNetworking)(nil).CreateFirewallGroup), ctx, createOpts)
+}
+
+// CreateFirewallPolicy mocks base method.
+func (m *MockNetworking) CreateFirewallPolicy(ctx context.Context, createOpts policies.CreateOpts) (*policies.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirewallPolicy", ctx, createOpts)
+	ret0, _ := ret[0].(*policies.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirewallPolicy indicates an expected call of CreateFirewallPolicy.
+func (mr *MockNetworkingMockRecorder) CreateFirewallPolicy(ctx, createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallPolicy", reflect.TypeOf((*MockNetworking)(nil).CreateFirewallPolicy), ctx, createOpts)
+}
+
+// CreateFirewallRule mocks base method.
+func (m *MockNetworking) CreateFirewallRule(ctx context.Context, createOpts rules.CreateOpts) (*rules.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirewallRule", ctx, createOpts)
+	ret0, _ := ret[0].(*rules.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirewallRule indicates an expected call of CreateFirewallRule.
+func (mr *MockNetworkingMockRecorder) CreateFirewallRule(ctx, createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallRule", reflect.TypeOf((*MockNetworking)(nil).CreateFirewallRule), ctx, createOpts)
+}
+
 // CreateFloatingIP mocks base method.
 func (m *MockNetworking) CreateFloatingIP(ctx context.Context, createOpts floatingips.CreateOpts) (*floatingips.FloatingIP, error) {
 	m.ctrl.T.Helper()
@@ -534,6 +738,21 @@ func (mr *MockNetworkingMockRecorder) CreateFloatingIP(ctx, createOpts any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFloatingIP", reflect.TypeOf((*MockNetworking)(nil).CreateFloatingIP), ctx, createOpts)
 }
 
+// CreateMinimumBandwidthRule mocks base method.
+func (m *MockNetworking) CreateMinimumBandwidthRule(ctx context.Context, policyID string, createOpts rules0.CreateMinimumBandwidthRuleOpts) (*rules0.MinimumBandwidthRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMinimumBandwidthRule", ctx, policyID, createOpts)
+	ret0, _ := ret[0].(*rules0.MinimumBandwidthRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMinimumBandwidthRule indicates an expected call of CreateMinimumBandwidthRule.
+func (mr *MockNetworkingMockRecorder) CreateMinimumBandwidthRule(ctx, policyID, createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMinimumBandwidthRule", reflect.TypeOf((*MockNetworking)(nil).CreateMinimumBandwidthRule), ctx, policyID, createOpts)
+}
+
 // CreateNetwork mocks base method.
 func (m *MockNetworking) CreateNetwork(ctx context.Context, opts networks.CreateOpts) (*networks.Network, error) {
 	m.ctrl.T.Helper()
@@ -549,6 +768,21 @@ func (mr *MockNetworkingMockRecorder) CreateNetwork(ctx, opts any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetwork", reflect.TypeOf((*MockNetworking)(nil).CreateNetwork), ctx, opts)
 }
 
+// CreateQoSPolicy mocks base method.
+func (m *MockNetworking) CreateQoSPolicy(ctx context.Context, createOpts policies0.CreateOpts) (*policies0.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQoSPolicy", ctx, createOpts)
+	ret0, _ := ret[0].(*policies0.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQoSPolicy indicates an expected call of CreateQoSPolicy.
+func (mr *MockNetworkingMockRecorder) CreateQoSPolicy(ctx, createOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQoSPolicy", reflect.TypeOf((*MockNetworking)(nil).CreateQoSPolicy), ctx, createOpts)
+}
+
 // CreateRouter mocks base method.
 func (m *MockNetworking) CreateRouter(ctx context.Context, createOpts routers.CreateOpts) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -565,10 +799,10 @@ func (mr *MockNetworkingMockRecorder) CreateRouter(ctx, createOpts any) *gomock.
 }
 
 // CreateRule mocks base method.
-func (m *MockNetworking) CreateRule(ctx context.Context, createOpts rules.CreateOpts) (*rules.SecGroupRule, error) {
+func (m *MockNetworking) CreateRule(ctx context.Context, createOpts rules1.CreateOpts) (*rules1.SecGroupRule, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateRule", ctx, createOpts)
-	ret0, _ := ret[0].(*rules.SecGroupRule)
+	ret0, _ := ret[0].(*rules1.SecGroupRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -580,10 +814,10 @@ func (mr *MockNetworkingMockRecorder) CreateRule(ctx, createOpts any) *gomock.Ca
 }
 
 // CreateSecurityGroup mocks base method.
-func (m *MockNetworking) CreateSecurityGroup(ctx context.Context, listOpts groups.CreateOpts) (*groups.SecGroup, error) {
+func (m *MockNetworking) CreateSecurityGroup(ctx context.Context, listOpts groups0.CreateOpts) (*groups0.SecGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSecurityGroup", ctx, listOpts)
-	ret0, _ := ret[0].(*groups.SecGroup)
+	ret0, _ := ret[0].(*groups0.SecGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -624,6 +858,76 @@ func (mr *MockNetworkingMockRecorder) CreateSubnetPool(ctx, createOpts any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubnetPool", reflect.TypeOf((*MockNetworking)(nil).CreateSubnetPool), ctx, createOpts)
 }
 
+// DeleteBandwidthLimitRule mocks base method.
+func (m *MockNetworking) DeleteBandwidthLimitRule(ctx context.Context, policyID, ruleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBandwidthLimitRule", ctx, policyID, ruleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBandwidthLimitRule indicates an expected call of DeleteBandwidthLimitRule.
+func (mr *MockNetworkingMockRecorder) DeleteBandwidthLimitRule(ctx, policyID, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBandwidthLimitRule", reflect.TypeOf((*MockNetworking)(nil).DeleteBandwidthLimitRule), ctx, policyID, ruleID)
+}
+
+// DeleteDSCPMarkingRule mocks base method.
+func (m *MockNetworking) DeleteDSCPMarkingRule(ctx context.Context, policyID, ruleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDSCPMarkingRule", ctx, policyID, ruleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDSCPMarkingRule indicates an expected call of DeleteDSCPMarkingRule.
+func (mr *MockNetworkingMockRecorder) DeleteDSCPMarkingRule(ctx, policyID, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDSCPMarkingRule", reflect.TypeOf((*MockNetworking)(nil).DeleteDSCPMarkingRule), ctx, policyID, ruleID)
+}
+
+// DeleteFirewallGroup mocks base method.
+func (m *MockNetworking) DeleteFirewallGroup(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFirewallGroup", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFirewallGroup indicates an expected call of DeleteFirewallGroup.
+func (mr *MockNetworkingMockRecorder) DeleteFirewallGroup(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallGroup", reflect.TypeOf((*MockNetworking)(nil).DeleteFirewallGroup), ctx, id)
+}
+
+// DeleteFirewallPolicy mocks base method.
+func (m *MockNetworking) DeleteFirewallPolicy(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFirewallPolicy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFirewallPolicy indicates an expected call of DeleteFirewallPolicy.
+func (mr *MockNetworkingMockRecorder) DeleteFirewallPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallPolicy", reflect.TypeOf((*MockNetworking)(nil).DeleteFirewallPolicy), ctx, id)
+}
+
+// DeleteFirewallRule mocks base method.
+func (m *MockNetworking) DeleteFirewallRule(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFirewallRule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFirewallRule indicates an expected call of DeleteFirewallRule.
+func (mr *MockNetworkingMockRecorder) DeleteFirewallRule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockNetworking)(nil).DeleteFirewallRule), ctx, id)
+}
+
 // DeleteFloatingIP mocks base method.
 func (m *MockNetworking) DeleteFloatingIP(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -638,6 +942,20 @@ func (mr *MockNetworkingMockRecorder) DeleteFloatingIP(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFloatingIP", reflect.TypeOf((*MockNetworking)(nil).DeleteFloatingIP), ctx, id)
 }
 
+// DeleteMinimumBandwidthRule mocks base method.
+func (m *MockNetworking) DeleteMinimumBandwidthRule(ctx context.Context, policyID, ruleID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMinimumBandwidthRule", ctx, policyID, ruleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMinimumBandwidthRule indicates an expected call of DeleteMinimumBandwidthRule.
+func (mr *MockNetworkingMockRecorder) DeleteMinimumBandwidthRule(ctx, policyID, ruleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMinimumBandwidthRule", reflect.TypeOf((*MockNetworking)(nil).DeleteMinimumBandwidthRule), ctx, policyID, ruleID)
+}
+
 // DeleteNetwork mocks base method.
 func (m *MockNetworking) DeleteNetwork(ctx context.Context, networkID string) error {
 	m.ctrl.T.Helper()
@@ -652,6 +970,20 @@ func (mr *MockNetworkingMockRecorder) DeleteNetwork(ctx, networkID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockNetworking)(nil).DeleteNetwork), ctx, networkID)
 }
 
+// DeleteQoSPolicy mocks base method.
+func (m *MockNetworking) DeleteQoSPolicy(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteQoSPolicy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteQoSPolicy indicates an expected call of DeleteQoSPolicy.
+func (mr *MockNetworkingMockRecorder) DeleteQoSPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteQoSPolicy", reflect.TypeOf((*MockNetworking)(nil).DeleteQoSPolicy), ctx, id)
+}
+
 // DeleteRouter mocks base method.
 func (m *MockNetworking) DeleteRouter(ctx context.Context, routerID string) error {
 	m.ctrl.T.Helper()
@@ -797,6 +1129,21 @@ func (mr *MockNetworkingMockRecorder) GetInstancePorts(ctx, instanceID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstancePorts", reflect.TypeOf((*MockNetworking)(nil).GetInstancePorts), ctx, instanceID)
 }
 
+// GetNetworkAvailabilityZones mocks base method.
+func (m *MockNetworking) GetNetworkAvailabilityZones(ctx context.Context, id string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkAvailabilityZones", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkAvailabilityZones indicates an expected call of GetNetworkAvailabilityZones.
+func (mr *MockNetworkingMockRecorder) GetNetworkAvailabilityZones(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkAvailabilityZones", reflect.TypeOf((*MockNetworking)(nil).GetNetworkAvailabilityZones), ctx, id)
+}
+
 // GetNetworkByID mocks base method.
 func (m *MockNetworking) GetNetworkByID(ctx context.Context, id string) (*networks.Network, error) {
 	m.ctrl.T.Helper()
@@ -827,6 +1174,21 @@ func (mr *MockNetworkingMockRecorder) GetNetworkByName(ctx, name any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByName", reflect.TypeOf((*MockNetworking)(nil).GetNetworkByName), ctx, name)
 }
 
+// GetNetworkQoSPolicyID mocks base method.
+func (m *MockNetworking) GetNetworkQoSPolicyID(ctx context.Context, networkID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkQoSPolicyID", ctx, networkID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkQoSPolicyID indicates an expected call of GetNetworkQoSPolicyID.
+func (mr *MockNetworkingMockRecorder) GetNetworkQoSPolicyID(ctx, networkID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkQoSPolicyID", reflect.TypeOf((*MockNetworking)(nil).GetNetworkQoSPolicyID), ctx, networkID)
+}
+
 // GetPort mocks base method.
 func (m *MockNetworking) GetPort(ctx context.Context, portID string) (*ports.Port, error) {
 	m.ctrl.T.Helper()
@@ -842,6 +1204,36 @@ func (mr *MockNetworkingMockRecorder) GetPort(ctx, portID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPort", reflect.TypeOf((*MockNetworking)(nil).GetPort), ctx, portID)
 }
 
+// GetQoSPolicy mocks base method.
+func (m *MockNetworking) GetQoSPolicy(ctx context.Context, id string) (*policies0.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQoSPolicy", ctx, id)
+	ret0, _ := ret[0].(*policies0.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQoSPolicy indicates an expected call of GetQoSPolicy.
+func (mr *MockNetworkingMockRecorder) GetQoSPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQoSPolicy", reflect.TypeOf((*MockNetworking)(nil).GetQoSPolicy), ctx, id)
+}
+
+// GetRouterAvailabilityZones mocks base method.
+func (m *MockNetworking) GetRouterAvailabilityZones(ctx context.Context, id string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouterAvailabilityZones", ctx, id)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRouterAvailabilityZones indicates an expected call of GetRouterAvailabilityZones.
+func (mr *MockNetworkingMockRecorder) GetRouterAvailabilityZones(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterAvailabilityZones", reflect.TypeOf((*MockNetworking)(nil).GetRouterAvailabilityZones), ctx, id)
+}
+
 // GetRouterByID mocks base method.
 func (m *MockNetworking) GetRouterByID(ctx context.Context, id string) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -873,10 +1265,10 @@ func (mr *MockNetworkingMockRecorder) GetRouterInterfacePort(ctx, routerID, subn
 }
 
 // GetSecurityGroup mocks base method.
-func (m *MockNetworking) GetSecurityGroup(ctx context.Context, groupID string) (*groups.SecGroup, error) {
+func (m *MockNetworking) GetSecurityGroup(ctx context.Context, groupID string) (*groups0.SecGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSecurityGroup", ctx, groupID)
-	ret0, _ := ret[0].(*groups.SecGroup)
+	ret0, _ := ret[0].(*groups0.SecGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -888,10 +1280,10 @@ func (mr *MockNetworkingMockRecorder) GetSecurityGroup(ctx, groupID any) *gomock
 }
 
 // GetSecurityGroupByName mocks base method.
-func (m *MockNetworking) GetSecurityGroupByName(ctx context.Context, name string) ([]groups.SecGroup, error) {
+func (m *MockNetworking) GetSecurityGroupByName(ctx context.Context, name string) ([]groups0.SecGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSecurityGroupByName", ctx, name)
-	ret0, _ := ret[0].([]groups.SecGroup)
+	ret0, _ := ret[0].([]groups0.SecGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -917,6 +1309,36 @@ func (mr *MockNetworkingMockRecorder) GetSubnetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetByID", reflect.TypeOf((*MockNetworking)(nil).GetSubnetByID), ctx, id)
 }
 
+// ListBandwidthLimitRules mocks base method.
+func (m *MockNetworking) ListBandwidthLimitRules(ctx context.Context, policyID string) ([]rules0.BandwidthLimitRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBandwidthLimitRules", ctx, policyID)
+	ret0, _ := ret[0].([]rules0.BandwidthLimitRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBandwidthLimitRules indicates an expected call of ListBandwidthLimitRules.
+func (mr *MockNetworkingMockRecorder) ListBandwidthLimitRules(ctx, policyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBandwidthLimitRules", reflect.TypeOf((*MockNetworking)(nil).ListBandwidthLimitRules), ctx, policyID)
+}
+
+// ListDSCPMarkingRules mocks base method.
+func (m *MockNetworking) ListDSCPMarkingRules(ctx context.Context, policyID string) ([]rules0.DSCPMarkingRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDSCPMarkingRules", ctx, policyID)
+	ret0, _ := ret[0].([]rules0.DSCPMarkingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDSCPMarkingRules indicates an expected call of ListDSCPMarkingRules.
+func (mr *MockNetworkingMockRecorder) ListDSCPMarkingRules(ctx, policyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDSCPMarkingRules", reflect.TypeOf((*MockNetworking)(nil).ListDSCPMarkingRules), ctx, policyID)
+}
+
 // ListFip mocks base method.
 func (m *MockNetworking) ListFip(ctx context.Context, listOpts floatingips.ListOpts) ([]floatingips.FloatingIP, error) {
 	m.ctrl.T.Helper()
@@ -932,6 +1354,66 @@ func (mr *MockNetworkingMockRecorder) ListFip(ctx, listOpts any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFip", reflect.TypeOf((*MockNetworking)(nil).ListFip), ctx, listOpts)
 }
 
+// ListFirewallGroups mocks base method.
+func (m *MockNetworking) ListFirewallGroups(ctx context.Context, listOpts groups.ListOpts) ([]groups.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirewallGroups", ctx, listOpts)
+	ret0, _ := ret[0].([]groups.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallGroups indicates an expected call of ListFirewallGroups.
+func (mr *MockNetworkingMockRecorder) ListFirewallGroups(ctx, listOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallGroups", reflect.TypeOf((*MockNetworking)(nil).ListFirewallGroups), ctx, listOpts)
+}
+
+// ListFirewallPolicies mocks base method.
+func (m *MockNetworking) ListFirewallPolicies(ctx context.Context, listOpts policies.ListOpts) ([]policies.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirewallPolicies", ctx, listOpts)
+	ret0, _ := ret[0].([]policies.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallPolicies indicates an expected call of ListFirewallPolicies.
+func (mr *MockNetworkingMockRecorder) ListFirewallPolicies(ctx, listOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallPolicies", reflect.TypeOf((*MockNetworking)(nil).ListFirewallPolicies), ctx, listOpts)
+}
+
+// ListFirewallRules mocks base method.
+func (m *MockNetworking) ListFirewallRules(ctx context.Context, listOpts rules.ListOpts) ([]rules.Rule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirewallRules", ctx, listOpts)
+	ret0, _ := ret[0].([]rules.Rule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallRules indicates an expected call of ListFirewallRules.
+func (mr *MockNetworkingMockRecorder) ListFirewallRules(ctx, listOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRules", reflect.TypeOf((*MockNetworking)(nil).ListFirewallRules), ctx, listOpts)
+}
+
+// ListMinimumBandwidthRules mocks base method.
+func (m *MockNetworking) ListMinimumBandwidthRules(ctx context.Context, policyID string) ([]rules0.MinimumBandwidthRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMinimumBandwidthRules", ctx, policyID)
+	ret0, _ := ret[0].([]rules0.MinimumBandwidthRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListMinimumBandwidthRules indicates an expected call of ListMinimumBandwidthRules.
+func (mr *MockNetworkingMockRecorder) ListMinimumBandwidthRules(ctx, policyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMinimumBandwidthRules", reflect.TypeOf((*MockNetworking)(nil).ListMinimumBandwidthRules), ctx, policyID)
+}
+
 // ListNetwork mocks base method.
 func (m *MockNetworking) ListNetwork(ctx context.Context, listOpts networks.ListOpts) ([]networks.Network, error) {
 	m.ctrl.T.Helper()
@@ -947,6 +1429,21 @@ func (mr *MockNetworkingMockRecorder) ListNetwork(ctx, listOpts any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetwork", reflect.TypeOf((*MockNetworking)(nil).ListNetwork), ctx, listOpts)
 }
 
+// ListQoSPolicies mocks base method.
+func (m *MockNetworking) ListQoSPolicies(ctx context.Context, listOpts policies0.ListOpts) ([]policies0.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQoSPolicies", ctx, listOpts)
+	ret0, _ := ret[0].([]policies0.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQoSPolicies indicates an expected call of ListQoSPolicies.
+func (mr *MockNetworkingMockRecorder) ListQoSPolicies(ctx, listOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQoSPolicies", reflect.TypeOf((*MockNetworking)(nil).ListQoSPolicies), ctx, listOpts)
+}
+
 // ListRouters mocks base method.
 func (m *MockNetworking) ListRouters(ctx context.Context, listOpts routers.ListOpts) ([]routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -963,10 +1460,10 @@ func (mr *MockNetworkingMockRecorder) ListRouters(ctx, listOpts any) *gomock.Cal
 }
 
 // ListRules mocks base method.
-func (m *MockNetworking) ListRules(ctx context.Context, listOpts rules.ListOpts) ([]rules.SecGroupRule, error) {
+func (m *MockNetworking) ListRules(ctx context.Context, listOpts rules1.ListOpts) ([]rules1.SecGroupRule, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListRules", ctx, listOpts)
-	ret0, _ := ret[0].([]rules.SecGroupRule)
+	ret0, _ := ret[0].([]rules1.SecGroupRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -978,10 +1475,10 @@ func (mr *MockNetworkingMockRecorder) ListRules(ctx, listOpts any) *gomock.Call
 }
 
 // ListSecurityGroup mocks base method.
-func (m *MockNetworking) ListSecurityGroup(ctx context.Context, listOpts groups.ListOpts) ([]groups.SecGroup, error) {
+func (m *MockNetworking) ListSecurityGroup(ctx context.Context, listOpts groups0.ListOpts) ([]groups0.SecGroup, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSecurityGroup", ctx, listOpts)
-	ret0, _ := ret[0].([]groups.SecGroup)
+	ret0, _ := ret[0].([]groups0.SecGroup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1037,6 +1534,21 @@ func (mr *MockNetworkingMockRecorder) RemoveRouterInterface(ctx, routerID, remov
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRouterInterface", reflect.TypeOf((*MockNetworking)(nil).RemoveRouterInterface), ctx, routerID, removeOpts)
 }
 
+// RemoveRoutesFromRouter mocks base method.
+func (m *MockNetworking) RemoveRoutesFromRouter(ctx context.Context, routes []routers.Route, routerID string) (*routers.Router, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRoutesFromRouter", ctx, routes, routerID)
+	ret0, _ := ret[0].(*routers.Router)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRoutesFromRouter indicates an expected call of RemoveRoutesFromRouter.
+func (mr *MockNetworkingMockRecorder) RemoveRoutesFromRouter(ctx, routes, routerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoutesFromRouter", reflect.TypeOf((*MockNetworking)(nil).RemoveRoutesFromRouter), ctx, routes, routerID)
+}
+
 // UpdateFIPWithPort mocks base method.
 func (m *MockNetworking) UpdateFIPWithPort(ctx context.Context, fipID, portID string) error {
 	m.ctrl.T.Helper()
@@ -1051,6 +1563,36 @@ func (mr *MockNetworkingMockRecorder) UpdateFIPWithPort(ctx, fipID, portID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFIPWithPort", reflect.TypeOf((*MockNetworking)(nil).UpdateFIPWithPort), ctx, fipID, portID)
 }
 
+// UpdateFirewallGroup mocks base method.
+func (m *MockNetworking) UpdateFirewallGroup(ctx context.Context, id string, updateOpts groups.UpdateOpts) (*groups.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirewallGroup", ctx, id, updateOpts)
+	ret0, _ := ret[0].(*groups.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFirewallGroup indicates an expected call of UpdateFirewallGroup.
+func (mr *MockNetworkingMockRecorder) UpdateFirewallGroup(ctx, id, updateOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallGroup", reflect.TypeOf((*MockNetworking)(nil).UpdateFirewallGroup), ctx, id, updateOpts)
+}
+
+// UpdateFirewallPolicy mocks base method.
+func (m *MockNetworking) UpdateFirewallPolicy(ctx context.Context, id string, updateOpts policies.UpdateOpts) (*policies.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirewallPolicy", ctx, id, updateOpts)
+	ret0, _ := ret[0].(*policies.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFirewallPolicy indicates an expected call of UpdateFirewallPolicy.
+func (mr *MockNetworkingMockRecorder) UpdateFirewallPolicy(ctx, id, updateOpts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallPolicy", reflect.TypeOf((*MockNetworking)(nil).UpdateFirewallPolicy), ctx, id, updateOpts)
+}
+
 // UpdateNetwork mocks base method.
 func (m *MockNetworking) UpdateNetwork(ctx context.Context, networkID string, opts networks.UpdateOpts) (*networks.Network, error) {
 	m.ctrl.T.Helper()
@@ -1066,6 +1608,20 @@ func (mr *MockNetworkingMockRecorder) UpdateNetwork(ctx, networkID, opts any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetwork", reflect.TypeOf((*MockNetworking)(nil).UpdateNetwork), ctx, networkID, opts)
 }
 
+// UpdateNetworkQoSPolicyID mocks base method.
+func (m *MockNetworking) UpdateNetworkQoSPolicyID(ctx context.Context, networkID, policyID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkQoSPolicyID", ctx, networkID, policyID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNetworkQoSPolicyID indicates an expected call of UpdateNetworkQoSPolicyID.
+func (mr *MockNetworkingMockRecorder) UpdateNetworkQoSPolicyID(ctx, networkID, policyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkQoSPolicyID", reflect.TypeOf((*MockNetworking)(nil).UpdateNetworkQoSPolicyID), ctx, networkID, policyID)
+}
+
 // UpdateRouter mocks base method.
 func (m *MockNetworking) UpdateRouter(ctx context.Context, routerID string, updateOpts routers.UpdateOpts) (*routers.Router, error) {
 	m.ctrl.T.Helper()
@@ -1261,3 +1817,371 @@ func (mr *MockSharedFilesystemMockRecorder) ListShareNetworks(ctx, listOpts any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListShareNetworks", reflect.TypeOf((*MockSharedFilesystem)(nil).ListShareNetworks), ctx, listOpts)
 }
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateContainerIfNotExists mocks base method.
+func (m *MockStorage) CreateContainerIfNotExists(ctx context.Context, container string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateContainerIfNotExists", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateContainerIfNotExists indicates an expected call of CreateContainerIfNotExists.
+func (mr *MockStorageMockRecorder) CreateContainerIfNotExists(ctx, container any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateContainerIfNotExists", reflect.TypeOf((*MockStorage)(nil).CreateContainerIfNotExists), ctx, container)
+}
+
+// CreateObject mocks base method.
+func (m *MockStorage) CreateObject(ctx context.Context, container, object string, data []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateObject", ctx, container, object, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateObject indicates an expected call of CreateObject.
+func (mr *MockStorageMockRecorder) CreateObject(ctx, container, object, data any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateObject", reflect.TypeOf((*MockStorage)(nil).CreateObject), ctx, container, object, data)
+}
+
+// CreateTempURL mocks base method.
+func (m *MockStorage) CreateTempURL(ctx context.Context, container, object string, expiresAt time.Time) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTempURL", ctx, container, object, expiresAt)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTempURL indicates an expected call of CreateTempURL.
+func (mr *MockStorageMockRecorder) CreateTempURL(ctx, container, object, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTempURL", reflect.TypeOf((*MockStorage)(nil).CreateTempURL), ctx, container, object, expiresAt)
+}
+
+// DeleteContainerIfExists mocks base method.
+func (m *MockStorage) DeleteContainerIfExists(ctx context.Context, container string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContainerIfExists", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContainerIfExists indicates an expected call of DeleteContainerIfExists.
+func (mr *MockStorageMockRecorder) DeleteContainerIfExists(ctx, container any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContainerIfExists", reflect.TypeOf((*MockStorage)(nil).DeleteContainerIfExists), ctx, container)
+}
+
+// DeleteObject mocks base method.
+func (m *MockStorage) DeleteObject(ctx context.Context, container, object string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", ctx, container, object)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockStorageMockRecorder) DeleteObject(ctx, container, object any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockStorage)(nil).DeleteObject), ctx, container, object)
+}
+
+// DeleteObjectsWithPrefix mocks base method.
+func (m *MockStorage) DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectsWithPrefix", ctx, container, prefix)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObjectsWithPrefix indicates an expected call of DeleteObjectsWithPrefix.
+func (mr *MockStorageMockRecorder) DeleteObjectsWithPrefix(ctx, container, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectsWithPrefix", reflect.TypeOf((*MockStorage)(nil).DeleteObjectsWithPrefix), ctx, container, prefix)
+}
+
+// EnsureContainerTempURLKey mocks base method.
+func (m *MockStorage) EnsureContainerTempURLKey(ctx context.Context, container string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnsureContainerTempURLKey", ctx, container)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnsureContainerTempURLKey indicates an expected call of EnsureContainerTempURLKey.
+func (mr *MockStorageMockRecorder) EnsureContainerTempURLKey(ctx, container any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnsureContainerTempURLKey", reflect.TypeOf((*MockStorage)(nil).EnsureContainerTempURLKey), ctx, container)
+}
+
+// ListObjectNames mocks base method.
+func (m *MockStorage) ListObjectNames(ctx context.Context, container string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectNames", ctx, container)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectNames indicates an expected call of ListObjectNames.
+func (mr *MockStorageMockRecorder) ListObjectNames(ctx, container any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectNames", reflect.TypeOf((*MockStorage)(nil).ListObjectNames), ctx, container)
+}
+
+// MockImages is a mock of Images interface.
+type MockImages struct {
+	ctrl     *gomock.Controller
+	recorder *MockImagesMockRecorder
+	isgomock struct{}
+}
+
+// MockImagesMockRecorder is the mock recorder for MockImages.
+type MockImagesMockRecorder struct {
+	mock *MockImages
+}
+
+// NewMockImages creates a new mock instance.
+func NewMockImages(ctrl *gomock.Controller) *MockImages {
+	mock := &MockImages{ctrl: ctrl}
+	mock.recorder = &MockImagesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImages) EXPECT() *MockImagesMockRecorder {
+	return m.recorder
+}
+
+// ListImages mocks base method.
+func (m *MockImages) ListImages(ctx context.Context, opts images.ListOpts) ([]images.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImages", ctx, opts)
+	ret0, _ := ret[0].([]images.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImages indicates an expected call of ListImages.
+func (mr *MockImagesMockRecorder) ListImages(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImages", reflect.TypeOf((*MockImages)(nil).ListImages), ctx, opts)
+}
+
+// MockBareMetal is a mock of BareMetal interface.
+type MockBareMetal struct {
+	ctrl     *gomock.Controller
+	recorder *MockBareMetalMockRecorder
+	isgomock struct{}
+}
+
+// MockBareMetalMockRecorder is the mock recorder for MockBareMetal.
+type MockBareMetalMockRecorder struct {
+	mock *MockBareMetal
+}
+
+// NewMockBareMetal creates a new mock instance.
+func NewMockBareMetal(ctrl *gomock.Controller) *MockBareMetal {
+	mock := &MockBareMetal{ctrl: ctrl}
+	mock.recorder = &MockBareMetalMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBareMetal) EXPECT() *MockBareMetalMockRecorder {
+	return m.recorder
+}
+
+// ListNodes mocks base method.
+func (m *MockBareMetal) ListNodes(ctx context.Context, opts nodes.ListOpts) ([]nodes.Node, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNodes", ctx, opts)
+	ret0, _ := ret[0].([]nodes.Node)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNodes indicates an expected call of ListNodes.
+func (mr *MockBareMetalMockRecorder) ListNodes(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNodes", reflect.TypeOf((*MockBareMetal)(nil).ListNodes), ctx, opts)
+}
+
+// MockBlockStorage is a mock of BlockStorage interface.
+type MockBlockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockBlockStorageMockRecorder is the mock recorder for MockBlockStorage.
+type MockBlockStorageMockRecorder struct {
+	mock *MockBlockStorage
+}
+
+// NewMockBlockStorage creates a new mock instance.
+func NewMockBlockStorage(ctrl *gomock.Controller) *MockBlockStorage {
+	mock := &MockBlockStorage{ctrl: ctrl}
+	mock.recorder = &MockBlockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockStorage) EXPECT() *MockBlockStorageMockRecorder {
+	return m.recorder
+}
+
+// GetVolumeTypeEncryption mocks base method.
+func (m *MockBlockStorage) GetVolumeTypeEncryption(ctx context.Context, id string) (*volumetypes.GetEncryptionType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeTypeEncryption", ctx, id)
+	ret0, _ := ret[0].(*volumetypes.GetEncryptionType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeTypeEncryption indicates an expected call of GetVolumeTypeEncryption.
+func (mr *MockBlockStorageMockRecorder) GetVolumeTypeEncryption(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeTypeEncryption", reflect.TypeOf((*MockBlockStorage)(nil).GetVolumeTypeEncryption), ctx, id)
+}
+
+// ListAvailabilityZones mocks base method.
+func (m *MockBlockStorage) ListAvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAvailabilityZones", ctx)
+	ret0, _ := ret[0].([]availabilityzones.AvailabilityZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAvailabilityZones indicates an expected call of ListAvailabilityZones.
+func (mr *MockBlockStorageMockRecorder) ListAvailabilityZones(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAvailabilityZones", reflect.TypeOf((*MockBlockStorage)(nil).ListAvailabilityZones), ctx)
+}
+
+// ListVolumeTypes mocks base method.
+func (m *MockBlockStorage) ListVolumeTypes(ctx context.Context, opts volumetypes.ListOpts) ([]volumetypes.VolumeType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumeTypes", ctx, opts)
+	ret0, _ := ret[0].([]volumetypes.VolumeType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumeTypes indicates an expected call of ListVolumeTypes.
+func (mr *MockBlockStorageMockRecorder) ListVolumeTypes(ctx, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumeTypes", reflect.TypeOf((*MockBlockStorage)(nil).ListVolumeTypes), ctx, opts)
+}
+
+// MockIdentity is a mock of Identity interface.
+type MockIdentity struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdentityMockRecorder
+	isgomock struct{}
+}
+
+// MockIdentityMockRecorder is the mock recorder for MockIdentity.
+type MockIdentityMockRecorder struct {
+	mock *MockIdentity
+}
+
+// NewMockIdentity creates a new mock instance.
+func NewMockIdentity(ctrl *gomock.Controller) *MockIdentity {
+	mock := &MockIdentity{ctrl: ctrl}
+	mock.recorder = &MockIdentityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdentity) EXPECT() *MockIdentityMockRecorder {
+	return m.recorder
+}
+
+// CreateApplicationCredential mocks base method.
+func (m *MockIdentity) CreateApplicationCredential(ctx context.Context, userID string, opts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApplicationCredential", ctx, userID, opts)
+	ret0, _ := ret[0].(*applicationcredentials.ApplicationCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApplicationCredential indicates an expected call of CreateApplicationCredential.
+func (mr *MockIdentityMockRecorder) CreateApplicationCredential(ctx, userID, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApplicationCredential", reflect.TypeOf((*MockIdentity)(nil).CreateApplicationCredential), ctx, userID, opts)
+}
+
+// DeleteApplicationCredential mocks base method.
+func (m *MockIdentity) DeleteApplicationCredential(ctx context.Context, userID, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApplicationCredential", ctx, userID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApplicationCredential indicates an expected call of DeleteApplicationCredential.
+func (mr *MockIdentityMockRecorder) DeleteApplicationCredential(ctx, userID, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApplicationCredential", reflect.TypeOf((*MockIdentity)(nil).DeleteApplicationCredential), ctx, userID, id)
+}
+
+// GetCurrentUserID mocks base method.
+func (m *MockIdentity) GetCurrentUserID(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrentUserID", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrentUserID indicates an expected call of GetCurrentUserID.
+func (mr *MockIdentityMockRecorder) GetCurrentUserID(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrentUserID", reflect.TypeOf((*MockIdentity)(nil).GetCurrentUserID), ctx)
+}
+
+// ListApplicationCredentials mocks base method.
+func (m *MockIdentity) ListApplicationCredentials(ctx context.Context, userID string) ([]applicationcredentials.ApplicationCredential, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApplicationCredentials", ctx, userID)
+	ret0, _ := ret[0].([]applicationcredentials.ApplicationCredential)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApplicationCredentials indicates an expected call of ListApplicationCredentials.
+func (mr *MockIdentityMockRecorder) ListApplicationCredentials(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApplicationCredentials", reflect.TypeOf((*MockIdentity)(nil).ListApplicationCredentials), ctx, userID)
+}
@@ -10,8 +10,14 @@ import (
 	"slices"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/external"
+	fwgroups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/groups"
+	fwpolicies "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/policies"
+	fwrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/rules"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/extraroutes"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	qosrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
@@ -26,6 +32,12 @@ type networkWithExternalExt struct {
 	external.NetworkExternalExt
 }
 
+// availabilityZonesExt holds the availability zones a resource is scheduled in. The field is provided
+// by the network and router availability zone extensions but not part of the gophercloud result types.
+type availabilityZonesExt struct {
+	AvailabilityZones []string `json:"availability_zones"`
+}
+
 // GetExternalNetworkNames returns a list of all external network names.
 func (c *NetworkingClient) GetExternalNetworkNames(ctx context.Context) ([]string, error) {
 	externalNetworks, err := c.listExternalNetworks(ctx, networks.ListOpts{})
@@ -100,6 +112,15 @@ func (c *NetworkingClient) GetNetworkByID(ctx context.Context, id string) (*netw
 	return network, IgnoreNotFoundError(err)
 }
 
+// GetNetworkAvailabilityZones returns the availability zones the network is scheduled in
+func (c *NetworkingClient) GetNetworkAvailabilityZones(ctx context.Context, id string) ([]string, error) {
+	var ext availabilityZonesExt
+	if err := networks.Get(ctx, c.client, id).ExtractIntoStructPtr(&ext, "network"); err != nil {
+		return nil, err
+	}
+	return ext.AvailabilityZones, nil
+}
+
 // CreateNetwork creates a network
 func (c *NetworkingClient) CreateNetwork(ctx context.Context, opts networks.CreateOpts) (*networks.Network, error) {
 	return networks.Create(ctx, c.client, opts).Extract()
@@ -208,6 +229,15 @@ func (c *NetworkingClient) GetRouterByID(ctx context.Context, id string) (*route
 	return router, IgnoreNotFoundError(err)
 }
 
+// GetRouterAvailabilityZones returns the availability zones the router is scheduled in
+func (c *NetworkingClient) GetRouterAvailabilityZones(ctx context.Context, id string) ([]string, error) {
+	var ext availabilityZonesExt
+	if err := routers.Get(ctx, c.client, id).ExtractIntoStructPtr(&ext, "router"); err != nil {
+		return nil, err
+	}
+	return ext.AvailabilityZones, nil
+}
+
 // GetSecurityGroup returns a security group info by id
 func (c *NetworkingClient) GetSecurityGroup(ctx context.Context, groupID string) (*groups.SecGroup, error) {
 	return groups.Get(ctx, c.client, groupID).Extract()
@@ -235,6 +265,22 @@ func (c *NetworkingClient) UpdateRoutesForRouter(ctx context.Context, routes []r
 	return routers.Update(ctx, c.client, routerID, updateOpts).Extract()
 }
 
+// AddRoutesToRouter adds the given routes to the router without touching its other routes
+func (c *NetworkingClient) AddRoutesToRouter(ctx context.Context, routes []routers.Route, routerID string) (*routers.Router, error) {
+	opts := extraroutes.Opts{
+		Routes: &routes,
+	}
+	return extraroutes.Add(ctx, c.client, routerID, opts).Extract()
+}
+
+// RemoveRoutesFromRouter removes the given routes from the router without touching its other routes
+func (c *NetworkingClient) RemoveRoutesFromRouter(ctx context.Context, routes []routers.Route, routerID string) (*routers.Router, error) {
+	opts := extraroutes.Opts{
+		Routes: &routes,
+	}
+	return extraroutes.Remove(ctx, c.client, routerID, opts).Extract()
+}
+
 // UpdateRouter updates router settings
 func (c *NetworkingClient) UpdateRouter(ctx context.Context, routerID string, updateOpts routers.UpdateOpts) (*routers.Router, error) {
 	return routers.Update(ctx, c.client, routerID, updateOpts).Extract()
@@ -361,3 +407,170 @@ func (c *NetworkingClient) UpdateFIPWithPort(ctx context.Context, fipID, portID
 	_, err := floatingips.Update(ctx, c.client, fipID, updateOpts).Extract()
 	return err
 }
+
+// ListQoSPolicies returns a list of QoS policies
+func (c *NetworkingClient) ListQoSPolicies(ctx context.Context, listOpts policies.ListOpts) ([]policies.Policy, error) {
+	pages, err := policies.List(c.client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return policies.ExtractPolicies(pages)
+}
+
+// GetQoSPolicy returns a QoS policy by id
+func (c *NetworkingClient) GetQoSPolicy(ctx context.Context, id string) (*policies.Policy, error) {
+	policy, err := policies.Get(ctx, c.client, id).Extract()
+	return policy, IgnoreNotFoundError(err)
+}
+
+// CreateQoSPolicy creates a QoS policy
+func (c *NetworkingClient) CreateQoSPolicy(ctx context.Context, createOpts policies.CreateOpts) (*policies.Policy, error) {
+	return policies.Create(ctx, c.client, createOpts).Extract()
+}
+
+// DeleteQoSPolicy deletes a QoS policy
+func (c *NetworkingClient) DeleteQoSPolicy(ctx context.Context, id string) error {
+	return policies.Delete(ctx, c.client, id).ExtractErr()
+}
+
+// ListBandwidthLimitRules returns the bandwidth limit rules of a QoS policy
+func (c *NetworkingClient) ListBandwidthLimitRules(ctx context.Context, policyID string) ([]qosrules.BandwidthLimitRule, error) {
+	pages, err := qosrules.ListBandwidthLimitRules(c.client, policyID, qosrules.BandwidthLimitRulesListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return qosrules.ExtractBandwidthLimitRules(pages)
+}
+
+// CreateBandwidthLimitRule creates a bandwidth limit rule in a QoS policy
+func (c *NetworkingClient) CreateBandwidthLimitRule(ctx context.Context, policyID string, createOpts qosrules.CreateBandwidthLimitRuleOpts) (*qosrules.BandwidthLimitRule, error) {
+	return qosrules.CreateBandwidthLimitRule(ctx, c.client, policyID, createOpts).ExtractBandwidthLimitRule()
+}
+
+// DeleteBandwidthLimitRule deletes a bandwidth limit rule of a QoS policy
+func (c *NetworkingClient) DeleteBandwidthLimitRule(ctx context.Context, policyID, ruleID string) error {
+	return qosrules.DeleteBandwidthLimitRule(ctx, c.client, policyID, ruleID).ExtractErr()
+}
+
+// ListMinimumBandwidthRules returns the minimum bandwidth rules of a QoS policy
+func (c *NetworkingClient) ListMinimumBandwidthRules(ctx context.Context, policyID string) ([]qosrules.MinimumBandwidthRule, error) {
+	pages, err := qosrules.ListMinimumBandwidthRules(c.client, policyID, qosrules.MinimumBandwidthRulesListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return qosrules.ExtractMinimumBandwidthRules(pages)
+}
+
+// CreateMinimumBandwidthRule creates a minimum bandwidth rule in a QoS policy
+func (c *NetworkingClient) CreateMinimumBandwidthRule(ctx context.Context, policyID string, createOpts qosrules.CreateMinimumBandwidthRuleOpts) (*qosrules.MinimumBandwidthRule, error) {
+	return qosrules.CreateMinimumBandwidthRule(ctx, c.client, policyID, createOpts).ExtractMinimumBandwidthRule()
+}
+
+// DeleteMinimumBandwidthRule deletes a minimum bandwidth rule of a QoS policy
+func (c *NetworkingClient) DeleteMinimumBandwidthRule(ctx context.Context, policyID, ruleID string) error {
+	return qosrules.DeleteMinimumBandwidthRule(ctx, c.client, policyID, ruleID).ExtractErr()
+}
+
+// ListDSCPMarkingRules returns the DSCP marking rules of a QoS policy
+func (c *NetworkingClient) ListDSCPMarkingRules(ctx context.Context, policyID string) ([]qosrules.DSCPMarkingRule, error) {
+	pages, err := qosrules.ListDSCPMarkingRules(c.client, policyID, qosrules.DSCPMarkingRulesListOpts{}).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return qosrules.ExtractDSCPMarkingRules(pages)
+}
+
+// CreateDSCPMarkingRule creates a DSCP marking rule in a QoS policy
+func (c *NetworkingClient) CreateDSCPMarkingRule(ctx context.Context, policyID string, createOpts qosrules.CreateDSCPMarkingRuleOpts) (*qosrules.DSCPMarkingRule, error) {
+	return qosrules.CreateDSCPMarkingRule(ctx, c.client, policyID, createOpts).ExtractDSCPMarkingRule()
+}
+
+// DeleteDSCPMarkingRule deletes a DSCP marking rule of a QoS policy
+func (c *NetworkingClient) DeleteDSCPMarkingRule(ctx context.Context, policyID, ruleID string) error {
+	return qosrules.DeleteDSCPMarkingRule(ctx, c.client, policyID, ruleID).ExtractErr()
+}
+
+// GetNetworkQoSPolicyID returns the id of the QoS policy attached to the network
+func (c *NetworkingClient) GetNetworkQoSPolicyID(ctx context.Context, networkID string) (string, error) {
+	var ext policies.QoSPolicyExt
+	if err := networks.Get(ctx, c.client, networkID).ExtractIntoStructPtr(&ext, "network"); err != nil {
+		return "", err
+	}
+	return ext.QoSPolicyID, nil
+}
+
+// UpdateNetworkQoSPolicyID attaches the QoS policy to the network. An empty policy id detaches the current policy.
+func (c *NetworkingClient) UpdateNetworkQoSPolicyID(ctx context.Context, networkID, policyID string) error {
+	updateOpts := policies.NetworkUpdateOptsExt{
+		UpdateOptsBuilder: networks.UpdateOpts{},
+		QoSPolicyID:       &policyID,
+	}
+	return networks.Update(ctx, c.client, networkID, updateOpts).Err
+}
+
+// ListFirewallGroups returns a list of firewall groups
+func (c *NetworkingClient) ListFirewallGroups(ctx context.Context, listOpts fwgroups.ListOpts) ([]fwgroups.Group, error) {
+	pages, err := fwgroups.List(c.client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fwgroups.ExtractGroups(pages)
+}
+
+// CreateFirewallGroup creates a firewall group
+func (c *NetworkingClient) CreateFirewallGroup(ctx context.Context, createOpts fwgroups.CreateOpts) (*fwgroups.Group, error) {
+	return fwgroups.Create(ctx, c.client, createOpts).Extract()
+}
+
+// UpdateFirewallGroup updates a firewall group
+func (c *NetworkingClient) UpdateFirewallGroup(ctx context.Context, id string, updateOpts fwgroups.UpdateOpts) (*fwgroups.Group, error) {
+	return fwgroups.Update(ctx, c.client, id, updateOpts).Extract()
+}
+
+// DeleteFirewallGroup deletes a firewall group
+func (c *NetworkingClient) DeleteFirewallGroup(ctx context.Context, id string) error {
+	return fwgroups.Delete(ctx, c.client, id).ExtractErr()
+}
+
+// ListFirewallPolicies returns a list of firewall policies
+func (c *NetworkingClient) ListFirewallPolicies(ctx context.Context, listOpts fwpolicies.ListOpts) ([]fwpolicies.Policy, error) {
+	pages, err := fwpolicies.List(c.client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fwpolicies.ExtractPolicies(pages)
+}
+
+// CreateFirewallPolicy creates a firewall policy
+func (c *NetworkingClient) CreateFirewallPolicy(ctx context.Context, createOpts fwpolicies.CreateOpts) (*fwpolicies.Policy, error) {
+	return fwpolicies.Create(ctx, c.client, createOpts).Extract()
+}
+
+// UpdateFirewallPolicy updates a firewall policy
+func (c *NetworkingClient) UpdateFirewallPolicy(ctx context.Context, id string, updateOpts fwpolicies.UpdateOpts) (*fwpolicies.Policy, error) {
+	return fwpolicies.Update(ctx, c.client, id, updateOpts).Extract()
+}
+
+// DeleteFirewallPolicy deletes a firewall policy
+func (c *NetworkingClient) DeleteFirewallPolicy(ctx context.Context, id string) error {
+	return fwpolicies.Delete(ctx, c.client, id).ExtractErr()
+}
+
+// ListFirewallRules returns a list of firewall rules
+func (c *NetworkingClient) ListFirewallRules(ctx context.Context, listOpts fwrules.ListOpts) ([]fwrules.Rule, error) {
+	pages, err := fwrules.List(c.client, listOpts).AllPages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return fwrules.ExtractRules(pages)
+}
+
+// CreateFirewallRule creates a firewall rule
+func (c *NetworkingClient) CreateFirewallRule(ctx context.Context, createOpts fwrules.CreateOpts) (*fwrules.Rule, error) {
+	return fwrules.Create(ctx, c.client, createOpts).Extract()
+}
+
+// DeleteFirewallRule deletes a firewall rule
+func (c *NetworkingClient) DeleteFirewallRule(ctx context.Context, id string) error {
+	return fwrules.Delete(ctx, c.client, id).ExtractErr()
+}
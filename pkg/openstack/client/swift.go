@@ -5,10 +5,14 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/objectstorage/v1/containers"
@@ -88,3 +92,61 @@ func (s *StorageClient) DeleteContainerIfExists(ctx context.Context, container s
 	}
 	return nil
 }
+
+// EnsureContainerTempURLKey sets a random temp URL key on the container <container> if it does not have one yet, so
+// that temporary URLs can be created for its objects.
+func (s *StorageClient) EnsureContainerTempURLKey(ctx context.Context, container string) error {
+	header, err := containers.Get(ctx, s.client, container, nil).Extract()
+	if err != nil {
+		return err
+	}
+	if header.TempURLKey != "" {
+		return nil
+	}
+
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+	_, err = containers.Update(ctx, s.client, container, containers.UpdateOpts{TempURLKey: hex.EncodeToString(key)}).Extract()
+	return err
+}
+
+// CreateObject creates or replaces the object <object> in <container> with the given data.
+func (s *StorageClient) CreateObject(ctx context.Context, container, object string, data []byte) error {
+	_, err := objects.Create(ctx, s.client, container, object, objects.CreateOpts{Content: bytes.NewReader(data)}).Extract()
+	return err
+}
+
+// DeleteObject deletes the object <object> from <container>. If it does not exist, no error is returned.
+func (s *StorageClient) DeleteObject(ctx context.Context, container, object string) error {
+	_, err := objects.Delete(ctx, s.client, container, object, nil).Extract()
+	if IsNotFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+// ListObjectNames returns the names of all objects in <container>. If the container does not exist, no error is returned.
+func (s *StorageClient) ListObjectNames(ctx context.Context, container string) ([]string, error) {
+	allPages, err := objects.List(s.client, container, nil).AllPages(ctx)
+	if IsNotFoundError(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return objects.ExtractNames(allPages)
+}
+
+// CreateTempURL creates a temporary URL which allows to download the object <object> from <container> until <expiresAt>
+// without authentication. It requires a temp URL key on the container.
+func (s *StorageClient) CreateTempURL(ctx context.Context, container, object string, expiresAt time.Time) (string, error) {
+	now := time.Now().Truncate(time.Second)
+	return objects.CreateTempURL(ctx, s.client, container, object, objects.CreateTempURLOpts{
+		Method:    objects.GET,
+		TTL:       int(expiresAt.Sub(now).Seconds()),
+		Timestamp: now,
+		Digest:    "sha256",
+	})
+}
@@ -2,20 +2,31 @@
 //
 // SPDX-License-Identifier: Apache-2.0
 
-//go:generate mockgen -destination=mocks/client_mocks.go -package=mocks . Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem
+//go:generate mockgen -destination=mocks/client_mocks.go -package=mocks . Factory,FactoryFactory,Compute,DNS,Networking,Loadbalancing,SharedFilesystem,Storage,Images,BareMetal,BlockStorage,Identity
 package client
 
 import (
 	"context"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/baremetal/v1/nodes"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/availabilityzones"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumetypes"
+	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/flavors"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/keypairs"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servergroups"
 	"github.com/gophercloud/gophercloud/v2/openstack/compute/v2/servers"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
 	"github.com/gophercloud/gophercloud/v2/openstack/image/v2/images"
 	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	fwgroups "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/groups"
+	fwpolicies "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/policies"
+	fwrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/fwaas_v2/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/floatingips"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/layer3/routers"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/policies"
+	qosrules "github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/qos/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/subnetpools"
@@ -62,6 +73,21 @@ type SharedFilesystemClient struct {
 	client *gophercloud.ServiceClient
 }
 
+// BareMetalClient is a client for the Ironic service.
+type BareMetalClient struct {
+	client *gophercloud.ServiceClient
+}
+
+// BlockStorageClient is a client for the Cinder service.
+type BlockStorageClient struct {
+	client *gophercloud.ServiceClient
+}
+
+// IdentityClient is a client for the Keystone service.
+type IdentityClient struct {
+	client *gophercloud.ServiceClient
+}
+
 // ImageClient is a client for images
 type ImageClient struct {
 	client *gophercloud.ServiceClient
@@ -79,6 +105,9 @@ type Factory interface {
 	Loadbalancing(options ...Option) (Loadbalancing, error)
 	SharedFilesystem(options ...Option) (SharedFilesystem, error)
 	Images(options ...Option) (Images, error)
+	BareMetal(options ...Option) (BareMetal, error)
+	BlockStorage(options ...Option) (BlockStorage, error)
+	Identity(options ...Option) (Identity, error)
 }
 
 // Storage describes the operations of a client interacting with OpenStack's ObjectStorage service.
@@ -86,11 +115,17 @@ type Storage interface {
 	DeleteObjectsWithPrefix(ctx context.Context, container, prefix string) error
 	CreateContainerIfNotExists(ctx context.Context, container string) error
 	DeleteContainerIfExists(ctx context.Context, container string) error
+	EnsureContainerTempURLKey(ctx context.Context, container string) error
+	CreateObject(ctx context.Context, container, object string, data []byte) error
+	DeleteObject(ctx context.Context, container, object string) error
+	ListObjectNames(ctx context.Context, container string) ([]string, error)
+	CreateTempURL(ctx context.Context, container, object string, expiresAt time.Time) (string, error)
 }
 
 // Compute describes the operations of a client interacting with OpenStack's Compute service.
 type Compute interface {
 	CreateServerGroup(ctx context.Context, name, policy string) (*servergroups.ServerGroup, error)
+	CreateServerGroupWithRules(ctx context.Context, name, policy string, rules servergroups.Rules) (*servergroups.ServerGroup, error)
 	GetServerGroup(ctx context.Context, id string) (*servergroups.ServerGroup, error)
 	DeleteServerGroup(ctx context.Context, id string) error
 	// Server
@@ -101,6 +136,8 @@ type Compute interface {
 
 	// Flavor
 	FindFlavorID(ctx context.Context, name string) (string, error)
+	ListFlavors(ctx context.Context) ([]flavors.Flavor, error)
+	ListFlavorExtraSpecs(ctx context.Context, id string) (map[string]string, error)
 
 	// KeyPairs
 	CreateKeyPair(ctx context.Context, name, publicKey string) (*keypairs.KeyPair, error)
@@ -126,6 +163,7 @@ type Networking interface {
 	UpdateNetwork(ctx context.Context, networkID string, opts networks.UpdateOpts) (*networks.Network, error)
 	GetNetworkByName(ctx context.Context, name string) ([]networks.Network, error)
 	GetNetworkByID(ctx context.Context, id string) (*networks.Network, error)
+	GetNetworkAvailabilityZones(ctx context.Context, id string) ([]string, error)
 	DeleteNetwork(ctx context.Context, networkID string) error
 	// FloatingIP
 	CreateFloatingIP(ctx context.Context, createOpts floatingips.CreateOpts) (*floatingips.FloatingIP, error)
@@ -145,8 +183,11 @@ type Networking interface {
 	DeleteRule(ctx context.Context, ruleID string) error
 	// Routers
 	GetRouterByID(ctx context.Context, id string) (*routers.Router, error)
+	GetRouterAvailabilityZones(ctx context.Context, id string) ([]string, error)
 	ListRouters(ctx context.Context, listOpts routers.ListOpts) ([]routers.Router, error)
 	UpdateRoutesForRouter(ctx context.Context, routes []routers.Route, routerID string) (*routers.Router, error)
+	AddRoutesToRouter(ctx context.Context, routes []routers.Route, routerID string) (*routers.Router, error)
+	RemoveRoutesFromRouter(ctx context.Context, routes []routers.Route, routerID string) (*routers.Router, error)
 	UpdateRouter(ctx context.Context, routerID string, updateOpts routers.UpdateOpts) (*routers.Router, error)
 	CreateRouter(ctx context.Context, createOpts routers.CreateOpts) (*routers.Router, error)
 	DeleteRouter(ctx context.Context, routerID string) error
@@ -167,6 +208,34 @@ type Networking interface {
 	GetRouterInterfacePort(ctx context.Context, routerID, subnetID string) (*ports.Port, error)
 	GetInstancePorts(ctx context.Context, instanceID string) ([]ports.Port, error)
 	UpdateFIPWithPort(ctx context.Context, fipID, portID string) error
+	// QoS
+	ListQoSPolicies(ctx context.Context, listOpts policies.ListOpts) ([]policies.Policy, error)
+	GetQoSPolicy(ctx context.Context, id string) (*policies.Policy, error)
+	CreateQoSPolicy(ctx context.Context, createOpts policies.CreateOpts) (*policies.Policy, error)
+	DeleteQoSPolicy(ctx context.Context, id string) error
+	ListBandwidthLimitRules(ctx context.Context, policyID string) ([]qosrules.BandwidthLimitRule, error)
+	CreateBandwidthLimitRule(ctx context.Context, policyID string, createOpts qosrules.CreateBandwidthLimitRuleOpts) (*qosrules.BandwidthLimitRule, error)
+	DeleteBandwidthLimitRule(ctx context.Context, policyID, ruleID string) error
+	ListMinimumBandwidthRules(ctx context.Context, policyID string) ([]qosrules.MinimumBandwidthRule, error)
+	CreateMinimumBandwidthRule(ctx context.Context, policyID string, createOpts qosrules.CreateMinimumBandwidthRuleOpts) (*qosrules.MinimumBandwidthRule, error)
+	DeleteMinimumBandwidthRule(ctx context.Context, policyID, ruleID string) error
+	ListDSCPMarkingRules(ctx context.Context, policyID string) ([]qosrules.DSCPMarkingRule, error)
+	CreateDSCPMarkingRule(ctx context.Context, policyID string, createOpts qosrules.CreateDSCPMarkingRuleOpts) (*qosrules.DSCPMarkingRule, error)
+	DeleteDSCPMarkingRule(ctx context.Context, policyID, ruleID string) error
+	GetNetworkQoSPolicyID(ctx context.Context, networkID string) (string, error)
+	UpdateNetworkQoSPolicyID(ctx context.Context, networkID, policyID string) error
+	// Firewall
+	ListFirewallGroups(ctx context.Context, listOpts fwgroups.ListOpts) ([]fwgroups.Group, error)
+	CreateFirewallGroup(ctx context.Context, createOpts fwgroups.CreateOpts) (*fwgroups.Group, error)
+	UpdateFirewallGroup(ctx context.Context, id string, updateOpts fwgroups.UpdateOpts) (*fwgroups.Group, error)
+	DeleteFirewallGroup(ctx context.Context, id string) error
+	ListFirewallPolicies(ctx context.Context, listOpts fwpolicies.ListOpts) ([]fwpolicies.Policy, error)
+	CreateFirewallPolicy(ctx context.Context, createOpts fwpolicies.CreateOpts) (*fwpolicies.Policy, error)
+	UpdateFirewallPolicy(ctx context.Context, id string, updateOpts fwpolicies.UpdateOpts) (*fwpolicies.Policy, error)
+	DeleteFirewallPolicy(ctx context.Context, id string) error
+	ListFirewallRules(ctx context.Context, listOpts fwrules.ListOpts) ([]fwrules.Rule, error)
+	CreateFirewallRule(ctx context.Context, createOpts fwrules.CreateOpts) (*fwrules.Rule, error)
+	DeleteFirewallRule(ctx context.Context, id string) error
 }
 
 // Loadbalancing describes the operations of a client interacting with OpenStack's Octavia service.
@@ -196,6 +265,27 @@ type Images interface {
 	ListImages(ctx context.Context, opts images.ListOpts) ([]images.Image, error)
 }
 
+// BareMetal describes the operations of a client interacting with OpenStack's Ironic service.
+type BareMetal interface {
+	ListNodes(ctx context.Context, opts nodes.ListOpts) ([]nodes.Node, error)
+}
+
+// BlockStorage describes the operations of a client interacting with OpenStack's Cinder service.
+type BlockStorage interface {
+	ListVolumeTypes(ctx context.Context, opts volumetypes.ListOpts) ([]volumetypes.VolumeType, error)
+	GetVolumeTypeEncryption(ctx context.Context, id string) (*volumetypes.GetEncryptionType, error)
+	ListAvailabilityZones(ctx context.Context) ([]availabilityzones.AvailabilityZone, error)
+}
+
+// Identity describes the operations of a client interacting with OpenStack's Keystone service.
+type Identity interface {
+	GetCurrentUserID(ctx context.Context) (string, error)
+	// Application Credentials
+	ListApplicationCredentials(ctx context.Context, userID string) ([]applicationcredentials.ApplicationCredential, error)
+	CreateApplicationCredential(ctx context.Context, userID string, opts applicationcredentials.CreateOpts) (*applicationcredentials.ApplicationCredential, error)
+	DeleteApplicationCredential(ctx context.Context, userID, id string) error
+}
+
 // FactoryFactoryFunc is a function that implements FactoryFactory.
 type FactoryFactoryFunc func(ctx context.Context, credentials *openstack.Credentials) (Factory, error)
 
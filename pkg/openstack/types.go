@@ -12,6 +12,8 @@ const (
 	// Name is the name of the OpenStack provider.
 	Name = "provider-openstack"
 
+	// BarbicanKMSPluginImageName is the name of the barbican-kms-plugin image.
+	BarbicanKMSPluginImageName = "barbican-kms-plugin"
 	// CloudControllerManagerImageName is the name of the cloud-controller-manager image.
 	CloudControllerManagerImageName = "cloud-controller-manager"
 	// CSIDriverCinderImageName is the name of the csi-driver-cinder image.
@@ -20,6 +22,8 @@ const (
 	CSIDriverManilaImageName = "csi-driver-manila"
 	// CSIDriverNFSImageName is the name of the csi-driver-nfs image.
 	CSIDriverNFSImageName = "csi-driver-nfs"
+	// CSIDriverCephFSImageName is the name of the csi-driver-cephfs image.
+	CSIDriverCephFSImageName = "csi-driver-cephfs"
 	// CSIProvisionerImageName is the name of the csi-provisioner image.
 	CSIProvisionerImageName = "csi-provisioner"
 	// CSIAttacherImageName is the name of the csi-attacher image.
@@ -40,6 +44,8 @@ const (
 	CSILivenessProbeImageName = "csi-liveness-probe"
 	// CSISnapshotControllerImageName is the name of the csi-snapshot-controller image.
 	CSISnapshotControllerImageName = "csi-snapshot-controller"
+	// K8sKeystoneAuthImageName is the name of the k8s-keystone-auth image.
+	K8sKeystoneAuthImageName = "k8s-keystone-auth"
 	// MachineControllerManagerProviderOpenStackImageName is the name of the MachineControllerManager OpenStack image.
 	MachineControllerManagerProviderOpenStackImageName = "machine-controller-manager-provider-openstack"
 
@@ -105,12 +111,44 @@ const (
 	CSINodeName = "csi-driver-node"
 	// CSIManilaNodeName is a constant for the chart name for a CSI Manila node deployment in the shoot.
 	CSIManilaNodeName = "csi-driver-manila-node"
+	// CSIManilaCephFSNodeName is a constant for the chart name for a CSI Manila CephFS node deployment in the shoot.
+	CSIManilaCephFSNodeName = "csi-driver-manila-cephfs-node"
 	// CSINFSNodeName is a constant for the chart name for a CSI NFS node deployment in the shoot.
 	CSINFSNodeName = "csi-driver-nfs-node"
 	// CSIDriverManila is a constant for the chart name for the CSI driver Manila deployment in the shoot.
 	CSIDriverManila = "csi-driver-manila"
 	// CSIDriverManilaController is a constant for the chart name for the CSI driver Manila / NFS controller deployment in the seed.
 	CSIDriverManilaController = "csi-driver-manila-controller"
+	// BarbicanKMSPluginName is a constant for the chart name for the Barbican KMS plugin deployment in the seed.
+	BarbicanKMSPluginName = "barbican-kms-plugin"
+	// BarbicanKMSPluginConfigName is the name of the secret containing the config of the Barbican KMS plugin.
+	BarbicanKMSPluginConfigName = "barbican-kms-plugin-config"
+	// BarbicanKMSPluginConfigMountPath is the path at which the config of the Barbican KMS plugin is mounted.
+	BarbicanKMSPluginConfigMountPath = "/etc/kubernetes/cloudprovider"
+	// BarbicanKMSPluginCACertDataKey is the key in the config secret of the Barbican KMS plugin which holds the CA
+	// certificate of Keystone.
+	BarbicanKMSPluginCACertDataKey = "keystone-ca.crt"
+	// BarbicanKMSPluginSocketMountPath is the path at which the socket of the Barbican KMS plugin is mounted.
+	BarbicanKMSPluginSocketMountPath = "/var/run/barbican-kms-plugin"
+	// BarbicanKMSPluginSocketName is the name of the socket on which the Barbican KMS plugin serves the kube-apiserver.
+	BarbicanKMSPluginSocketName = "kms.sock"
+	// EtcdEncryptionConfigVolumeName is the name of the volume of the kube-apiserver containing the etcd encryption
+	// configuration created by gardenlet.
+	EtcdEncryptionConfigVolumeName = "etcd-encryption-secret"
+	// EtcdEncryptionConfigDataKey is the key in an etcd encryption configuration secret which holds the configuration.
+	EtcdEncryptionConfigDataKey = "encryption-configuration.yaml"
+	// EtcdEncryptionConfigKMSSecretName is the name of the secrets containing the etcd encryption configuration with the
+	// Barbican KMS plugin.
+	EtcdEncryptionConfigKMSSecretName = "kube-apiserver-etcd-encryption-configuration-kms"
+	// LabelEtcdEncryptionConfig is the label of an etcd encryption configuration with the Barbican KMS plugin whose
+	// value is the name of the etcd encryption configuration secret of gardenlet it was derived from.
+	LabelEtcdEncryptionConfig = "openstack.provider.extensions.gardener.cloud/etcd-encryption-configuration"
+	// K8sKeystoneAuthName is a constant for the chart name for the Keystone authentication and authorization webhook deployment in the seed.
+	K8sKeystoneAuthName = "k8s-keystone-auth"
+	// K8sKeystoneAuthWebhookKubeconfigName is the name of the secret containing the kubeconfig used by the kube-apiserver to call k8s-keystone-auth.
+	K8sKeystoneAuthWebhookKubeconfigName = "k8s-keystone-auth-webhook-kubeconfig" // #nosec G101 -- No credential.
+	// CAControlPlaneName is the name of the CA which signs the server certificates of the control plane components.
+	CAControlPlaneName = "ca-" + Name + "-controlplane"
 	// CSIDriverName is a constant for the name of the csi-driver component.
 	CSIDriverName = "csi-driver"
 	// CSIProvisionerName is a constant for the name of the csi-provisioner component.
@@ -129,6 +167,10 @@ const (
 	CSIManilaStorageProvisionerNFS = "nfs.manila.csi.openstack.org"
 	// CSIManilaNFS is a constant for CSI Manila NFS resource objects
 	CSIManilaNFS = "csi-manila-nfs"
+	// CSIManilaStorageProvisionerCephFS is a constant with the storage provisioner name which is used in storageclasses for Manila CephFS.
+	CSIManilaStorageProvisionerCephFS = "cephfs.manila.csi.openstack.org"
+	// CSIManilaCephFS is a constant for CSI Manila CephFS resource objects
+	CSIManilaCephFS = "csi-manila-cephfs"
 	// CSIManilaSecret is a constant for additional role/rolebiding for CSI manila plugin secret
 	CSIManilaSecret = "csi-manila-secret" // #nosec G101 -- No credential.
 
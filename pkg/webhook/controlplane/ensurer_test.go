@@ -6,6 +6,7 @@ package controlplane
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"strings"
 	"testing"
@@ -20,6 +21,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	testutils "github.com/gardener/gardener/pkg/utils/test"
 	. "github.com/onsi/ginkgo/v2"
@@ -32,8 +34,11 @@ import (
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/v1alpha1"
 )
 
 const namespace = "test"
@@ -83,6 +88,7 @@ var _ = Describe("Ensurer", func() {
 		ctx = context.TODO()
 
 		ctrl *gomock.Controller
+		c    client.Client
 
 		ensurer genericmutator.Ensurer
 
@@ -125,7 +131,8 @@ var _ = Describe("Ensurer", func() {
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
-		ensurer = NewEnsurer(logger)
+		c = fakeclient.NewClientBuilder().Build()
+		ensurer = NewEnsurer(c, logger)
 	})
 
 	AfterEach(func() {
@@ -185,6 +192,293 @@ var _ = Describe("Ensurer", func() {
 
 			checkKubeAPIServerDeployment(dep)
 		})
+
+		Context("kms", func() {
+			var (
+				eContextWithKMS        gcontext.GardenContext
+				encryptionConfigSecret *corev1.Secret
+			)
+
+			BeforeEach(func() {
+				eContextWithKMS = gcontext.NewInternalGardenContext(
+					&extensionscontroller.Cluster{
+						Shoot: &gardencorev1beta1.Shoot{
+							Spec: gardencorev1beta1.ShootSpec{
+								Kubernetes: gardencorev1beta1.Kubernetes{
+									Version: "1.32.0",
+								},
+								Region: "eu-1",
+								Provider: gardencorev1beta1.Provider{
+									ControlPlaneConfig: &runtime.RawExtension{
+										Raw: encode(&v1alpha1.ControlPlaneConfig{
+											TypeMeta: metav1.TypeMeta{
+												APIVersion: v1alpha1.SchemeGroupVersion.String(),
+												Kind:       "ControlPlaneConfig",
+											},
+											KMS: &v1alpha1.KMS{KeyID: "123e4567-e89b-12d3-a456-426614174000"},
+										}),
+									},
+								},
+							},
+						},
+					},
+				)
+
+				encryptionConfigSecret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "kube-apiserver-etcd-encryption-configuration-abcd1234"},
+					Data: map[string][]byte{"encryption-configuration.yaml": []byte(`apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - aescbc:
+      keys:
+      - name: key-1
+        secret: c2VjcmV0
+  - identity: {}
+  resources:
+  - secrets
+`)},
+				}
+				Expect(c.Create(ctx, encryptionConfigSecret)).To(Succeed())
+
+				dep.Spec.Template.Spec.Containers[0].Args = []string{"--encryption-provider-config=/etc/kubernetes/etcd-encryption-secret/encryption-configuration.yaml"}
+				dep.Spec.Template.Spec.Volumes = []corev1.Volume{{
+					Name: "etcd-encryption-secret",
+					VolumeSource: corev1.VolumeSource{
+						Secret: &corev1.SecretVolumeSource{SecretName: encryptionConfigSecret.Name},
+					},
+				}}
+
+				DeferCleanup(testutils.WithVar(&ImageVector, imagevector.ImageVector{{
+					Name:       "barbican-kms-plugin",
+					Repository: ptr.To("foo"),
+					Tag:        ptr.To("bar"),
+				}}))
+			})
+
+			It("should not wire the kms plugin as long as its config does not exist", func() {
+				Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKMS, dep, nil)).To(Succeed())
+
+				Expect(dep.Spec.Template.Spec.Containers).To(HaveLen(1))
+				Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ConsistOf("--encryption-provider-config=/etc/kubernetes/etcd-encryption-secret/encryption-configuration.yaml"))
+			})
+
+			It("should fail if the kms plugin config does not exist but the kms plugin was wired before", func() {
+				oldDep := dep.DeepCopy()
+				oldDep.Spec.Template.Spec.Volumes = append(oldDep.Spec.Template.Spec.Volumes, corev1.Volume{Name: "etcd-encryption-secret-kms"})
+
+				Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKMS, dep, oldDep)).To(MatchError(ContainSubstring("failed reading Barbican KMS plugin config")))
+			})
+
+			Context("with kms plugin config", func() {
+				BeforeEach(func() {
+					Expect(c.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "barbican-kms-plugin-config"},
+						Data:       map[string][]byte{"cloudprovider.conf": []byte("[Global]")},
+					})).To(Succeed())
+				})
+
+				It("should not wire the kms plugin as long as the etcd encryption configuration with the plugin does not exist", func() {
+					Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKMS, dep, nil)).To(Succeed())
+
+					Expect(dep.Spec.Template.Spec.Containers).To(HaveLen(1))
+					Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ConsistOf("--encryption-provider-config=/etc/kubernetes/etcd-encryption-secret/encryption-configuration.yaml"))
+				})
+
+				It("should add the barbican kms plugin to the kube-apiserver deployment", func() {
+					Expect(c.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: namespace,
+							Name:      "kube-apiserver-etcd-encryption-configuration-kms-1234abcd",
+							Labels:    map[string]string{"openstack.provider.extensions.gardener.cloud/etcd-encryption-configuration": encryptionConfigSecret.Name},
+						},
+					})).To(Succeed())
+
+					Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKMS, dep, nil)).To(Succeed())
+
+					ps := dep.Spec.Template.Spec
+					Expect(ps.Containers).To(HaveLen(2))
+					Expect(ps.Containers[0].Args).To(ConsistOf("--encryption-provider-config=/etc/kubernetes/etcd-encryption-secret-kms/encryption-configuration.yaml"))
+					Expect(ps.Containers[0].VolumeMounts).To(ConsistOf(
+						corev1.VolumeMount{Name: "etcd-encryption-secret-kms", MountPath: "/etc/kubernetes/etcd-encryption-secret-kms", ReadOnly: true},
+						corev1.VolumeMount{Name: "barbican-kms-plugin-socket", MountPath: "/var/run/barbican-kms-plugin"},
+					))
+					Expect(ps.Containers[1].Name).To(Equal("barbican-kms-plugin"))
+					Expect(ps.Containers[1].Image).To(Equal("foo:bar"))
+					Expect(ps.Containers[1].Args).To(ConsistOf(
+						"--socketpath=/var/run/barbican-kms-plugin/kms.sock",
+						"--cloud-config=/etc/kubernetes/cloudprovider/cloudprovider.conf",
+					))
+					Expect(dep.Spec.Template.Annotations).To(HaveKey("checksum/secret-barbican-kms-plugin-config"))
+
+					Expect(ps.Volumes).To(HaveLen(4))
+					kmsVolume := ps.Volumes[1]
+					Expect(kmsVolume.Name).To(Equal("etcd-encryption-secret-kms"))
+					Expect(kmsVolume.Secret.SecretName).To(Equal("kube-apiserver-etcd-encryption-configuration-kms-1234abcd"))
+					Expect(ps.Volumes[2].Name).To(Equal("barbican-kms-plugin-socket"))
+					Expect(ps.Volumes[3].Secret.SecretName).To(Equal("barbican-kms-plugin-config"))
+					Expect(dep.Spec.Template.Annotations).To(HaveKey(references.AnnotationKey(references.KindSecret, kmsVolume.Secret.SecretName)))
+				})
+
+				It("should keep the etcd encryption configuration of the old deployment as long as the new one does not exist", func() {
+					oldDep := dep.DeepCopy()
+					oldDep.Spec.Template.Spec.Volumes = append(oldDep.Spec.Template.Spec.Volumes, corev1.Volume{
+						Name: "etcd-encryption-secret-kms",
+						VolumeSource: corev1.VolumeSource{
+							Secret: &corev1.SecretVolumeSource{SecretName: "kube-apiserver-etcd-encryption-configuration-kms-old"},
+						},
+					})
+
+					Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKMS, dep, oldDep)).To(Succeed())
+
+					Expect(dep.Spec.Template.Spec.Containers).To(HaveLen(2))
+					Expect(dep.Spec.Template.Spec.Volumes).To(ContainElement(HaveField("VolumeSource.Secret.SecretName", "kube-apiserver-etcd-encryption-configuration-kms-old")))
+				})
+			})
+		})
+
+		Context("keystone auth", func() {
+			var (
+				eContextWithKeystoneAuth gcontext.GardenContext
+				authorizationConfig      *corev1.ConfigMap
+				caBundleSecret           *corev1.Secret
+			)
+
+			BeforeEach(func() {
+				eContextWithKeystoneAuth = gcontext.NewInternalGardenContext(
+					&extensionscontroller.Cluster{
+						Shoot: &gardencorev1beta1.Shoot{
+							Spec: gardencorev1beta1.ShootSpec{
+								Kubernetes: gardencorev1beta1.Kubernetes{
+									Version: "1.32.0",
+								},
+								Provider: gardencorev1beta1.Provider{
+									ControlPlaneConfig: &runtime.RawExtension{
+										Raw: encode(&v1alpha1.ControlPlaneConfig{
+											TypeMeta: metav1.TypeMeta{
+												APIVersion: v1alpha1.SchemeGroupVersion.String(),
+												Kind:       "ControlPlaneConfig",
+											},
+											KeystoneAuth: &v1alpha1.KeystoneAuth{},
+										}),
+									},
+								},
+							},
+						},
+					},
+				)
+
+				authorizationConfig = &corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "kube-apiserver-authorization-config-abcd1234"},
+					Data: map[string]string{"config.yaml": `apiVersion: apiserver.config.k8s.io/v1beta1
+kind: AuthorizationConfiguration
+authorizers:
+- name: node
+  type: Node
+- name: rbac
+  type: RBAC
+`},
+				}
+				Expect(c.Create(ctx, authorizationConfig)).To(Succeed())
+
+				caBundleSecret = &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: namespace,
+						Name:      "ca-provider-openstack-controlplane-bundle-abcd1234",
+						Labels:    map[string]string{"managed-by": "secrets-manager", "bundle-for": "ca-provider-openstack-controlplane"},
+					},
+					Data: map[string][]byte{"bundle.crt": []byte("ca-bundle")},
+				}
+
+				dep.Spec.Template.Spec.Containers[0].Args = []string{"--authorization-config=/etc/kubernetes/structured/authorization/config.yaml"}
+				dep.Spec.Template.Spec.Volumes = []corev1.Volume{{
+					Name: "authorization-config",
+					VolumeSource: corev1.VolumeSource{
+						ConfigMap: &corev1.ConfigMapVolumeSource{
+							LocalObjectReference: corev1.LocalObjectReference{Name: authorizationConfig.Name},
+						},
+					},
+				}}
+			})
+
+			It("should not configure the keystone webhooks as long as the control plane CA does not exist", func() {
+				Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKeystoneAuth, dep, nil)).To(Succeed())
+
+				Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ConsistOf("--authorization-config=/etc/kubernetes/structured/authorization/config.yaml"))
+				Expect(dep.Spec.Template.Spec.Volumes).To(HaveLen(1))
+			})
+
+			It("should fail if the keystone webhooks were configured before but the control plane CA does not exist", func() {
+				oldDep := dep.DeepCopy()
+				oldDep.Spec.Template.Spec.Volumes = append(oldDep.Spec.Template.Spec.Volumes, corev1.Volume{Name: "k8s-keystone-auth-webhook-kubeconfig"})
+
+				Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKeystoneAuth, dep, oldDep)).To(MatchError(ContainSubstring("failed ensuring k8s-keystone-auth webhook kubeconfig")))
+			})
+
+			It("should fail if the kube-apiserver does not use a structured authorization configuration", func() {
+				Expect(c.Create(ctx, caBundleSecret)).To(Succeed())
+				dep.Spec.Template.Spec.Containers[0].Args = nil
+				dep.Spec.Template.Spec.Volumes = nil
+
+				Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKeystoneAuth, dep, nil)).To(MatchError(ContainSubstring("kube-apiserver does not use a structured authorization configuration")))
+			})
+
+			It("should configure k8s-keystone-auth as authentication and authorization webhook", func() {
+				Expect(c.Create(ctx, caBundleSecret)).To(Succeed())
+
+				Expect(ensurer.EnsureKubeAPIServerDeployment(ctx, eContextWithKeystoneAuth, dep, nil)).To(Succeed())
+
+				ps := dep.Spec.Template.Spec
+				Expect(ps.Containers[0].Args).To(ConsistOf(
+					"--authentication-token-webhook-config-file=/etc/kubernetes/k8s-keystone-auth/kubeconfig",
+					"--authorization-config=/etc/kubernetes/structured/authorization-keystone/config.yaml",
+				))
+				Expect(ps.Containers[0].VolumeMounts).To(ConsistOf(
+					corev1.VolumeMount{Name: "k8s-keystone-auth-webhook-kubeconfig", MountPath: "/etc/kubernetes/k8s-keystone-auth", ReadOnly: true},
+					corev1.VolumeMount{Name: "authorization-config-keystone", MountPath: "/etc/kubernetes/structured/authorization-keystone", ReadOnly: true},
+				))
+				Expect(dep.Spec.Template.Labels).To(HaveKeyWithValue("networking.resources.gardener.cloud/to-k8s-keystone-auth-tcp-8443", "allowed"))
+				Expect(dep.Spec.Template.Annotations).To(HaveKey("checksum/secret-k8s-keystone-auth-webhook-kubeconfig"))
+
+				Expect(ps.Volumes).To(HaveLen(3))
+				Expect(ps.Volumes[1].Secret.SecretName).To(Equal("k8s-keystone-auth-webhook-kubeconfig"))
+
+				webhookKubeconfig := &corev1.Secret{}
+				Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "k8s-keystone-auth-webhook-kubeconfig"}, webhookKubeconfig)).To(Succeed())
+				Expect(string(webhookKubeconfig.Data["kubeconfig"])).To(And(
+					ContainSubstring("server: https://k8s-keystone-auth."+namespace+".svc:8443/webhook"),
+					ContainSubstring("certificate-authority-data: "+base64.StdEncoding.EncodeToString([]byte("ca-bundle"))),
+				))
+				authorizationVolume := ps.Volumes[2]
+				Expect(authorizationVolume.Name).To(Equal("authorization-config-keystone"))
+				Expect(dep.Spec.Template.Annotations).To(HaveKey(references.AnnotationKey(references.KindConfigMap, authorizationVolume.ConfigMap.Name)))
+
+				keystoneAuthorizationConfig := &corev1.ConfigMap{}
+				Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: authorizationVolume.ConfigMap.Name}, keystoneAuthorizationConfig)).To(Succeed())
+				Expect(keystoneAuthorizationConfig.Immutable).To(Equal(ptr.To(true)))
+				Expect(keystoneAuthorizationConfig.Data["config.yaml"]).To(Equal(`apiVersion: apiserver.config.k8s.io/v1beta1
+authorizers:
+- name: node
+  type: Node
+- name: rbac
+  type: RBAC
+- name: keystone
+  type: Webhook
+  webhook:
+    authorizedTTL: 5m0s
+    connectionInfo:
+      kubeConfigFile: /etc/kubernetes/k8s-keystone-auth/kubeconfig
+      type: KubeConfigFile
+    failurePolicy: NoOpinion
+    matchConditionSubjectAccessReviewVersion: v1
+    matchConditions: null
+    subjectAccessReviewVersion: v1beta1
+    timeout: 3s
+    unauthorizedTTL: 30s
+kind: AuthorizationConfiguration
+`))
+			})
+		})
 	})
 
 	Describe("#EnsureKubeControllerManagerDeployment", func() {
@@ -350,7 +644,7 @@ WantedBy=multi-user.target
 
 		It("should add additional units if resolvConfOptions field is not set", func() {
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(c, logger)
 
 			// Call EnsureAdditionalUnits method and check the result
 			err := ensurer.EnsureAdditionalUnits(ctx, eContextK8s132, &units, nil)
@@ -368,7 +662,7 @@ WantedBy=multi-user.target
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(c, logger)
 
 			// Call EnsureAdditionalUnits method and check the result
 			err := ensurer.EnsureAdditionalUnits(ctx, eContextK8s132WithResolvConfOptions, &units, nil)
@@ -401,7 +695,7 @@ WantedBy=multi-user.target
 		It("should add additional files to the current ones if resolvConfOptions field is not set", func() {
 			files := []extensionsv1alpha1.File{oldFile}
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(c, logger)
 
 			// Call EnsureAdditionalFiles method and check the result
 			err := ensurer.EnsureAdditionalFiles(ctx, eContextK8s132, &files, nil)
@@ -412,7 +706,7 @@ WantedBy=multi-user.target
 			files := []extensionsv1alpha1.File{oldFile}
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(c, logger)
 
 			// Call EnsureAdditionalFiles method and check the result
 			err := ensurer.EnsureAdditionalFiles(ctx, eContextK8s132WithResolvConfOptions, &files, nil)
@@ -427,7 +721,7 @@ WantedBy=multi-user.target
 			)
 
 			// Create ensurer
-			ensurer := NewEnsurer(logger)
+			ensurer := NewEnsurer(c, logger)
 
 			// Call EnsureAdditionalFiles method and check the result
 			err := ensurer.EnsureAdditionalFiles(ctx, eContextK8s132WithResolvConfOptions, &files, nil)
@@ -457,7 +751,7 @@ WantedBy=multi-user.target
 		})
 
 		BeforeEach(func() {
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(c, logger)
 			DeferCleanup(testutils.WithVar(&ImageVector, imagevector.ImageVector{{
 				Name:       "machine-controller-manager-provider-openstack",
 				Repository: ptr.To("foo"),
@@ -484,7 +778,7 @@ WantedBy=multi-user.target
 		})
 
 		BeforeEach(func() {
-			ensurer = NewEnsurer(logger)
+			ensurer = NewEnsurer(c, logger)
 		})
 
 		It("should inject the sidecar container policy", func() {
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"time"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	apiserverconfigv1beta1 "k8s.io/apiserver/pkg/apis/apiserver/v1beta1"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const (
+	keystoneAuthAuthorizerName = "keystone"
+
+	keystoneAuthWebhookKubeconfigVolumeName = "k8s-keystone-auth-webhook-kubeconfig"
+	keystoneAuthWebhookKubeconfigMountPath  = "/etc/kubernetes/k8s-keystone-auth"
+	keystoneAuthWebhookKubeconfigDataKey    = "kubeconfig"
+	keystoneAuthWebhookPort                 = 8443
+
+	// The structured authorization configuration is created by gardenlet, see
+	// https://github.com/gardener/gardener/blob/master/pkg/component/kubernetes/apiserver/authorization.go
+	authorizationConfigVolumeName = "authorization-config"
+	authorizationConfigDataKey    = "config.yaml"
+
+	authorizationConfigKeystoneVolumeName    = "authorization-config-keystone"
+	authorizationConfigKeystoneMountPath     = "/etc/kubernetes/structured/authorization-keystone"
+	authorizationConfigKeystoneConfigMapName = "kube-apiserver-authorization-config-keystone"
+)
+
+// ensureKubeAPIServerKeystoneAuth configures k8s-keystone-auth as authentication and authorization webhook of the
+// kube-apiserver. The Keystone authorizer is appended to the authorizers of Gardener, hence it is only asked if RBAC
+// has no opinion about a request.
+func (e *ensurer) ensureKubeAPIServerKeystoneAuth(ctx context.Context, cpConfig *api.ControlPlaneConfig, newObj, oldObj *appsv1.Deployment) error {
+	if cpConfig.KeystoneAuth == nil {
+		return nil
+	}
+
+	ps := &newObj.Spec.Template.Spec
+	c := extensionswebhook.ContainerWithName(ps.Containers, "kube-apiserver")
+	if c == nil {
+		return nil
+	}
+
+	// The webhook kubeconfig is created here instead of with the controlplane which is only reconciled after the
+	// kube-apiserver, so that the webhooks are configured in the same reconciliation in which Keystone is enabled. It
+	// needs the CA of the control plane which is only generated with the controlplane of new shoots, though.
+	webhookKubeconfig, err := e.ensureKeystoneAuthWebhookKubeconfig(ctx, newObj.Namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) && !isKeystoneAuthWired(oldObj) {
+			e.logger.Info("Control plane CA does not exist yet, skipping Keystone configuration of kube-apiserver", "namespace", newObj.Namespace)
+			return nil
+		}
+		return fmt.Errorf("failed ensuring k8s-keystone-auth webhook kubeconfig: %w", err)
+	}
+	webhookKubeconfigPath := filepath.Join(keystoneAuthWebhookKubeconfigMountPath, keystoneAuthWebhookKubeconfigDataKey)
+
+	c.Args = extensionswebhook.EnsureStringWithPrefix(c.Args, "--authentication-token-webhook-config-file=", webhookKubeconfigPath)
+	c.VolumeMounts = extensionswebhook.EnsureVolumeMountWithName(c.VolumeMounts, corev1.VolumeMount{
+		Name:      keystoneAuthWebhookKubeconfigVolumeName,
+		MountPath: keystoneAuthWebhookKubeconfigMountPath,
+		ReadOnly:  true,
+	})
+	ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, corev1.Volume{
+		Name: keystoneAuthWebhookKubeconfigVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  openstack.K8sKeystoneAuthWebhookKubeconfigName,
+				DefaultMode: ptr.To[int32](0640),
+			},
+		},
+	})
+
+	if authorizationConfigVolume := volumeWithName(ps.Volumes, authorizationConfigVolumeName); authorizationConfigVolume != nil && authorizationConfigVolume.ConfigMap != nil {
+		authorizationConfig := &corev1.ConfigMap{}
+		if err := e.client.Get(ctx, client.ObjectKey{Namespace: newObj.Namespace, Name: authorizationConfigVolume.ConfigMap.Name}, authorizationConfig); err != nil {
+			return fmt.Errorf("failed reading authorization configuration: %w", err)
+		}
+
+		keystoneAuthorizationConfig, err := e.ensureKeystoneAuthorizationConfigMap(ctx, authorizationConfig, webhookKubeconfigPath)
+		if err != nil {
+			return err
+		}
+
+		c.Args = extensionswebhook.EnsureStringWithPrefix(c.Args, "--authorization-config=", filepath.Join(authorizationConfigKeystoneMountPath, authorizationConfigDataKey))
+		c.VolumeMounts = extensionswebhook.EnsureVolumeMountWithName(c.VolumeMounts, corev1.VolumeMount{
+			Name:      authorizationConfigKeystoneVolumeName,
+			MountPath: authorizationConfigKeystoneMountPath,
+			ReadOnly:  true,
+		})
+		ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, corev1.Volume{
+			Name: authorizationConfigKeystoneVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: keystoneAuthorizationConfig.Name},
+				},
+			},
+		})
+	} else {
+		return fmt.Errorf("kube-apiserver does not use a structured authorization configuration, hence k8s-keystone-auth cannot be configured as authorizer")
+	}
+
+	newObj.Spec.Template.Labels = extensionswebhook.EnsureAnnotationOrLabel(newObj.Spec.Template.Labels, fmt.Sprintf("networking.resources.gardener.cloud/to-%s-tcp-%d", openstack.K8sKeystoneAuthName, keystoneAuthWebhookPort), "allowed")
+	newObj.Spec.Template.Annotations = extensionswebhook.EnsureAnnotationOrLabel(newObj.Spec.Template.Annotations, "checksum/secret-"+openstack.K8sKeystoneAuthWebhookKubeconfigName, utils.ComputeSecretChecksum(webhookKubeconfig.Data))
+
+	return references.InjectAnnotations(newObj)
+}
+
+// ensureKeystoneAuthWebhookKubeconfig creates or updates the kubeconfig used by the kube-apiserver to call
+// k8s-keystone-auth. It trusts the newest bundle of the control plane CA which signs the server certificate of
+// k8s-keystone-auth.
+func (e *ensurer) ensureKeystoneAuthWebhookKubeconfig(ctx context.Context, namespace string) (*corev1.Secret, error) {
+	caBundleSecrets := &corev1.SecretList{}
+	if err := e.client.List(ctx, caBundleSecrets, client.InNamespace(namespace), client.MatchingLabels{
+		secretsmanager.LabelKeyManagedBy: secretsmanager.LabelValueSecretsManager,
+		secretsmanager.LabelKeyBundleFor: openstack.CAControlPlaneName,
+	}); err != nil {
+		return nil, err
+	}
+	if len(caBundleSecrets.Items) == 0 {
+		return nil, apierrors.NewNotFound(corev1.Resource("secrets"), openstack.CAControlPlaneName)
+	}
+	caBundleSecret := slices.MaxFunc(caBundleSecrets.Items, func(a, b corev1.Secret) int {
+		return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+	})
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		openstack.K8sKeystoneAuthName,
+		clientcmdv1.Cluster{
+			Server:                   fmt.Sprintf("https://%s.%s.svc:%d/webhook", openstack.K8sKeystoneAuthName, namespace, keystoneAuthWebhookPort),
+			CertificateAuthorityData: caBundleSecret.Data[secretsutils.DataKeyCertificateBundle],
+		},
+		clientcmdv1.AuthInfo{},
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      openstack.K8sKeystoneAuthWebhookKubeconfigName,
+			Namespace: namespace,
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, e.client, secret, func() error {
+		secret.Labels = utils.MergeStringMaps(secret.Labels, map[string]string{
+			v1beta1constants.LabelApp:  "kubernetes",
+			v1beta1constants.LabelRole: openstack.K8sKeystoneAuthName,
+		})
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{keystoneAuthWebhookKubeconfigDataKey: kubeconfig}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return secret, nil
+}
+
+// isKeystoneAuthWired returns true if the given kube-apiserver deployment mounts the k8s-keystone-auth webhook kubeconfig.
+func isKeystoneAuthWired(dep *appsv1.Deployment) bool {
+	return dep != nil && volumeWithName(dep.Spec.Template.Spec.Volumes, keystoneAuthWebhookKubeconfigVolumeName) != nil
+}
+
+// ensureKeystoneAuthorizationConfigMap creates an immutable copy of the authorization configuration of Gardener in
+// which k8s-keystone-auth is appended as webhook authorizer.
+func (e *ensurer) ensureKeystoneAuthorizationConfigMap(ctx context.Context, authorizationConfigMap *corev1.ConfigMap, webhookKubeconfigPath string) (*corev1.ConfigMap, error) {
+	authorizationConfig := &apiserverconfigv1beta1.AuthorizationConfiguration{}
+	if err := yaml.Unmarshal([]byte(authorizationConfigMap.Data[authorizationConfigDataKey]), authorizationConfig); err != nil {
+		return nil, fmt.Errorf("could not decode authorization configuration: %w", err)
+	}
+
+	hasKeystoneAuthorizer := false
+	for _, authorizer := range authorizationConfig.Authorizers {
+		if authorizer.Name == keystoneAuthAuthorizerName {
+			hasKeystoneAuthorizer = true
+		}
+	}
+	if !hasKeystoneAuthorizer {
+		authorizationConfig.Authorizers = append(authorizationConfig.Authorizers, apiserverconfigv1beta1.AuthorizerConfiguration{
+			Type: string(apiserverconfigv1beta1.TypeWebhook),
+			Name: keystoneAuthAuthorizerName,
+			Webhook: &apiserverconfigv1beta1.WebhookConfiguration{
+				AuthorizedTTL:                            metav1.Duration{Duration: 5 * time.Minute},
+				UnauthorizedTTL:                          metav1.Duration{Duration: 30 * time.Second},
+				Timeout:                                  metav1.Duration{Duration: 3 * time.Second},
+				SubjectAccessReviewVersion:               "v1beta1",
+				MatchConditionSubjectAccessReviewVersion: "v1",
+				FailurePolicy:                            apiserverconfigv1beta1.FailurePolicyNoOpinion,
+				ConnectionInfo: apiserverconfigv1beta1.WebhookConnectionInfo{
+					Type:           apiserverconfigv1beta1.AuthorizationWebhookConnectionInfoTypeKubeConfigFile,
+					KubeConfigFile: ptr.To(webhookKubeconfigPath),
+				},
+			},
+		})
+	}
+
+	data, err := yaml.Marshal(authorizationConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      authorizationConfigKeystoneConfigMapName,
+			Namespace: authorizationConfigMap.Namespace,
+		},
+		Data: map[string]string{authorizationConfigDataKey: string(data)},
+	}
+	if err := kubernetesutils.MakeUnique(configMap); err != nil {
+		return nil, err
+	}
+
+	if err := e.client.Create(ctx, configMap); err != nil && !apierrors.IsAlreadyExists(err) {
+		return nil, fmt.Errorf("failed creating authorization configuration with k8s-keystone-auth: %w", err)
+	}
+	return configMap, nil
+}
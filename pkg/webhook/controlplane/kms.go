@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controlplane
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/imagevector"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/openstack"
+)
+
+const (
+	kmsPluginSocketVolumeName = "barbican-kms-plugin-socket"
+	kmsPluginConfigVolumeName = "barbican-kms-plugin-config"
+
+	etcdEncryptionConfigKMSVolumeName = "etcd-encryption-secret-kms"
+	etcdEncryptionConfigKMSMountPath  = "/etc/kubernetes/etcd-encryption-secret-kms"
+)
+
+// ensureKubeAPIServerKMSPlugin adds the Barbican KMS plugin as sidecar to the kube-apiserver deployment and configures
+// it as the first encryption provider, so that new writes are encrypted with the key held in Barbican while resources
+// which were encrypted with the static key of Gardener can still be read.
+func (e *ensurer) ensureKubeAPIServerKMSPlugin(ctx context.Context, cluster *extensionscontroller.Cluster, cpConfig *api.ControlPlaneConfig, newObj, oldObj *appsv1.Deployment) error {
+	if cpConfig.KMS == nil {
+		return nil
+	}
+
+	ps := &newObj.Spec.Template.Spec
+	c := extensionswebhook.ContainerWithName(ps.Containers, "kube-apiserver")
+	if c == nil {
+		return nil
+	}
+
+	// The plugin config and the etcd encryption configuration with the plugin are created by the controlplane
+	// controller which is reconciled after the kube-apiserver, hence the plugin is only wired once they exist. Once it
+	// was wired, the kube-apiserver must never start without it, as it could not decrypt the resources which were
+	// already encrypted with the key held in Barbican.
+	pluginConfig := &corev1.Secret{}
+	if err := e.client.Get(ctx, client.ObjectKey{Namespace: newObj.Namespace, Name: openstack.BarbicanKMSPluginConfigName}, pluginConfig); err != nil {
+		if apierrors.IsNotFound(err) && !isKMSPluginWired(oldObj) {
+			e.logger.Info("Barbican KMS plugin config does not exist yet, skipping KMS configuration of kube-apiserver", "namespace", newObj.Namespace)
+			return nil
+		}
+		return fmt.Errorf("failed reading Barbican KMS plugin config: %w", err)
+	}
+
+	encryptionConfigVolume := volumeWithName(ps.Volumes, openstack.EtcdEncryptionConfigVolumeName)
+	if encryptionConfigVolume == nil || encryptionConfigVolume.Secret == nil {
+		return fmt.Errorf("kube-apiserver deployment has no volume %q with the etcd encryption configuration", openstack.EtcdEncryptionConfigVolumeName)
+	}
+
+	kmsEncryptionConfigSecretName, err := e.kmsEncryptionConfigSecretName(ctx, newObj.Namespace, encryptionConfigVolume.Secret.SecretName, oldObj)
+	if err != nil {
+		return err
+	}
+	if kmsEncryptionConfigSecretName == "" {
+		e.logger.Info("Etcd encryption configuration with Barbican KMS plugin does not exist yet, skipping KMS configuration of kube-apiserver", "namespace", newObj.Namespace)
+		return nil
+	}
+
+	image, err := ImageVector.FindImage(openstack.BarbicanKMSPluginImageName, imagevector.TargetVersion(cluster.Shoot.Spec.Kubernetes.Version))
+	if err != nil {
+		return err
+	}
+
+	c.Args = extensionswebhook.EnsureStringWithPrefix(c.Args, "--encryption-provider-config=", filepath.Join(etcdEncryptionConfigKMSMountPath, openstack.EtcdEncryptionConfigDataKey))
+	c.VolumeMounts = extensionswebhook.EnsureVolumeMountWithName(c.VolumeMounts, corev1.VolumeMount{
+		Name:      etcdEncryptionConfigKMSVolumeName,
+		MountPath: etcdEncryptionConfigKMSMountPath,
+		ReadOnly:  true,
+	})
+	c.VolumeMounts = extensionswebhook.EnsureVolumeMountWithName(c.VolumeMounts, corev1.VolumeMount{
+		Name:      kmsPluginSocketVolumeName,
+		MountPath: openstack.BarbicanKMSPluginSocketMountPath,
+	})
+
+	ps.Containers = extensionswebhook.EnsureContainerWithName(ps.Containers, corev1.Container{
+		Name:            openstack.BarbicanKMSPluginName,
+		Image:           image.String(),
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Args: []string{
+			"--socketpath=" + filepath.Join(openstack.BarbicanKMSPluginSocketMountPath, openstack.BarbicanKMSPluginSocketName),
+			"--cloud-config=" + filepath.Join(openstack.BarbicanKMSPluginConfigMountPath, openstack.CloudProviderConfigDataKey),
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+		},
+		SecurityContext: &corev1.SecurityContext{
+			AllowPrivilegeEscalation: ptr.To(false),
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      kmsPluginSocketVolumeName,
+				MountPath: openstack.BarbicanKMSPluginSocketMountPath,
+			},
+			{
+				Name:      kmsPluginConfigVolumeName,
+				MountPath: openstack.BarbicanKMSPluginConfigMountPath,
+				ReadOnly:  true,
+			},
+		},
+	})
+
+	ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, corev1.Volume{
+		Name: etcdEncryptionConfigKMSVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  kmsEncryptionConfigSecretName,
+				DefaultMode: ptr.To[int32](0640),
+			},
+		},
+	})
+	ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, corev1.Volume{
+		Name: kmsPluginSocketVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			EmptyDir: &corev1.EmptyDirVolumeSource{},
+		},
+	})
+	ps.Volumes = extensionswebhook.EnsureVolumeWithName(ps.Volumes, corev1.Volume{
+		Name: kmsPluginConfigVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName:  openstack.BarbicanKMSPluginConfigName,
+				DefaultMode: ptr.To[int32](0640),
+			},
+		},
+	})
+
+	newObj.Spec.Template.Annotations = extensionswebhook.EnsureAnnotationOrLabel(newObj.Spec.Template.Annotations, "checksum/secret-"+openstack.BarbicanKMSPluginConfigName, utils.ComputeSecretChecksum(pluginConfig.Data))
+
+	return references.InjectAnnotations(newObj)
+}
+
+// kmsEncryptionConfigSecretName returns the name of the newest etcd encryption configuration with the Barbican KMS
+// plugin which was derived from the given etcd encryption configuration of gardenlet. If it does not exist yet, e.g.
+// because gardenlet just changed its configuration, the one of the given old deployment is kept until the controlplane
+// controller created it.
+func (e *ensurer) kmsEncryptionConfigSecretName(ctx context.Context, namespace, encryptionConfigSecretName string, oldObj *appsv1.Deployment) (string, error) {
+	secrets := &corev1.SecretList{}
+	if err := e.client.List(ctx, secrets, client.InNamespace(namespace), client.MatchingLabels{openstack.LabelEtcdEncryptionConfig: encryptionConfigSecretName}); err != nil {
+		return "", fmt.Errorf("failed listing etcd encryption configurations with Barbican KMS plugin: %w", err)
+	}
+	if len(secrets.Items) > 0 {
+		return slices.MaxFunc(secrets.Items, func(a, b corev1.Secret) int {
+			return a.CreationTimestamp.Compare(b.CreationTimestamp.Time)
+		}).Name, nil
+	}
+
+	if isKMSPluginWired(oldObj) {
+		if volume := volumeWithName(oldObj.Spec.Template.Spec.Volumes, etcdEncryptionConfigKMSVolumeName); volume.Secret != nil {
+			return volume.Secret.SecretName, nil
+		}
+	}
+	return "", nil
+}
+
+// isKMSPluginWired returns true if the Barbican KMS plugin is already configured for the given kube-apiserver
+// deployment.
+func isKMSPluginWired(dep *appsv1.Deployment) bool {
+	return dep != nil && volumeWithName(dep.Spec.Template.Spec.Volumes, etcdEncryptionConfigKMSVolumeName) != nil
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// MutatorWebhookName is the name of the webhook defaulting the load balancer settings of services in the shoot.
+	MutatorWebhookName = "service-mutator"
+	// ValidatorWebhookName is the name of the webhook enforcing the load balancer policy for services in the shoot.
+	ValidatorWebhookName = "service-validator"
+)
+
+var (
+	logger = log.Log.WithName("openstack-service-webhook")
+)
+
+// AddMutatorToManager creates the service mutator webhook for the shoot and adds it to the manager.
+func AddMutatorToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding mutator webhook to manager")
+	wh, err := extensionswebhook.New(mgr, extensionswebhook.Args{
+		Name:       MutatorWebhookName,
+		Path:       MutatorWebhookName,
+		Target:     extensionswebhook.TargetShoot,
+		Predicates: []predicate.Predicate{loadBalancerPredicate()},
+		Mutators:   map[extensionswebhook.Mutator][]extensionswebhook.Type{NewMutator(): {{Obj: &corev1.Service{}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The webhook fails closed, so that the load balancer policy cannot be bypassed while the webhook server is not
+	// available. The controlplane controller relaxes the failure policy for shoots without a load balancer policy.
+	wh.FailurePolicy = ptr.To(admissionregistrationv1.Fail)
+	return wh, nil
+}
+
+// AddValidatorToManager creates the service validator webhook for the shoot and adds it to the manager.
+func AddValidatorToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding validator webhook to manager")
+	wh, err := extensionswebhook.New(mgr, extensionswebhook.Args{
+		Name:       ValidatorWebhookName,
+		Path:       ValidatorWebhookName,
+		Target:     extensionswebhook.TargetShoot,
+		Predicates: []predicate.Predicate{loadBalancerPredicate()},
+		Validators: map[extensionswebhook.Validator][]extensionswebhook.Type{NewValidator(): {{Obj: &corev1.Service{}}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	// The webhook fails closed like the mutator webhook.
+	wh.FailurePolicy = ptr.To(admissionregistrationv1.Fail)
+	return wh, nil
+}
+
+// loadBalancerPredicate filters services of type `LoadBalancer`, so that neither the cluster object nor the shoot client
+// are constructed for other services.
+func loadBalancerPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		service, ok := obj.(*corev1.Service)
+		return ok && isLoadBalancer(service)
+	})
+}
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type mutator struct{}
+
+// NewMutator returns a new mutator which defaults the load balancer class and the internal load balancer annotation of
+// services according to the load balancer policy of the shoot.
+func NewMutator() extensionswebhook.Mutator {
+	return &mutator{}
+}
+
+// WantsClusterObject returns true as the load balancer policy is read from the shoot specification.
+func (m *mutator) WantsClusterObject() bool {
+	return true
+}
+
+// WantsShootClient returns true as the load balancer policy depends on the labels of the namespace of a service.
+func (m *mutator) WantsShootClient() bool {
+	return true
+}
+
+// Mutate mutates the given service of type `LoadBalancer` when it is created.
+func (m *mutator) Mutate(ctx context.Context, newObj, oldObj client.Object) error {
+	if newObj.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	service, ok := newObj.(*corev1.Service)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+
+	// Only services which become a load balancer are defaulted to not change the load balancers of existing ones.
+	if !isLoadBalancer(service) {
+		return nil
+	}
+	if oldObj != nil {
+		oldService, ok := oldObj.(*corev1.Service)
+		if !ok {
+			return fmt.Errorf("wrong object type %T for old object", oldObj)
+		}
+		if isLoadBalancer(oldService) {
+			return nil
+		}
+	}
+
+	policy, err := loadBalancerPolicyFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if !policy.requiresNamespace() {
+		return nil
+	}
+
+	namespaceLabels, err := namespaceLabels(ctx, service.Namespace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := service.Annotations[AnnotationLoadBalancerClass]; !ok {
+		class, err := policy.defaultClass(namespaceLabels)
+		if err != nil {
+			return err
+		}
+		if len(class) > 0 {
+			metav1.SetMetaDataAnnotation(&service.ObjectMeta, AnnotationLoadBalancerClass, class)
+		}
+	}
+
+	internal, err := policy.isInternalNamespace(namespaceLabels)
+	if err != nil {
+		return err
+	}
+	if _, ok := service.Annotations[AnnotationInternalLoadBalancer]; internal && !ok {
+		metav1.SetMetaDataAnnotation(&service.ObjectMeta, AnnotationInternalLoadBalancer, "true")
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	cloudProfileConfigJSON = `{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","constraints":{"floatingPools":[{"name":"fip-*","region":"eu-1","loadBalancerClasses":[{"name":"public"},{"name":"vpn","purpose":"vpn"}]}]}}`
+	infraConfigJSON        = `{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","floatingPoolName":"fip-1"}`
+)
+
+// newContext returns a context containing a cluster object with the given controlplane config and a shoot client
+// knowing the given namespaces.
+func newContext(cpConfigJSON string, namespaces ...*corev1.Namespace) context.Context {
+	cluster := &extensionscontroller.Cluster{
+		CloudProfile: &gardencorev1beta1.CloudProfile{
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				ProviderConfig: &runtime.RawExtension{Raw: []byte(cloudProfileConfigJSON)},
+			},
+		},
+		Shoot: &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Region: "eu-1",
+				Provider: gardencorev1beta1.Provider{
+					ControlPlaneConfig:   &runtime.RawExtension{Raw: []byte(cpConfigJSON)},
+					InfrastructureConfig: &runtime.RawExtension{Raw: []byte(infraConfigJSON)},
+				},
+			},
+		},
+	}
+
+	shootClientBuilder := fake.NewClientBuilder()
+	for _, namespace := range namespaces {
+		shootClientBuilder.WithObjects(namespace)
+	}
+
+	ctx := context.WithValue(context.Background(), extensionswebhook.ClusterObjectContextKey{}, cluster)
+	return context.WithValue(ctx, extensionswebhook.ShootClientContextKey{}, shootClientBuilder.Build())
+}
+
+func newNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func newService(serviceType corev1.ServiceType, annotations map[string]string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "svc", Namespace: "foo", Annotations: annotations},
+		Spec:       corev1.ServiceSpec{Type: serviceType},
+	}
+}
+
+var _ = Describe("Mutator", func() {
+	const cpConfigJSON = `{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","loadBalancerPolicy":{"namespaceDefaults":[{"namespaceSelector":{"matchLabels":{"team":"a"}},"loadBalancerClass":"internal"},{"namespaceSelector":{},"loadBalancerClass":"public"}],"internalNamespaceSelector":{"matchLabels":{"internal":"true"}}}}`
+
+	var (
+		ctx     context.Context
+		mutator extensionswebhook.Mutator
+	)
+
+	BeforeEach(func() {
+		ctx = newContext(cpConfigJSON, newNamespace("foo", map[string]string{"team": "a", "internal": "true"}))
+		mutator = NewMutator()
+	})
+
+	It("should default the load balancer class and the internal annotation for new load balancers", func() {
+		service := newService(corev1.ServiceTypeLoadBalancer, nil)
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{
+			AnnotationLoadBalancerClass:    "internal",
+			AnnotationInternalLoadBalancer: "true",
+		}))
+	})
+
+	It("should use the first matching namespace default", func() {
+		ctx = newContext(cpConfigJSON, newNamespace("foo", nil))
+		service := newService(corev1.ServiceTypeLoadBalancer, nil)
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{AnnotationLoadBalancerClass: "public"}))
+	})
+
+	It("should not overwrite existing annotations", func() {
+		service := newService(corev1.ServiceTypeLoadBalancer, map[string]string{
+			AnnotationLoadBalancerClass:    "public",
+			AnnotationInternalLoadBalancer: "false",
+		})
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Annotations).To(Equal(map[string]string{
+			AnnotationLoadBalancerClass:    "public",
+			AnnotationInternalLoadBalancer: "false",
+		}))
+	})
+
+	It("should default a service which is changed to type LoadBalancer", func() {
+		service := newService(corev1.ServiceTypeLoadBalancer, nil)
+
+		Expect(mutator.Mutate(ctx, service, newService(corev1.ServiceTypeClusterIP, nil))).To(Succeed())
+		Expect(service.Annotations).To(HaveKeyWithValue(AnnotationLoadBalancerClass, "internal"))
+	})
+
+	It("should not mutate existing load balancers", func() {
+		service := newService(corev1.ServiceTypeLoadBalancer, nil)
+
+		Expect(mutator.Mutate(ctx, service, newService(corev1.ServiceTypeLoadBalancer, nil))).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+
+	It("should not mutate services of other types or load balancer implementations", func() {
+		service := newService(corev1.ServiceTypeClusterIP, nil)
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+
+		service = newService(corev1.ServiceTypeLoadBalancer, nil)
+		service.Spec.LoadBalancerClass = ptr.To("other")
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+
+	It("should not mutate anything without load balancer policy", func() {
+		ctx = newContext(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig"}`)
+		service := newService(corev1.ServiceTypeLoadBalancer, nil)
+
+		Expect(mutator.Mutate(ctx, service, nil)).To(Succeed())
+		Expect(service.Annotations).To(BeEmpty())
+	})
+})
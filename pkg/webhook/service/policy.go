@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack"
+	"github.com/gardener/gardener-extension-provider-openstack/pkg/apis/openstack/helper"
+)
+
+const (
+	// AnnotationLoadBalancerClass is the annotation of a service referencing the load balancer class which is used by
+	// the cloud-controller-manager.
+	AnnotationLoadBalancerClass = "loadbalancer.openstack.org/class"
+	// AnnotationInternalLoadBalancer is the annotation of a service requesting a load balancer without floating IP.
+	AnnotationInternalLoadBalancer = "service.beta.kubernetes.io/openstack-internal-load-balancer"
+)
+
+// loadBalancerPolicy contains the load balancer classes and the load balancer policy of a shoot.
+type loadBalancerPolicy struct {
+	classNames []string
+	policy     *api.LoadBalancerPolicy
+}
+
+// loadBalancerPolicyFromContext determines the load balancer classes and the load balancer policy from the cluster
+// object in the given context.
+func loadBalancerPolicyFromContext(ctx context.Context) (*loadBalancerPolicy, error) {
+	cluster, ok := ctx.Value(extensionswebhook.ClusterObjectContextKey{}).(*extensionscontroller.Cluster)
+	if !ok || cluster == nil || cluster.Shoot == nil {
+		return nil, fmt.Errorf("could not get cluster object from context")
+	}
+	return loadBalancerPolicyFromCluster(cluster)
+}
+
+// HasLoadBalancerClassesOrPolicy returns true if load balancer classes or a load balancer policy are configured for
+// the shoot of the given cluster, i.e. if the service webhooks have to be registered in the shoot.
+func HasLoadBalancerClassesOrPolicy(cluster *extensionscontroller.Cluster) (bool, error) {
+	p, err := loadBalancerPolicyFromCluster(cluster)
+	if err != nil {
+		return false, err
+	}
+	return len(p.classNames) > 0 || p.policy != nil, nil
+}
+
+// loadBalancerPolicyFromCluster determines the load balancer classes and the load balancer policy of the shoot of the
+// given cluster. The classes are computed in the same way as for the cloud-controller-manager configuration.
+func loadBalancerPolicyFromCluster(cluster *extensionscontroller.Cluster) (*loadBalancerPolicy, error) {
+	cpConfig, err := helper.ControlPlaneConfigFromRawExtension(cluster.Shoot.Spec.Provider.ControlPlaneConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode controlplane config: %w", err)
+	}
+	infraConfig, err := helper.InfrastructureConfigFromRawExtension(cluster.Shoot.Spec.Provider.InfrastructureConfig)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode infrastructure config: %w", err)
+	}
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	var loadBalancerClassesFromCloudProfile []api.LoadBalancerClass
+	if cloudProfileConfig != nil {
+		if floatingPool, err := helper.FindFloatingPool(cloudProfileConfig.Constraints.FloatingPools, infraConfig.FloatingPoolName, cluster.Shoot.Spec.Region, nil); err == nil {
+			loadBalancerClassesFromCloudProfile = floatingPool.LoadBalancerClasses
+		}
+	}
+
+	loadBalancerClasses := loadBalancerClassesFromCloudProfile
+	if cpConfig.LoadBalancerClasses != nil {
+		loadBalancerClasses = cpConfig.LoadBalancerClasses
+	}
+
+	p := &loadBalancerPolicy{policy: cpConfig.LoadBalancerPolicy}
+	for _, class := range loadBalancerClasses {
+		p.classNames = append(p.classNames, class.Name)
+	}
+	// Dual-stack shoots have additional load balancer classes for IPv6 load balancers.
+	if infraConfig.Networks.IPv6 != nil {
+		for _, class := range loadBalancerClasses {
+			if name, ok := helper.IPv6LoadBalancerClassName(class); ok {
+				p.classNames = append(p.classNames, name)
+			}
+		}
+		if !slices.Contains(p.classNames, api.IPv6LoadBalancerClass) {
+			p.classNames = append(p.classNames, api.IPv6LoadBalancerClass)
+		}
+	}
+	// The vpn class of the CloudProfile is always configured for the cloud-controller-manager.
+	for _, class := range loadBalancerClassesFromCloudProfile {
+		if class.Purpose != nil && *class.Purpose == api.VPNLoadBalancerClass {
+			p.classNames = append(p.classNames, class.Name)
+			break
+		}
+	}
+
+	return p, nil
+}
+
+// requiresNamespace returns true if the policy depends on the labels of the namespace of a service.
+func (p *loadBalancerPolicy) requiresNamespace() bool {
+	return p.policy != nil && (len(p.policy.NamespaceDefaults) > 0 || p.policy.InternalNamespaceSelector != nil)
+}
+
+// defaultClass returns the load balancer class of the first namespace default matching the given namespace labels.
+func (p *loadBalancerPolicy) defaultClass(namespaceLabels labels.Set) (string, error) {
+	if p.policy == nil {
+		return "", nil
+	}
+
+	for _, namespaceDefault := range p.policy.NamespaceDefaults {
+		matches, err := selectorMatches(&namespaceDefault.NamespaceSelector, namespaceLabels)
+		if err != nil {
+			return "", err
+		}
+		if matches {
+			return namespaceDefault.LoadBalancerClass, nil
+		}
+	}
+
+	return "", nil
+}
+
+// isInternalNamespace returns true if only internal load balancers may be created in a namespace with the given labels.
+func (p *loadBalancerPolicy) isInternalNamespace(namespaceLabels labels.Set) (bool, error) {
+	if p.policy == nil || p.policy.InternalNamespaceSelector == nil {
+		return false, nil
+	}
+
+	return selectorMatches(p.policy.InternalNamespaceSelector, namespaceLabels)
+}
+
+func selectorMatches(labelSelector *metav1.LabelSelector, namespaceLabels labels.Set) (bool, error) {
+	selector, err := metav1.LabelSelectorAsSelector(labelSelector)
+	if err != nil {
+		return false, fmt.Errorf("could not parse namespace selector: %w", err)
+	}
+
+	return selector.Matches(namespaceLabels), nil
+}
+
+// namespaceLabels reads the labels of the given namespace with the shoot client in the given context.
+func namespaceLabels(ctx context.Context, name string) (labels.Set, error) {
+	shootClient, ok := ctx.Value(extensionswebhook.ShootClientContextKey{}).(client.Client)
+	if !ok {
+		return nil, fmt.Errorf("could not get shoot client from context")
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := shootClient.Get(ctx, client.ObjectKey{Name: name}, namespace); err != nil {
+		return nil, fmt.Errorf("could not get namespace %q: %w", name, err)
+	}
+
+	return namespace.Labels, nil
+}
+
+// isLoadBalancer returns true if the load balancer of the given service is managed by the cloud-controller-manager.
+func isLoadBalancer(service *corev1.Service) bool {
+	return service != nil && service.Spec.Type == corev1.ServiceTypeLoadBalancer && service.Spec.LoadBalancerClass == nil
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type validator struct{}
+
+// NewValidator returns a new validator which enforces the load balancer classes and the load balancer policy of the
+// shoot for services.
+func NewValidator() extensionswebhook.Validator {
+	return &validator{}
+}
+
+// WantsClusterObject returns true as the load balancer classes and policy are read from the shoot specification.
+func (v *validator) WantsClusterObject() bool {
+	return true
+}
+
+// WantsShootClient returns true as the load balancer policy depends on the labels of the namespace of a service.
+func (v *validator) WantsShootClient() bool {
+	return true
+}
+
+// Validate validates the load balancer class and the internal load balancer annotation of the given service of type
+// `LoadBalancer`. Existing services are only validated if the respective annotation changed to not block updates of
+// services created before the policy was configured.
+func (v *validator) Validate(ctx context.Context, newObj, oldObj client.Object) error {
+	if newObj.GetDeletionTimestamp() != nil {
+		return nil
+	}
+
+	service, ok := newObj.(*corev1.Service)
+	if !ok {
+		return fmt.Errorf("wrong object type %T", newObj)
+	}
+	if !isLoadBalancer(service) {
+		return nil
+	}
+
+	var oldService *corev1.Service
+	if oldObj != nil {
+		if oldService, ok = oldObj.(*corev1.Service); !ok {
+			return fmt.Errorf("wrong object type %T for old object", oldObj)
+		}
+		if !isLoadBalancer(oldService) {
+			oldService = nil
+		}
+	}
+
+	policy, err := loadBalancerPolicyFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if class, ok := service.Annotations[AnnotationLoadBalancerClass]; ok && annotationChanged(service, oldService, AnnotationLoadBalancerClass) {
+		if len(policy.classNames) == 0 {
+			return fmt.Errorf("annotation %s is set to %q, but no load balancer classes are configured for the shoot", AnnotationLoadBalancerClass, class)
+		}
+		if !slices.Contains(policy.classNames, class) {
+			return fmt.Errorf("annotation %s is set to unknown load balancer class %q, supported classes are: %s", AnnotationLoadBalancerClass, class, strings.Join(policy.classNames, ", "))
+		}
+	}
+
+	if policy.policy == nil || policy.policy.InternalNamespaceSelector == nil || !annotationChanged(service, oldService, AnnotationInternalLoadBalancer) {
+		return nil
+	}
+
+	namespaceLabels, err := namespaceLabels(ctx, service.Namespace)
+	if err != nil {
+		return err
+	}
+	internal, err := policy.isInternalNamespace(namespaceLabels)
+	if err != nil {
+		return err
+	}
+	if internal && service.Annotations[AnnotationInternalLoadBalancer] != "true" {
+		return fmt.Errorf("only internal load balancers can be created in namespace %q, annotation %s must be set to %q", service.Namespace, AnnotationInternalLoadBalancer, "true")
+	}
+
+	return nil
+}
+
+// annotationChanged returns true if the given annotation of the service differs from the one of the old service or if
+// there is no old service of type `LoadBalancer`.
+func annotationChanged(service, oldService *corev1.Service, annotation string) bool {
+	if oldService == nil {
+		return true
+	}
+
+	newValue, newOK := service.Annotations[annotation]
+	oldValue, oldOK := oldService.Annotations[annotation]
+	return newValue != oldValue || newOK != oldOK
+}
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package service
+
+import (
+	"context"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var _ = Describe("Validator", func() {
+	const cpConfigJSON = `{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","loadBalancerClasses":[{"name":"internal"},{"name":"public"}],"loadBalancerPolicy":{"internalNamespaceSelector":{"matchLabels":{"internal":"true"}}}}`
+
+	var (
+		ctx       context.Context
+		validator extensionswebhook.Validator
+	)
+
+	BeforeEach(func() {
+		ctx = newContext(cpConfigJSON, newNamespace("foo", map[string]string{"internal": "true"}))
+		validator = NewValidator()
+	})
+
+	Context("load balancer class", func() {
+		BeforeEach(func() {
+			ctx = newContext(cpConfigJSON, newNamespace("foo", nil))
+		})
+
+		It("should allow configured load balancer classes", func() {
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "public"}), nil)).To(Succeed())
+		})
+
+		It("should allow the vpn load balancer class of the CloudProfile", func() {
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "vpn"}), nil)).To(Succeed())
+		})
+
+		It("should allow the load balancer classes of the CloudProfile if the shoot does not define own ones", func() {
+			ctx = newContext(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig"}`)
+
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "public"}), nil)).To(Succeed())
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "internal"}), nil)).To(MatchError(ContainSubstring(`unknown load balancer class "internal"`)))
+		})
+
+		It("should allow the load balancer classes for IPv6 load balancers of dual-stack shoots", func() {
+			ctx = newContext(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"ControlPlaneConfig","loadBalancerClasses":[{"name":"public","ipv6FloatingSubnetID":"subnet"}]}`)
+			cluster := ctx.Value(extensionswebhook.ClusterObjectContextKey{}).(*extensionscontroller.Cluster)
+			cluster.Shoot.Spec.Provider.InfrastructureConfig.Raw = []byte(`{"apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","kind":"InfrastructureConfig","floatingPoolName":"fip-1","networks":{"ipv6":{"subnetPoolID":"pool"}}}`)
+
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "public-ipv6"}), nil)).To(Succeed())
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "ipv6"}), nil)).To(Succeed())
+		})
+
+		It("should forbid the load balancer classes for IPv6 load balancers of single-stack shoots", func() {
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "ipv6"}), nil)).To(MatchError(ContainSubstring(`unknown load balancer class "ipv6"`)))
+		})
+
+		It("should forbid unknown load balancer classes", func() {
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "pubilc"}), nil)).To(MatchError(ContainSubstring(`unknown load balancer class "pubilc"`)))
+		})
+
+		It("should forbid changing the load balancer class to an unknown one", func() {
+			Expect(validator.Validate(ctx,
+				newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "pubilc"}),
+				newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "public"}),
+			)).To(MatchError(ContainSubstring(`unknown load balancer class "pubilc"`)))
+		})
+
+		It("should allow updates of existing load balancers with unchanged load balancer class", func() {
+			Expect(validator.Validate(ctx,
+				newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "pubilc"}),
+				newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationLoadBalancerClass: "pubilc"}),
+			)).To(Succeed())
+		})
+
+		It("should not validate services of other types", func() {
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeClusterIP, map[string]string{AnnotationLoadBalancerClass: "pubilc"}), nil)).To(Succeed())
+		})
+	})
+
+	Context("internal namespaces", func() {
+		It("should allow internal load balancers", func() {
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationInternalLoadBalancer: "true"}), nil)).To(Succeed())
+		})
+
+		It("should forbid internet-facing load balancers", func() {
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, nil), nil)).To(MatchError(ContainSubstring("only internal load balancers")))
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationInternalLoadBalancer: "false"}), nil)).To(MatchError(ContainSubstring("only internal load balancers")))
+		})
+
+		It("should forbid changing a load balancer to be internet-facing", func() {
+			Expect(validator.Validate(ctx,
+				newService(corev1.ServiceTypeLoadBalancer, nil),
+				newService(corev1.ServiceTypeLoadBalancer, map[string]string{AnnotationInternalLoadBalancer: "true"}),
+			)).To(MatchError(ContainSubstring("only internal load balancers")))
+		})
+
+		It("should allow updates of existing internet-facing load balancers", func() {
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, nil), newService(corev1.ServiceTypeLoadBalancer, nil))).To(Succeed())
+		})
+
+		It("should allow internet-facing load balancers in other namespaces", func() {
+			ctx = newContext(cpConfigJSON, newNamespace("foo", nil))
+
+			Expect(validator.Validate(ctx, newService(corev1.ServiceTypeLoadBalancer, nil), nil)).To(Succeed())
+		})
+	})
+})